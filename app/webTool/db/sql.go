@@ -168,7 +168,7 @@ func (this *Client) GetAll(tableName string, fields []string) (ret []map[string]
 
 func (this *Client) Create(tableName string, fields []string) error {
 	sqlStr := `CREATE TABLE "%s" (
-  "__key__" varchar(255) NOT NULL,
+  "__key__" varchar(255) COLLATE "C" NOT NULL,
   "__version__" int8 NOT NULL,
   %s
   PRIMARY KEY ("__key__")
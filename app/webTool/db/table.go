@@ -81,7 +81,7 @@ func makeCreateSql(tableName string, fields []string) string {
 	sqlStr := `
 DROP TABLE IF EXISTS "%s";
 CREATE TABLE "%s" (
-  "__key__" varchar(255) NOT NULL,
+  "__key__" varchar(255) COLLATE "C" NOT NULL,
   "__version__" int8 NOT NULL,
   %s
   PRIMARY KEY ("__key__")
@@ -0,0 +1,155 @@
+package cdc
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestWriteRead(t *testing.T) {
+
+	dir := "./testcdc"
+
+	os.RemoveAll(dir)
+	defer os.RemoveAll(dir)
+
+	w, err := NewWriter(dir, 1, 100, 0)
+
+	assert.Nil(t, err)
+
+	for i := uint64(1); i <= 10; i++ {
+		err = w.Write(i, []*Record{
+			&Record{Table: "users1", Key: "a", Version: int64(i), Op: OpSet, Fields: map[string]interface{}{"age": i}},
+			&Record{Table: "users1", Key: "b", Op: OpDel},
+		})
+		assert.Nil(t, err)
+	}
+
+	//重放的日志被忽略
+	assert.Nil(t, w.Write(5, []*Record{&Record{Table: "users1", Key: "c", Op: OpDel}}))
+
+	w.Close()
+
+	files, _ := listFiles(regionDir(dir, 1))
+
+	assert.Equal(t, true, len(files) > 1)
+
+	//重新打开后从最后的index继续
+	w, err = NewWriter(dir, 1, 100, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), w.LastIndex())
+	assert.Nil(t, w.Write(11, []*Record{&Record{Table: "users1", Key: "c", Op: OpDel}}))
+
+	r := NewReader(dir, 1, 0, 0)
+
+	count := 0
+	for {
+		record, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		count++
+		assert.Equal(t, 1, record.Region)
+	}
+
+	assert.Equal(t, 21, count)
+
+	//写入新记录后可以继续读取
+	assert.Nil(t, w.Write(12, []*Record{&Record{Table: "users1", Key: "d", Op: OpDel}}))
+
+	record, err := r.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, "d", record.Key)
+
+	r.Close()
+
+	//从指定位置恢复
+	r = NewReader(dir, 1, 5, 0)
+	record, err = r.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), record.Index)
+	assert.Equal(t, 1, record.Seq)
+	assert.Equal(t, "b", record.Key)
+	r.Close()
+
+	w.Close()
+}
+
+func TestMaxFiles(t *testing.T) {
+
+	dir := "./testcdc"
+
+	os.RemoveAll(dir)
+	defer os.RemoveAll(dir)
+
+	w, err := NewWriter(dir, 1, 100, 2)
+
+	assert.Nil(t, err)
+
+	for i := uint64(1); i <= 10; i++ {
+		assert.Nil(t, w.Write(i, []*Record{&Record{Table: "users1", Key: "a", Version: int64(i), Op: OpSet, Fields: map[string]interface{}{"age": i}}}))
+	}
+
+	assert.Nil(t, w.Write(11, []*Record{&Record{Op: OpSnapshot}}))
+
+	w.Close()
+
+	files, _ := listFiles(regionDir(dir, 1))
+	assert.Equal(t, 2, len(files))
+
+	r := NewReader(dir, 1, 0, 0)
+	var last *Record
+	for {
+		record, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		last = record
+	}
+	r.Close()
+
+	assert.Equal(t, OpSnapshot, last.Op)
+	assert.Equal(t, uint64(11), last.Index)
+}
+
+func TestWriteFail(t *testing.T) {
+
+	dir := "./testcdc"
+
+	os.RemoveAll(dir)
+	defer os.RemoveAll(dir)
+
+	w, err := NewWriter(dir, 1, 1024*1024, 0)
+	assert.Nil(t, err)
+
+	assert.Nil(t, w.Write(1, []*Record{&Record{Table: "users1", Key: "a", Op: OpDel}}))
+
+	//写入失败时不推进LastIndex
+	w.file.Close()
+	assert.NotNil(t, w.Write(2, []*Record{&Record{Table: "users1", Key: "b", Op: OpDel}}))
+	assert.Equal(t, uint64(1), w.LastIndex())
+
+	//重新打开后用相同的index重试
+	w, err = NewWriter(dir, 1, 1024*1024, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), w.LastIndex())
+	assert.Nil(t, w.Write(2, []*Record{&Record{Table: "users1", Key: "b", Op: OpDel}}))
+	w.Close()
+
+	r := NewReader(dir, 1, 0, 0)
+	keys := []string{}
+	for {
+		record, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		keys = append(keys, record.Key)
+	}
+	r.Close()
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
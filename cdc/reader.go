@@ -0,0 +1,141 @@
+package cdc
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+)
+
+/*
+ * 从(index,seq)之后读取region的变更记录,index为0表示从头读取。
+ * Next返回io.EOF表示暂时没有新的记录，稍后可以继续调用
+ */
+type Reader struct {
+	path    string
+	index   uint64
+	seq     int
+	file    *os.File
+	fileIdx uint64 //当前文件的起始index
+	r       *bufio.Reader
+	partial []byte //尚未写完整的行
+}
+
+func NewReader(dir string, region int, index uint64, seq int) *Reader {
+	return &Reader{
+		path:  regionDir(dir, region),
+		index: index,
+		seq:   seq,
+	}
+}
+
+//最后返回的记录的位置
+func (this *Reader) Position() (uint64, int) {
+	return this.index, this.seq
+}
+
+func (this *Reader) open(f cdcFile) error {
+	file, err := os.Open(f.name)
+	if nil != err {
+		return err
+	}
+	this.Close()
+	this.file = file
+	this.fileIdx = f.index
+	this.r = bufio.NewReader(file)
+	this.partial = nil
+	return nil
+}
+
+//打开包含起始位置的文件
+func (this *Reader) openFirst() error {
+	files, err := listFiles(this.path)
+	if nil != err {
+		if os.IsNotExist(err) {
+			return io.EOF
+		}
+		return err
+	}
+
+	if len(files) == 0 {
+		return io.EOF
+	}
+
+	i := 0
+	for j, v := range files {
+		if v.index <= this.index {
+			i = j
+		}
+	}
+
+	return this.open(files[i])
+}
+
+//当前文件已经读完，切换到下一个文件
+func (this *Reader) openNext() error {
+	files, err := listFiles(this.path)
+	if nil != err {
+		return err
+	}
+
+	for _, v := range files {
+		if v.index > this.fileIdx {
+			return this.open(v)
+		}
+	}
+
+	return io.EOF
+}
+
+func (this *Reader) Next() (*Record, error) {
+	if nil == this.file {
+		if err := this.openFirst(); nil != err {
+			return nil, err
+		}
+	}
+
+	for {
+		line, err := this.r.ReadBytes('\n')
+		if nil != err {
+			if err != io.EOF {
+				return nil, err
+			}
+
+			this.partial = append(this.partial, line...)
+
+			if len(this.partial) > 0 {
+				//writer尚未写完一行
+				return nil, io.EOF
+			}
+
+			if err = this.openNext(); nil != err {
+				return nil, err
+			}
+
+			continue
+		}
+
+		if len(this.partial) > 0 {
+			line = append(this.partial, line...)
+			this.partial = nil
+		}
+
+		record := &Record{}
+		if err = json.Unmarshal(line, record); nil != err {
+			return nil, err
+		}
+
+		if record.after(this.index, this.seq) {
+			this.index = record.Index
+			this.seq = record.Seq
+			return record, nil
+		}
+	}
+}
+
+func (this *Reader) Close() {
+	if nil != this.file {
+		this.file.Close()
+		this.file = nil
+	}
+}
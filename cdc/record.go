@@ -0,0 +1,36 @@
+package cdc
+
+/*
+ * 变更记录(change data capture)
+ * kvnode在apply提交的proposal时为每个发生变更的记录生成一条Record,按raft日志顺序写入region对应的目录。
+ * (Region,Index,Seq)唯一确定一条记录，消费者保存最后处理的位置，用NewReader从该位置之后继续读取。
+ * 节点通过raft快照追赶时，快照之前的日志不会被apply,此时写入一条OpSnapshot记录(Index为快照的index),
+ * 消费者需要从数据库或其它副本重新同步该region的数据。
+ */
+
+const (
+	OpSet      = "set"    //Fields为记录的全部字段
+	OpUpdate   = "update" //Fields为发生变更的字段
+	OpDel      = "del"
+	OpSnapshot = "snapshot" //Index之前的变更没有输出
+)
+
+type Record struct {
+	Region  int                    `json:"region"`
+	Index   uint64                 `json:"index"` //raft日志index
+	Seq     int                    `json:"seq"`   //在同一条raft日志中的序号
+	Table   string                 `json:"table"`
+	Key     string                 `json:"key"`
+	Version int64                  `json:"version"`
+	Op      string                 `json:"op"`
+	Fields  map[string]interface{} `json:"fields,omitempty"`
+}
+
+//记录是否位于(index,seq)之后
+func (this *Record) after(index uint64, seq int) bool {
+	if this.Index != index {
+		return this.Index > index
+	} else {
+		return this.Seq > seq
+	}
+}
@@ -0,0 +1,260 @@
+package cdc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const fileSuffix = ".cdc"
+
+const defaultMaxFileSize = 64 * 1024 * 1024
+
+func regionDir(dir string, region int) string {
+	return filepath.Join(dir, strconv.Itoa(region))
+}
+
+//文件以其中第一条记录的raft index命名
+func fileName(index uint64) string {
+	return fmt.Sprintf("%020d%s", index, fileSuffix)
+}
+
+type cdcFile struct {
+	name  string
+	index uint64
+}
+
+//按起始index升序返回region目录下的所有文件
+func listFiles(path string) ([]cdcFile, error) {
+	infos, err := ioutil.ReadDir(path)
+	if nil != err {
+		return nil, err
+	}
+
+	files := []cdcFile{}
+	for _, v := range infos {
+		name := v.Name()
+		if v.IsDir() || !strings.HasSuffix(name, fileSuffix) {
+			continue
+		}
+		index, err := strconv.ParseUint(strings.TrimSuffix(name, fileSuffix), 10, 64)
+		if nil != err {
+			continue
+		}
+		files = append(files, cdcFile{name: filepath.Join(path, name), index: index})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].index < files[j].index
+	})
+
+	return files, nil
+}
+
+/*
+ * 按region写入变更记录，文件超过maxFileSize后切换到新文件,切换后只保留最新的maxFiles个文件(0表示不删除)。
+ * 重放raft日志时会再次写入已经写过的index,Writer记录最后写入的index并忽略重复的写入
+ * 每次写入在fsync之后才推进LastIndex,写入失败时截掉本次写入的内容，可以用相同的index重试
+ */
+type Writer struct {
+	path        string
+	region      int
+	maxFileSize int
+	maxFiles    int
+	file        *os.File
+	size        int
+	lastIndex   uint64
+}
+
+func NewWriter(dir string, region int, maxFileSize int, maxFiles int) (*Writer, error) {
+	if maxFileSize <= 0 {
+		maxFileSize = defaultMaxFileSize
+	}
+
+	writer := &Writer{
+		path:        regionDir(dir, region),
+		region:      region,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+	}
+
+	if err := os.MkdirAll(writer.path, 0755); nil != err {
+		return nil, err
+	}
+
+	files, err := listFiles(writer.path)
+	if nil != err {
+		return nil, err
+	}
+
+	if len(files) > 0 {
+		if err = writer.openLast(files[len(files)-1].name); nil != err {
+			return nil, err
+		}
+	}
+
+	return writer, nil
+}
+
+//打开最后一个文件继续写入，丢弃崩溃时写了一半的记录
+func (this *Writer) openLast(name string) error {
+	f, err := os.OpenFile(name, os.O_RDWR, 0644)
+	if nil != err {
+		return err
+	}
+
+	r := bufio.NewReader(f)
+	offset := 0
+	for {
+		line, err := r.ReadBytes('\n')
+		if nil != err {
+			if err != io.EOF {
+				f.Close()
+				return err
+			}
+			break
+		}
+		record := &Record{}
+		if nil != json.Unmarshal(line, record) {
+			break
+		}
+		this.lastIndex = record.Index
+		offset += len(line)
+	}
+
+	if err = f.Truncate(int64(offset)); nil != err {
+		f.Close()
+		return err
+	}
+
+	if _, err = f.Seek(int64(offset), io.SeekStart); nil != err {
+		f.Close()
+		return err
+	}
+
+	this.file = f
+	this.size = offset
+	return nil
+}
+
+func (this *Writer) rotate(index uint64) error {
+	if err := this.Close(); nil != err {
+		return err
+	}
+
+	f, err := os.OpenFile(filepath.Join(this.path, fileName(index)), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if nil != err {
+		return err
+	}
+
+	this.file = f
+	this.size = 0
+
+	//新文件的目录项也需要落盘
+	if err = syncDir(this.path); nil != err {
+		return err
+	}
+
+	this.removeOld()
+
+	return nil
+}
+
+func syncDir(path string) error {
+	d, err := os.Open(path)
+	if nil != err {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+//删除超出保留数量的旧文件
+func (this *Writer) removeOld() {
+	if this.maxFiles <= 0 {
+		return
+	}
+
+	files, err := listFiles(this.path)
+	if nil != err {
+		return
+	}
+
+	for i := 0; i < len(files)-this.maxFiles; i++ {
+		os.Remove(files[i].name)
+	}
+}
+
+func (this *Writer) LastIndex() uint64 {
+	return this.lastIndex
+}
+
+//写入一条raft日志产生的所有变更
+func (this *Writer) Write(index uint64, records []*Record) error {
+	if index <= this.lastIndex || len(records) == 0 {
+		return nil
+	}
+
+	if nil == this.file || this.size >= this.maxFileSize {
+		if err := this.rotate(index); nil != err {
+			return err
+		}
+	}
+
+	buff := bytes.Buffer{}
+
+	for i, v := range records {
+		v.Region = this.region
+		v.Index = index
+		v.Seq = i
+		b, err := json.Marshal(v)
+		if nil != err {
+			return err
+		}
+		buff.Write(b)
+		buff.WriteByte('\n')
+	}
+
+	_, err := this.file.Write(buff.Bytes())
+	if nil == err {
+		err = this.file.Sync()
+	}
+
+	if nil != err {
+		//截掉写了一部分的记录，重试时不会产生重复的记录
+		if e := this.truncate(this.size); nil != e {
+			return fmt.Errorf("%v(truncate:%v)", err, e)
+		}
+		return err
+	}
+
+	this.size += buff.Len()
+	this.lastIndex = index
+
+	return nil
+}
+
+func (this *Writer) truncate(size int) error {
+	if err := this.file.Truncate(int64(size)); nil != err {
+		return err
+	}
+	_, err := this.file.Seek(int64(size), io.SeekStart)
+	return err
+}
+
+func (this *Writer) Close() error {
+	if nil != this.file {
+		err := this.file.Close()
+		this.file = nil
+		return err
+	}
+	return nil
+}
@@ -10,17 +10,50 @@ type StatusResult struct {
 }
 
 type SliceResult struct {
+	ErrCode      int32
+	Table        string
+	Key          string
+	Version      int64
+	Fields       map[string]*Field
+	Result       string //Eval脚本返回的结果，出错时为错误信息
+	AppliedIndex uint64 //follower读取时返回的已应用日志位置
+	unikey       string
+}
+
+//批量命令中单个key的结果
+type Row struct {
+	Key     string
+	Version int64
+	ErrCode int32
+	Fields  map[string]*Field
+}
+
+//记录变更通知,ErrCode != ERR_OK表示关注已经失效，需要重新Watch
+type WatchEvent struct {
 	ErrCode int32
 	Table   string
 	Key     string
 	Version int64
+	Del     bool //记录被删除
 	Fields  map[string]*Field
+}
+
+type MutiResult struct {
+	ErrCode int32
+	Table   string
+	Rows    []*Row
 	unikey  string
+	cursor  string //scan及索引查询使用
+	finish  bool   //scan及索引查询使用
 }
 
 const (
-	cb_status = 1
-	cb_slice  = 2
+	cb_status     = 1
+	cb_slice      = 2
+	cb_muti       = 3
+	cb_watch      = 4
+	cb_preload    = 5
+	cb_slot_table = 6
 )
 
 type callback struct {
@@ -63,6 +96,28 @@ func (this *callback) onError(unikey string, errCode int32) {
 			unikey:  unikey,
 			ErrCode: errCode,
 		})
+	} else if this.tt == cb_muti {
+		table, _ := splitUniKey(unikey)
+		this.cb.(func(*MutiResult))(&MutiResult{
+			Table:   table,
+			unikey:  unikey,
+			ErrCode: errCode,
+		})
+	} else if this.tt == cb_watch {
+		table, key := splitUniKey(unikey)
+		this.cb.(func(*WatchEvent))(&WatchEvent{
+			Key:     key,
+			Table:   table,
+			ErrCode: errCode,
+		})
+	} else if this.tt == cb_preload {
+		this.cb.(func(*PreloadResult))(&PreloadResult{
+			ErrCode: errCode,
+		})
+	} else if this.tt == cb_slot_table {
+		this.cb.(func(*SlotTableResult))(&SlotTableResult{
+			ErrCode: errCode,
+		})
 	} else {
 		panic("invaild cb_type")
 	}
@@ -83,6 +138,22 @@ func (this *callback) onResult(unikey string, r interface{}) {
 		ret.Table = table
 		ret.unikey = unikey
 		this.cb.(func(*SliceResult))(ret)
+	} else if this.tt == cb_muti {
+		table, _ := splitUniKey(unikey)
+		ret := r.(*MutiResult)
+		ret.Table = table
+		ret.unikey = unikey
+		this.cb.(func(*MutiResult))(ret)
+	} else if this.tt == cb_watch {
+		table, key := splitUniKey(unikey)
+		ret := r.(*WatchEvent)
+		ret.Key = key
+		ret.Table = table
+		this.cb.(func(*WatchEvent))(ret)
+	} else if this.tt == cb_preload {
+		this.cb.(func(*PreloadResult))(r.(*PreloadResult))
+	} else if this.tt == cb_slot_table {
+		this.cb.(func(*SlotTableResult))(r.(*SlotTableResult))
 	} else {
 		panic("invaild cb_type")
 	}
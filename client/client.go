@@ -1,8 +1,11 @@
 package client
 
 import (
+	"github.com/sniperHW/flyfish/partition"
 	"github.com/sniperHW/kendynet/event"
 	"github.com/sniperHW/kendynet/util"
+	"sync"
+	"time"
 )
 
 var ClientTimeout uint32 = 6000 //6sec
@@ -14,6 +17,25 @@ type Client struct {
 	closed        int32
 	callbackQueue *event.EventQueue //响应回调的事件队列
 	compress      bool
+	muConn        sync.Mutex
+	conns         map[string]*Conn //按leader提示转发请求时使用的连接
+	muSlot        sync.Mutex
+	slotTable     *partition.Table //最近获取的slot表
+}
+
+//获取到addr的连接，不存在则创建
+func (this *Client) getConn(addr string) *Conn {
+	if addr == this.conn.addr {
+		return this.conn
+	}
+	this.muConn.Lock()
+	defer this.muConn.Unlock()
+	c, ok := this.conns[addr]
+	if !ok {
+		c = openConn(this, addr)
+		this.conns[addr] = c
+	}
+	return c
 }
 
 func (this *Client) pcall(unikey string, cb callback, a interface{}) {
@@ -45,6 +67,7 @@ func OpenClient(service string, compress bool, callbackQueue ...*event.EventQueu
 
 	c := &Client{
 		compress: compress,
+		conns:    map[string]*Conn{},
 	}
 
 	if len(callbackQueue) > 0 {
@@ -72,14 +95,31 @@ func (this *Client) GetAllWithVersion(table, key string, version int64) *SliceCm
 	return this.conn.GetAll(table, key, &version)
 }
 
+//允许follower从已应用的状态读取,maxLag:允许落后的raft日志条数,0表示不限制
+func (this *Client) GetStale(table, key string, maxLag int64, fields ...string) *SliceCmd {
+	return this.conn.GetStale(table, key, maxLag, fields...)
+}
+
+func (this *Client) GetAllStale(table, key string, maxLag int64) *SliceCmd {
+	return this.conn.GetAllStale(table, key, maxLag)
+}
+
 func (this *Client) Set(table, key string, fields map[string]interface{}, version ...int64) *StatusCmd {
 	return this.conn.Set(table, key, fields, version...)
 }
 
+func (this *Client) SetWithTTL(table, key string, fields map[string]interface{}, ttl time.Duration, version ...int64) *StatusCmd {
+	return this.conn.SetWithTTL(table, key, fields, ttl, version...)
+}
+
 func (this *Client) SetNx(table, key string, fields map[string]interface{}) *SliceCmd {
 	return this.conn.SetNx(table, key, fields)
 }
 
+func (this *Client) SetNxWithTTL(table, key string, fields map[string]interface{}, ttl time.Duration) *SliceCmd {
+	return this.conn.SetNxWithTTL(table, key, fields, ttl)
+}
+
 func (this *Client) CompareAndSet(table, key, field string, oldV, newV interface{}, version ...int64) *SliceCmd {
 	return this.conn.CompareAndSet(table, key, field, oldV, newV, version...)
 }
@@ -92,18 +132,106 @@ func (this *Client) Del(table, key string, version ...int64) *StatusCmd {
 	return this.conn.Del(table, key, version...)
 }
 
+func (this *Client) Unset(table, key string, fields ...string) *StatusCmd {
+	return this.conn.Unset(table, key, fields...)
+}
+
+func (this *Client) UnsetWithVersion(table, key string, version int64, fields ...string) *StatusCmd {
+	return this.conn.UnsetWithVersion(table, key, version, fields...)
+}
+
 func (this *Client) IncrBy(table, key, field string, value int64, version ...int64) *SliceCmd {
 	return this.conn.IncrBy(table, key, field, value, version...)
 }
 
+func (this *Client) IncrByWithTTL(table, key, field string, value int64, ttl time.Duration, version ...int64) *SliceCmd {
+	return this.conn.IncrByWithTTL(table, key, field, value, ttl, version...)
+}
+
 func (this *Client) DecrBy(table, key, field string, value int64, version ...int64) *SliceCmd {
 	return this.conn.DecrBy(table, key, field, value, version...)
 }
 
+func (this *Client) Eval(table, key, script string, args ...string) *SliceCmd {
+	return this.conn.Eval(table, key, script, args...)
+}
+
+func (this *Client) MGet(table string, keys []string, fields ...string) *MutiCmd {
+	return this.conn.MGet(table, keys, fields...)
+}
+
+func (this *Client) MGetAll(table string, keys ...string) *MutiCmd {
+	return this.conn.MGetAll(table, keys...)
+}
+
+func (this *Client) MSet(table string, items ...*MSetItem) *MutiCmd {
+	return this.conn.MSet(table, items...)
+}
+
+func (this *Client) Txn(table string, ops ...*TxnOp) *MutiCmd {
+	return this.conn.Txn(table, ops...)
+}
+
+func (this *Client) GetByIndex(table, field string, value interface{}) *MutiCmd {
+	return this.conn.GetByIndex(table, field, value)
+}
+
 func (this *Client) Kick(table, key string) *StatusCmd {
 	return this.conn.Kick(table, key)
 }
 
-func (this *Client) ReloadTableConf() *StatusCmd {
-	return this.conn.ReloadTableConf()
+func (this *Client) Watch(table, key string, cb func(*WatchEvent)) *StatusCmd {
+	return this.conn.Watch(table, key, cb)
+}
+
+func (this *Client) Unwatch(table, key string, watchSeqno int64) *StatusCmd {
+	return this.conn.Unwatch(table, key, watchSeqno)
+}
+
+func (this *Client) Cancel(seqnos ...int64) {
+	this.conn.Cancel(seqnos...)
+}
+
+func (this *Client) ReloadTableConf(regions ...int) *StatusCmd {
+	return this.conn.ReloadTableConf(regions...)
+}
+
+func (this *Client) AddMember(nodeID int, url string) *StatusCmd {
+	return this.conn.AddMember(nodeID, url)
+}
+
+func (this *Client) RemoveMember(nodeID int) *StatusCmd {
+	return this.conn.RemoveMember(nodeID)
+}
+
+func (this *Client) TransferLeader(region int, nodeID int) *StatusCmd {
+	return this.conn.TransferLeader(region, nodeID)
+}
+
+func (this *Client) MoveSlot(slot int, region int) *StatusCmd {
+	return this.conn.MoveSlot(slot, region)
+}
+
+func (this *Client) GetSlotTable() *SlotTableCmd {
+	return this.conn.GetSlotTable()
+}
+
+func (this *Client) ReloadConfig(path string) *StatusCmd {
+	return this.conn.ReloadConfig(path)
+}
+
+func (this *Client) PreloadKeys(table string, keys ...string) *PreloadCmd {
+	return this.conn.PreloadKeys(table, keys...)
+}
+
+func (this *Client) PreloadRange(table, begin, end string) *PreloadCmd {
+	return this.conn.PreloadRange(table, begin, end)
+}
+
+func (this *Client) PreloadAll(table string) *PreloadCmd {
+	return this.conn.PreloadAll(table)
+}
+
+func (this *Client) PreloadStatus(job int64) *PreloadCmd {
+	return this.conn.PreloadStatus(job)
 }
@@ -41,6 +41,9 @@ type cmdContext struct {
 	isTimeouted bool
 	cb          callback
 	req         *net.Message
+	watch       *callback //watch请求的变更回调
+	hops        int       //按leader提示转发的次数
+	slotRetried bool      //已经因ERR_SLOT_VERSION重发过
 }
 
 func (this *cmdContext) onError(errCode int32) {
@@ -52,8 +55,9 @@ func (this *cmdContext) onResult(r interface{}) {
 }
 
 type StatusCmd struct {
-	conn *Conn
-	req  *net.Message
+	conn  *Conn
+	req   *net.Message
+	watch *callback
 }
 
 func (this *StatusCmd) asyncExec(syncFlag bool, cb func(*StatusResult)) {
@@ -65,10 +69,16 @@ func (this *StatusCmd) asyncExec(syncFlag bool, cb func(*StatusResult)) {
 		},
 		unikey: this.req.GetHead().UniKey,
 		req:    this.req,
+		watch:  this.watch,
 	}
 	this.conn.exec(context)
 }
 
+//请求的seqno,用于Cancel
+func (this *StatusCmd) Seqno() int64 {
+	return this.req.GetHead().Seqno
+}
+
 func (this *StatusCmd) AsyncExec(cb func(*StatusResult)) {
 	this.asyncExec(false, cb)
 }
@@ -99,6 +109,11 @@ func (this *SliceCmd) asyncExec(syncFlag bool, cb func(*SliceResult)) {
 	this.conn.exec(context)
 }
 
+//请求的seqno,用于Cancel
+func (this *SliceCmd) Seqno() int64 {
+	return this.req.GetHead().Seqno
+}
+
 func (this *SliceCmd) AsyncExec(cb func(*SliceResult)) {
 	this.asyncExec(false, cb)
 }
@@ -151,13 +166,39 @@ func (this *Conn) GetAll(table, key string, version *int64) *SliceCmd {
 
 }
 
+//允许follower从已应用的状态读取，结果的AppliedIndex为follower已应用的日志位置
+func (this *Conn) GetStale(table, key string, maxLag int64, fields ...string) *SliceCmd {
+	cmd := this.Get(table, key, nil, fields...)
+	if nil != cmd {
+		req := cmd.req.GetData().(*protocol.GetReq)
+		req.StaleOk = true
+		req.MaxLag = maxLag
+	}
+	return cmd
+}
+
+func (this *Conn) GetAllStale(table, key string, maxLag int64) *SliceCmd {
+	cmd := this.GetAll(table, key, nil)
+	req := cmd.req.GetData().(*protocol.GetReq)
+	req.StaleOk = true
+	req.MaxLag = maxLag
+	return cmd
+}
+
 func (this *Conn) Set(table, key string, fields map[string]interface{}, version ...int64) *StatusCmd {
+	return this.SetWithTTL(table, key, fields, 0, version...)
+}
+
+//ttl:记录过期时间,0使用表格默认值
+func (this *Conn) SetWithTTL(table, key string, fields map[string]interface{}, ttl time.Duration, version ...int64) *StatusCmd {
 
 	if len(fields) == 0 {
 		return nil
 	}
 
-	pbdata := &protocol.SetReq{}
+	pbdata := &protocol.SetReq{
+		Ttl: int64(ttl / time.Millisecond),
+	}
 
 	if len(version) > 0 {
 		pbdata.Version = proto.Int64(version[0])
@@ -181,11 +222,17 @@ func (this *Conn) Set(table, key string, fields map[string]interface{}, version
 
 //如果不存在则设置,否则返回已存在的记录
 func (this *Conn) SetNx(table, key string, fields map[string]interface{}) *SliceCmd {
+	return this.SetNxWithTTL(table, key, fields, 0)
+}
+
+func (this *Conn) SetNxWithTTL(table, key string, fields map[string]interface{}, ttl time.Duration) *SliceCmd {
 	if len(fields) == 0 {
 		return nil
 	}
 
-	pbdata := &protocol.SetNxReq{}
+	pbdata := &protocol.SetNxReq{
+		Ttl: int64(ttl / time.Millisecond),
+	}
 
 	for k, v := range fields {
 		pbdata.Fields = append(pbdata.Fields, protocol.PackField(k, v))
@@ -279,9 +326,45 @@ func (this *Conn) Del(table, key string, version ...int64) *StatusCmd {
 
 }
 
+//将记录的fields重置为默认值
+func (this *Conn) Unset(table, key string, fields ...string) *StatusCmd {
+	return this.unset(table, key, nil, fields)
+}
+
+//同Unset,记录的版本号必须与version一致
+func (this *Conn) UnsetWithVersion(table, key string, version int64, fields ...string) *StatusCmd {
+	return this.unset(table, key, proto.Int64(version), fields)
+}
+
+func (this *Conn) unset(table, key string, version *int64, fields []string) *StatusCmd {
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		UniKey:  table + ":" + key,
+		Timeout: ClientTimeout,
+	}, &protocol.UnsetReq{
+		Version: version,
+		Fields:  fields,
+	})
+
+	return &StatusCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
 func (this *Conn) IncrBy(table, key, field string, value int64, version ...int64) *SliceCmd {
+	return this.IncrByWithTTL(table, key, field, value, 0, version...)
+}
+
+func (this *Conn) IncrByWithTTL(table, key, field string, value int64, ttl time.Duration, version ...int64) *SliceCmd {
 	pbdata := &protocol.IncrByReq{
 		Field: protocol.PackField(field, value),
+		Ttl:   int64(ttl / time.Millisecond),
 	}
 
 	if len(version) > 0 {
@@ -321,6 +404,23 @@ func (this *Conn) DecrBy(table, key, field string, value int64, version ...int64
 	}
 }
 
+//在记录上执行kvnode注册的脚本,Fields为脚本写入的字段
+func (this *Conn) Eval(table, key, script string, args ...string) *SliceCmd {
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		UniKey:  table + ":" + key,
+		Timeout: ClientTimeout,
+	}, &protocol.EvalReq{
+		Name: script,
+		Args: args,
+	})
+
+	return &SliceCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
 func (this *Conn) Kick(table, key string) *StatusCmd {
 	pbdata := &protocol.KickReq{}
 
@@ -336,9 +436,14 @@ func (this *Conn) Kick(table, key string) *StatusCmd {
 	}
 }
 
-func (this *Conn) ReloadTableConf() *StatusCmd {
+//重载表格配置，regions非空时只在节点作为leader的这些region上切换(kvnode转发时使用)
+func (this *Conn) ReloadTableConf(regions ...int) *StatusCmd {
 	pbdata := &protocol.ReloadTableConfReq{}
 
+	for _, v := range regions {
+		pbdata.Regions = append(pbdata.Regions, int32(v))
+	}
+
 	req := net.NewMessage(net.CommonHead{
 		Seqno: atomic.AddInt64(&seqno, 1),
 	}, pbdata)
@@ -349,11 +454,87 @@ func (this *Conn) ReloadTableConf() *StatusCmd {
 	}
 }
 
+//将节点添加到集群的所有region,url为新节点的raft地址
+func (this *Conn) AddMember(nodeID int, url string) *StatusCmd {
+	return this.memberChange(&protocol.MemberChangeReq{
+		NodeId: int32(nodeID),
+		Url:    url,
+	})
+}
+
+//将节点从集群的所有region删除，被删除的节点id不能再次加入
+func (this *Conn) RemoveMember(nodeID int) *StatusCmd {
+	return this.memberChange(&protocol.MemberChangeReq{
+		NodeId: int32(nodeID),
+		Remove: true,
+	})
+}
+
+//将region的leader转移到指定节点，命令需要发往region当前的leader
+func (this *Conn) TransferLeader(region int, nodeID int) *StatusCmd {
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		Timeout: ClientTimeout,
+	}, &protocol.TransferLeaderReq{
+		Region: int32(region),
+		NodeId: int32(nodeID),
+	})
+
+	return &StatusCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
+//将slot迁移到指定region,命令需要发往slot所在region的leader,目标region的leader必须在同一节点
+func (this *Conn) MoveSlot(slot int, region int) *StatusCmd {
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		Timeout: ClientTimeout,
+	}, &protocol.MoveSlotReq{
+		Slot:   int32(slot),
+		Region: int32(region),
+	})
+
+	return &StatusCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
+//通知kvproxy重新加载配置,path只能为空或kvproxy启动时的配置文件
+func (this *Conn) ReloadConfig(path string) *StatusCmd {
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		Timeout: ClientTimeout,
+	}, &protocol.ReloadConfigReq{
+		Path: path,
+	})
+
+	return &StatusCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
+func (this *Conn) memberChange(pbdata *protocol.MemberChangeReq) *StatusCmd {
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		Timeout: ClientTimeout,
+	}, pbdata)
+
+	return &StatusCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
 func (this *Conn) onGetResp(c *cmdContext, errCode int32, resp *protocol.GetResp) {
 
 	ret := SliceResult{
-		ErrCode: errCode,
-		Version: resp.GetVersion(),
+		ErrCode:      errCode,
+		Version:      resp.GetVersion(),
+		AppliedIndex: resp.GetAppliedIndex(),
 	}
 
 	if ret.ErrCode == errcode.ERR_OK {
@@ -436,6 +617,17 @@ func (this *Conn) onDelResp(c *cmdContext, errCode int32, resp *protocol.DelResp
 
 }
 
+func (this *Conn) onUnsetResp(c *cmdContext, errCode int32, resp *protocol.UnsetResp) {
+
+	ret := StatusResult{
+		ErrCode: errCode,
+		Version: resp.GetVersion(),
+	}
+
+	this.c.doCallBack(c.unikey, c.cb, &ret)
+
+}
+
 func (this *Conn) onIncrByResp(c *cmdContext, errCode int32, resp *protocol.IncrByResp) {
 
 	ret := SliceResult{
@@ -467,6 +659,24 @@ func (this *Conn) onDecrByResp(c *cmdContext, errCode int32, resp *protocol.Decr
 
 }
 
+func (this *Conn) onEvalResp(c *cmdContext, errCode int32, resp *protocol.EvalResp) {
+
+	ret := SliceResult{
+		ErrCode: errCode,
+		Version: resp.GetVersion(),
+		Result:  resp.GetResult(),
+	}
+
+	if ret.ErrCode == errcode.ERR_OK {
+		ret.Fields = map[string]*Field{}
+		for _, v := range resp.GetFields() {
+			ret.Fields[v.GetName()] = (*Field)(v)
+		}
+	}
+
+	this.c.doCallBack(c.unikey, c.cb, &ret)
+}
+
 func (this *Conn) onKickResp(c *cmdContext, errCode int32, resp *protocol.KickResp) {
 
 	ret := StatusResult{
@@ -478,6 +688,39 @@ func (this *Conn) onKickResp(c *cmdContext, errCode int32, resp *protocol.KickRe
 }
 
 func (this *Conn) onReloadTableConfResp(c *cmdContext, errCode int32, resp *protocol.ReloadTableConfResp) {
+	ret := StatusResult{
+		ErrCode: errCode,
+		ErrStr:  resp.Err,
+		Version: resp.GetVersion(),
+	}
+	this.c.doCallBack(c.unikey, c.cb, &ret)
+}
+
+func (this *Conn) onMemberChangeResp(c *cmdContext, errCode int32, resp *protocol.MemberChangeResp) {
+	ret := StatusResult{
+		ErrCode: errCode,
+		ErrStr:  resp.Err,
+	}
+	this.c.doCallBack(c.unikey, c.cb, &ret)
+}
+
+func (this *Conn) onTransferLeaderResp(c *cmdContext, errCode int32, resp *protocol.TransferLeaderResp) {
+	ret := StatusResult{
+		ErrCode: errCode,
+		ErrStr:  resp.Err,
+	}
+	this.c.doCallBack(c.unikey, c.cb, &ret)
+}
+
+func (this *Conn) onMoveSlotResp(c *cmdContext, errCode int32, resp *protocol.MoveSlotResp) {
+	ret := StatusResult{
+		ErrCode: errCode,
+		ErrStr:  resp.Err,
+	}
+	this.c.doCallBack(c.unikey, c.cb, &ret)
+}
+
+func (this *Conn) onReloadConfigResp(c *cmdContext, errCode int32, resp *protocol.ReloadConfigResp) {
 	ret := StatusResult{
 		ErrCode: errCode,
 		ErrStr:  resp.Err,
@@ -489,10 +732,18 @@ func (this *Conn) onMessage(msg *net.Message) {
 	this.eventQueue.Post(func() {
 		head := msg.GetHead()
 		cmd := protocol.CmdType(msg.GetCmd())
-		if cmd != protocol.CmdType_Ping {
+		if cmd == protocol.CmdType_WatchNotify {
+			this.onWatchNotify(head.Seqno, head.ErrCode, msg.GetData().(*protocol.WatchNotify))
+		} else if cmd != protocol.CmdType_Ping {
 			ok, ctx := this.timerMgr.CancelByIndex(uint64(head.Seqno))
 			if ok {
 				c := ctx.(*cmdContext)
+				if errcode.ERR_NOT_LEADER == head.ErrCode && this.followLeader(c, head.UniKey) {
+					return
+				}
+				if errcode.ERR_SLOT_VERSION == head.ErrCode && this.resendWithSlotTable(c) {
+					return
+				}
 				switch cmd {
 				case protocol.CmdType_Get:
 					this.onGetResp(c, head.ErrCode, msg.GetData().(*protocol.GetResp))
@@ -506,14 +757,44 @@ func (this *Conn) onMessage(msg *net.Message) {
 					this.onCompareAndSetNxResp(c, head.ErrCode, msg.GetData().(*protocol.CompareAndSetNxResp))
 				case protocol.CmdType_Del:
 					this.onDelResp(c, head.ErrCode, msg.GetData().(*protocol.DelResp))
+				case protocol.CmdType_Unset:
+					this.onUnsetResp(c, head.ErrCode, msg.GetData().(*protocol.UnsetResp))
 				case protocol.CmdType_IncrBy:
 					this.onIncrByResp(c, head.ErrCode, msg.GetData().(*protocol.IncrByResp))
 				case protocol.CmdType_DecrBy:
 					this.onDecrByResp(c, head.ErrCode, msg.GetData().(*protocol.DecrByResp))
 				case protocol.CmdType_Kick:
 					this.onKickResp(c, head.ErrCode, msg.GetData().(*protocol.KickResp))
+				case protocol.CmdType_Preload:
+					this.onPreloadResp(c, head.ErrCode, msg.GetData().(*protocol.PreloadResp))
 				case protocol.CmdType_ReloadTableConf:
 					this.onReloadTableConfResp(c, head.ErrCode, msg.GetData().(*protocol.ReloadTableConfResp))
+				case protocol.CmdType_MemberChange:
+					this.onMemberChangeResp(c, head.ErrCode, msg.GetData().(*protocol.MemberChangeResp))
+				case protocol.CmdType_TransferLeader:
+					this.onTransferLeaderResp(c, head.ErrCode, msg.GetData().(*protocol.TransferLeaderResp))
+				case protocol.CmdType_MoveSlot:
+					this.onMoveSlotResp(c, head.ErrCode, msg.GetData().(*protocol.MoveSlotResp))
+				case protocol.CmdType_GetSlotTable:
+					this.onGetSlotTableResp(c, head.ErrCode, msg.GetData().(*protocol.GetSlotTableResp))
+				case protocol.CmdType_ReloadConfig:
+					this.onReloadConfigResp(c, head.ErrCode, msg.GetData().(*protocol.ReloadConfigResp))
+				case protocol.CmdType_MGet:
+					this.onMGetResp(c, head.ErrCode, msg.GetData().(*protocol.MgetResp))
+				case protocol.CmdType_MSet:
+					this.onMSetResp(c, head.ErrCode, msg.GetData().(*protocol.MsetResp))
+				case protocol.CmdType_Eval:
+					this.onEvalResp(c, head.ErrCode, msg.GetData().(*protocol.EvalResp))
+				case protocol.CmdType_Txn:
+					this.onTxnResp(c, head.ErrCode, msg.GetData().(*protocol.TxnResp))
+				case protocol.CmdType_GetByIndex:
+					this.onGetByIndexResp(c, head.ErrCode, msg.GetData().(*protocol.GetByIndexResp))
+				case protocol.CmdType_Scan:
+					this.onScanResp(c, head.ErrCode, msg.GetData().(*protocol.ScanResp))
+				case protocol.CmdType_Watch:
+					this.onWatchResp(c, head.ErrCode, msg.GetData().(*protocol.WatchResp))
+				case protocol.CmdType_UnWatch:
+					this.onUnwatchResp(c, head.ErrCode, msg.GetData().(*protocol.UnwatchResp))
 				default:
 				}
 			}
@@ -14,7 +14,10 @@ import (
 	"time"
 )
 
-const maxPendingSize int = 10000
+const (
+	maxPendingSize int = 10000
+	maxLeaderHops  int = 3 //按leader提示转发的最大次数
+)
 
 type Conn struct {
 	session     kendynet.StreamSession
@@ -25,6 +28,7 @@ type Conn struct {
 	c           *Client
 	nextPing    time.Time
 	timerMgr    *timer.TimerMgr
+	watchers    map[int64]*cmdContext //watch seqno -> watch请求
 }
 
 func openConn(cli *Client, addr string) *Conn {
@@ -35,6 +39,7 @@ func openConn(cli *Client, addr string) *Conn {
 		c:           cli,
 		nextPing:    time.Now().Add(protocol.PingTime),
 		timerMgr:    timer.NewTimerMgr(1),
+		watchers:    map[int64]*cmdContext{},
 	}
 	go c.eventQueue.Run()
 	return c
@@ -89,6 +94,7 @@ func (this *Conn) onConnected(session kendynet.StreamSession, compress bool) {
 func (this *Conn) onDisconnected() {
 	this.eventQueue.Post(func() {
 		this.session = nil
+		this.clearWatcher(errcode.ERR_CONNECTION)
 	})
 }
 
@@ -115,32 +121,133 @@ func (this *Conn) dial() {
 }
 
 func (this *Conn) sendReq(c *cmdContext) {
+	if _, ok := c.req.GetData().(*protocol.GetSlotTableReq); !ok {
+		c.req.SetSlotVersion(this.c.getSlotVersion())
+	}
 	this.session.Send(c.req)
 }
 
 func (this *Conn) onTimeout(_ *timer.Timer, ctx interface{}) {
 	c := ctx.(*cmdContext)
 	c.isTimeouted = true
+	this.removeWatcher(c)
 	this.c.doCallBack(c.unikey, c.cb, errcode.ERR_TIMEOUT)
 }
 
+/*
+ * 取消尚未返回的请求，回调以ERR_CANCEL返回。
+ * 服务端丢弃尚未开始执行的请求，已经开始执行的请求无法撤销
+ */
+func (this *Conn) Cancel(seqnos ...int64) {
+	this.eventQueue.Post(func() {
+		seqs := []int64{}
+		for _, v := range seqnos {
+			ok, ctx := this.timerMgr.CancelByIndex(uint64(v))
+			if ok {
+				c := ctx.(*cmdContext)
+				//尚在pendingSend中的请求不再发送
+				c.isTimeouted = true
+				this.removeWatcher(c)
+				seqs = append(seqs, v)
+				this.c.doCallBack(c.unikey, c.cb, errcode.ERR_CANCEL)
+			}
+		}
+
+		if len(seqs) > 0 && nil != this.session {
+			this.session.Send(net.NewMessage(net.CommonHead{}, &protocol.Cancel{
+				Seqs: seqs,
+			}))
+		}
+	})
+}
+
 func (this *Conn) exec(c *cmdContext) {
 	this.eventQueue.Post(func() {
 		c.deadline = time.Now().Add(time.Duration(ClientTimeout) * time.Millisecond)
-		if nil == this.session && !this.dialing {
-			this.dial()
+		if nil != c.watch {
+			//先注册，变更通知可能先于响应到达
+			this.watchers[c.req.GetHead().Seqno] = c
 		}
+		this.doExec(c, time.Duration(ClientTimeout)*time.Millisecond)
+	})
+}
 
-		if this.dialing {
-			if len(this.pendingSend) < maxPendingSize {
-				this.timerMgr.OnceWithIndex(time.Duration(ClientTimeout)*time.Millisecond, this.eventQueue, this.onTimeout, c, uint64(c.req.GetHead().Seqno))
-				this.pendingSend = append(this.pendingSend, c)
-			} else {
-				this.c.doCallBack(c.unikey, c.cb, errcode.ERR_BUSY)
-			}
+func (this *Conn) doExec(c *cmdContext, timeout time.Duration) {
+	if nil == this.session && !this.dialing {
+		this.dial()
+	}
+
+	if this.dialing {
+		if len(this.pendingSend) < maxPendingSize {
+			this.timerMgr.OnceWithIndex(timeout, this.eventQueue, this.onTimeout, c, uint64(c.req.GetHead().Seqno))
+			this.pendingSend = append(this.pendingSend, c)
 		} else {
-			this.timerMgr.OnceWithIndex(time.Duration(ClientTimeout)*time.Millisecond, this.eventQueue, this.onTimeout, c, uint64(c.req.GetHead().Seqno))
-			this.sendReq(c)
+			this.removeWatcher(c)
+			this.c.doCallBack(c.unikey, c.cb, errcode.ERR_BUSY)
 		}
+	} else {
+		this.timerMgr.OnceWithIndex(timeout, this.eventQueue, this.onTimeout, c, uint64(c.req.GetHead().Seqno))
+		this.sendReq(c)
+	}
+}
+
+/*
+ * 失去leadership时尚未提交的写入仍然可能被新leader提交，ERR_NOT_LEADER不代表请求没有执行。
+ * 只有Get以及携带version的写命令(已经执行的写入转发后版本号不再匹配)可以再次发送
+ */
+func canResend(req interface{}) bool {
+	switch req.(type) {
+	case *protocol.GetReq:
+		return true
+	case *protocol.SetReq:
+		return nil != req.(*protocol.SetReq).Version
+	case *protocol.SetNxReq:
+		return nil != req.(*protocol.SetNxReq).Version
+	case *protocol.IncrByReq:
+		return nil != req.(*protocol.IncrByReq).Version
+	case *protocol.DecrByReq:
+		return nil != req.(*protocol.DecrByReq).Version
+	case *protocol.CompareAndSetReq:
+		return nil != req.(*protocol.CompareAndSetReq).Version
+	case *protocol.CompareAndSetNxReq:
+		return nil != req.(*protocol.CompareAndSetNxReq).Version
+	case *protocol.DelReq:
+		return nil != req.(*protocol.DelReq).Version
+	case *protocol.UnsetReq:
+		return nil != req.(*protocol.UnsetReq).Version
+	default:
+		return false
+	}
+}
+
+/*
+ * 按ERR_NOT_LEADER响应中的leader提示把请求转发到leader所在节点，使用原请求剩余的超时时间
+ * watch请求、不能重复执行的命令以及超过maxLeaderHops次的请求不转发，直接返回ERR_NOT_LEADER
+ * 已经转发的请求不能再通过Cancel取消
+ */
+func (this *Conn) followLeader(c *cmdContext, hint string) bool {
+	if nil != c.watch || c.hops >= maxLeaderHops || !canResend(c.req.GetData()) {
+		return false
+	}
+
+	_, service, ok := net.ParseLeaderHint(hint)
+	if !ok || "" == service || service == this.addr {
+		return false
+	}
+
+	timeout := c.deadline.Sub(time.Now())
+	if timeout <= 0 {
+		return false
+	}
+
+	c.hops++
+
+	logger.Debugln("follow leader", c.unikey, this.addr, "->", service)
+
+	conn := this.c.getConn(service)
+	conn.eventQueue.Post(func() {
+		conn.doExec(c, timeout)
 	})
+
+	return true
 }
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"github.com/sniperHW/flyfish/net"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"sync/atomic"
+)
+
+/*
+ * 按二级索引查询,返回指定字段等于value的记录,Rows按key排序,只包含Key和Version
+ * field必须在table_conf中声明为index。一次最多返回1000条，获取所有记录使用IndexScaner
+ */
+func (this *Conn) GetByIndex(table, field string, value interface{}) *MutiCmd {
+
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		UniKey:  mutiUniKey(table, field),
+		Timeout: ClientTimeout,
+	}, &protocol.GetByIndexReq{
+		Table: table,
+		Value: protocol.PackField(field, value),
+	})
+
+	return &MutiCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
+func (this *Conn) onGetByIndexResp(c *cmdContext, errCode int32, resp *protocol.GetByIndexResp) {
+	ret := MutiResult{
+		ErrCode: errCode,
+		cursor:  resp.GetCursor(),
+		finish:  resp.GetFinish(),
+	}
+
+	for _, v := range resp.GetRows() {
+		ret.Rows = append(ret.Rows, &Row{
+			Key:     v.GetKey(),
+			Version: v.GetVersion(),
+		})
+	}
+
+	this.c.doCallBack(c.unikey, c.cb, &ret)
+}
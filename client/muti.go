@@ -0,0 +1,179 @@
+package client
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"sync/atomic"
+)
+
+/*
+ * 服务端批量命令,一次请求处理同一张表的多个key,Rows的顺序与请求中key的顺序一致
+ */
+
+type MutiCmd struct {
+	conn *Conn
+	req  *net.Message
+}
+
+func (this *MutiCmd) asyncExec(syncFlag bool, cb func(*MutiResult)) {
+	context := &cmdContext{
+		cb: callback{
+			tt:   cb_muti,
+			cb:   cb,
+			sync: syncFlag,
+		},
+		unikey: this.req.GetHead().UniKey,
+		req:    this.req,
+	}
+	this.conn.exec(context)
+}
+
+//请求的seqno,用于Cancel
+func (this *MutiCmd) Seqno() int64 {
+	return this.req.GetHead().Seqno
+}
+
+func (this *MutiCmd) AsyncExec(cb func(*MutiResult)) {
+	this.asyncExec(false, cb)
+}
+
+func (this *MutiCmd) Exec() *MutiResult {
+	respChan := make(chan *MutiResult)
+	this.asyncExec(true, func(r *MutiResult) {
+		respChan <- r
+	})
+	return <-respChan
+}
+
+type MSetItem struct {
+	Key     string
+	Fields  map[string]interface{}
+	Version *int64 //非nil时校验版本号
+}
+
+//unikey使用第一个key,kvproxy按region拆分后分别转发到各region的leader。直接连接kvnode时不属于其leader region的key返回ERR_NOT_LEADER
+func mutiUniKey(table string, key string) string {
+	return table + ":" + key
+}
+
+func (this *Conn) MGet(table string, keys []string, fields ...string) *MutiCmd {
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	//没有指定fields则获取所有字段
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		UniKey:  mutiUniKey(table, keys[0]),
+		Timeout: ClientTimeout,
+	}, &protocol.MgetReq{
+		Table:  table,
+		Keys:   keys,
+		Fields: fields,
+		All:    len(fields) == 0,
+	})
+
+	return &MutiCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
+func (this *Conn) MGetAll(table string, keys ...string) *MutiCmd {
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		UniKey:  mutiUniKey(table, keys[0]),
+		Timeout: ClientTimeout,
+	}, &protocol.MgetReq{
+		Table: table,
+		Keys:  keys,
+		All:   true,
+	})
+
+	return &MutiCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
+func (this *Conn) MSet(table string, items ...*MSetItem) *MutiCmd {
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	pbdata := &protocol.MsetReq{
+		Table: table,
+	}
+
+	for _, v := range items {
+		item := &protocol.MsetItem{
+			Key: v.Key,
+		}
+
+		if nil != v.Version {
+			item.Version = proto.Int64(*v.Version)
+		}
+
+		for kk, vv := range v.Fields {
+			item.Fields = append(item.Fields, protocol.PackField(kk, vv))
+		}
+
+		pbdata.Items = append(pbdata.Items, item)
+	}
+
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		UniKey:  mutiUniKey(table, items[0].Key),
+		Timeout: ClientTimeout,
+	}, pbdata)
+
+	return &MutiCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
+func (this *Conn) onMutiResp(c *cmdContext, errCode int32, rows []*protocol.Row) {
+	ret := MutiResult{
+		ErrCode: errCode,
+	}
+
+	//批量命令出错时不返回rows,事务条件不满足时返回每个op的检查结果
+	if ret.ErrCode == errcode.ERR_OK || len(rows) > 0 {
+		for _, v := range rows {
+			row := &Row{
+				Key:     v.GetKey(),
+				Version: v.GetVersion(),
+				ErrCode: v.GetErrCode(),
+			}
+
+			if len(v.GetFields()) > 0 {
+				row.Fields = map[string]*Field{}
+				for _, vv := range v.GetFields() {
+					row.Fields[vv.GetName()] = (*Field)(vv)
+				}
+			}
+
+			ret.Rows = append(ret.Rows, row)
+		}
+	}
+
+	this.c.doCallBack(c.unikey, c.cb, &ret)
+}
+
+func (this *Conn) onMGetResp(c *cmdContext, errCode int32, resp *protocol.MgetResp) {
+	this.onMutiResp(c, errCode, resp.GetRows())
+}
+
+func (this *Conn) onMSetResp(c *cmdContext, errCode int32, resp *protocol.MsetResp) {
+	this.onMutiResp(c, errCode, resp.GetRows())
+}
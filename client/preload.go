@@ -0,0 +1,112 @@
+package client
+
+import (
+	"github.com/sniperHW/flyfish/net"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"sync/atomic"
+)
+
+/*
+ * 缓存预热(管理命令,直接发往kvnode)
+ * kvnode启动后台任务，只加载本节点作为leader的store中的记录，返回的Job用于PreloadStatus查询进度
+ */
+
+type PreloadResult struct {
+	ErrCode int32
+	ErrStr  string
+	Job     int64
+	Scanned int64 //已从数据库读取的记录数
+	Loaded  int64 //已加载到缓存的记录数
+	Skipped int64 //已在缓存中，不是leader或超出缓存容量而跳过的记录数
+	Finish  bool
+}
+
+type PreloadCmd struct {
+	conn *Conn
+	req  *net.Message
+}
+
+func (this *PreloadCmd) asyncExec(syncFlag bool, cb func(*PreloadResult)) {
+	context := &cmdContext{
+		cb: callback{
+			tt:   cb_preload,
+			cb:   cb,
+			sync: syncFlag,
+		},
+		unikey: this.req.GetHead().UniKey,
+		req:    this.req,
+	}
+	this.conn.exec(context)
+}
+
+func (this *PreloadCmd) AsyncExec(cb func(*PreloadResult)) {
+	this.asyncExec(false, cb)
+}
+
+func (this *PreloadCmd) Exec() *PreloadResult {
+	respChan := make(chan *PreloadResult)
+	this.asyncExec(true, func(r *PreloadResult) {
+		respChan <- r
+	})
+	return <-respChan
+}
+
+func (this *Conn) preload(pbdata *protocol.PreloadReq) *PreloadCmd {
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		Timeout: ClientTimeout,
+	}, pbdata)
+
+	return &PreloadCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
+//预加载指定的key
+func (this *Conn) PreloadKeys(table string, keys ...string) *PreloadCmd {
+	if len(keys) == 0 {
+		return nil
+	}
+	return this.preload(&protocol.PreloadReq{
+		Table: table,
+		Keys:  keys,
+	})
+}
+
+//预加载[begin,end)范围内的key,end为空表示不限制
+func (this *Conn) PreloadRange(table, begin, end string) *PreloadCmd {
+	return this.preload(&protocol.PreloadReq{
+		Table: table,
+		Begin: begin,
+		End:   end,
+	})
+}
+
+//预加载整张表
+func (this *Conn) PreloadAll(table string) *PreloadCmd {
+	return this.preload(&protocol.PreloadReq{
+		Table: table,
+		All:   true,
+	})
+}
+
+//查询预加载任务的进度
+func (this *Conn) PreloadStatus(job int64) *PreloadCmd {
+	return this.preload(&protocol.PreloadReq{
+		Job: job,
+	})
+}
+
+func (this *Conn) onPreloadResp(c *cmdContext, errCode int32, resp *protocol.PreloadResp) {
+	ret := PreloadResult{
+		ErrCode: errCode,
+		ErrStr:  resp.GetErr(),
+		Job:     resp.GetJob(),
+		Scanned: resp.GetScanned(),
+		Loaded:  resp.GetLoaded(),
+		Skipped: resp.GetSkipped(),
+		Finish:  resp.GetFinish(),
+	}
+	this.c.doCallBack(c.unikey, c.cb, &ret)
+}
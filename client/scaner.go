@@ -1,98 +1,128 @@
 package client
 
-/*
 import (
 	"fmt"
 	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
 	protocol "github.com/sniperHW/flyfish/proto"
 	"sync/atomic"
+)
 
-	//"github.com/golang/protobuf/proto"
-	"github.com/sniperHW/kendynet/event"
-	"github.com/sniperHW/kendynet/util"
+var (
+	ErrScanFinish  = fmt.Errorf("scan finish")
+	ErrScanPending = fmt.Errorf("previous next not return")
 )
 
+/*
+ * 按__key__的字节序遍历表格,服务端不保存遍历状态,Scaner记录cursor用于下一次请求
+ * 同一时刻只能有一个Next在执行
+ */
 type Scaner struct {
-	conn          *Conn
-	callbackQueue *event.EventQueue //响应回调的事件队列
-	table         string
-	fileds        []string
-	getAll        bool //获取所有字段
-	first         int32
-	closed        int32
+	conn    *Conn
+	table   string
+	fields  []string
+	getAll  bool            //获取所有字段
+	index   *protocol.Field //按索引查询时的索引字段及值
+	cursor  string
+	finish  int32
+	pending int32
 }
 
 //如果不传fields表示getAll
-func (this *Client) Scaner(table string, fileds ...string) *Scaner {
-	s := &Scaner{
-		callbackQueue: this.callbackQueue,
-		table:         table,
-		fileds:        fileds,
+func (this *Client) Scaner(table string, fields ...string) *Scaner {
+	return &Scaner{
+		conn:   this.conn,
+		table:  table,
+		fields: fields,
+		getAll: len(fields) == 0,
 	}
-	if len(fileds) == 0 {
-		s.getAll = true
-	}
-	s.conn = openConn(this, this.service)
-	return s
 }
 
-func (this *Scaner) wrapCb(cb func(*Scaner, *MutiResult)) func(*MutiResult) {
-	return func(r *MutiResult) {
-		defer util.Recover(logger)
-		cb(this, r)
+//按key的顺序分批获取索引字段field等于value的记录(Rows只包含Key及Version)
+func (this *Client) IndexScaner(table, field string, value interface{}) *Scaner {
+	return &Scaner{
+		conn:  this.conn,
+		table: table,
+		index: protocol.PackField(field, value),
 	}
 }
 
-func (this *Scaner) AsyncNext(count int32, cb func(*Scaner, *MutiResult)) error {
+func (this *Scaner) Finish() bool {
+	return atomic.LoadInt32(&this.finish) == 1
+}
 
-	if atomic.LoadInt32(&this.closed) == 1 {
-		return fmt.Errorf("closed")
+func (this *Scaner) onResult(r *MutiResult) {
+	if r.ErrCode == errcode.ERR_OK {
+		this.cursor = r.cursor
+		if r.finish {
+			atomic.StoreInt32(&this.finish, 1)
+		}
 	}
+	atomic.StoreInt32(&this.pending, 0)
+}
 
-	if count <= 0 {
-		count = 50
+func (this *Scaner) asyncNext(syncFlag bool, count int32, cb func(*Scaner, *MutiResult)) error {
+
+	if this.Finish() {
+		return ErrScanFinish
 	}
 
-	req := &protocol.ScanReq{
-		Head: &protocol.ReqCommon{
-			Seqno:       atomic.AddInt64(&this.conn.seqno, 1), //proto.Int64(atomic.AddInt64(&this.conn.seqno, 1)),
-			Timeout:     int64(ServerTimeout),                 //proto.Int64(int64(ServerTimeout)),
-			RespTimeout: int64(ClientTimeout),                 //proto.Int64(int64(ClientTimeout)),
-		},
+	if !atomic.CompareAndSwapInt32(&this.pending, 0, 1) {
+		return ErrScanPending
 	}
-	if atomic.CompareAndSwapInt32(&this.first, 0, 1) {
-		req.Head.Table = this.table //proto.String(this.table)
-		if this.getAll {
-			req.All = true //proto.Bool(true)
-		} else {
-			req.All = false //proto.Bool(false)
-			req.Fields = []string{}
-			for _, v := range this.fileds {
-				req.Fields = append(req.Fields, v)
-			}
-			if len(req.Fields) == 0 {
-				req.All = true //proto.Bool(true)
-			}
-		}
+
+	var req *net.Message
+
+	if nil != this.index {
+		req = net.NewMessage(net.CommonHead{
+			Seqno:   atomic.AddInt64(&seqno, 1),
+			UniKey:  mutiUniKey(this.table, this.index.GetName()),
+			Timeout: ClientTimeout,
+		}, &protocol.GetByIndexReq{
+			Table:  this.table,
+			Value:  this.index,
+			Count:  count,
+			Cursor: this.cursor,
+		})
+	} else {
+		req = net.NewMessage(net.CommonHead{
+			Seqno:   atomic.AddInt64(&seqno, 1),
+			UniKey:  this.table + ":" + this.cursor,
+			Timeout: ClientTimeout,
+		}, &protocol.ScanReq{
+			Table:  this.table,
+			Fields: this.fields,
+			All:    this.getAll,
+			Count:  count,
+			Cursor: this.cursor,
+		})
 	}
-	req.Count = count //proto.Int32(count)
 
 	context := &cmdContext{
-		seqno: req.Head.GetSeqno(),
 		cb: callback{
 			tt: cb_muti,
-			cb: this.wrapCb(cb),
+			cb: func(r *MutiResult) {
+				this.onResult(r)
+				cb(this, r)
+			},
+			sync: syncFlag,
 		},
-		req: req,
+		unikey: req.GetHead().UniKey,
+		req:    req,
 	}
+
 	this.conn.exec(context)
 
 	return nil
 }
 
+func (this *Scaner) AsyncNext(count int32, cb func(*Scaner, *MutiResult)) error {
+	return this.asyncNext(false, count, cb)
+}
+
 func (this *Scaner) Next(count int32) (*MutiResult, error) {
 	respChan := make(chan *MutiResult)
-	err := this.AsyncNext(count, func(s *Scaner, r *MutiResult) {
+	err := this.asyncNext(true, count, func(_ *Scaner, r *MutiResult) {
 		respChan <- r
 	})
 	if nil != err {
@@ -101,36 +131,26 @@ func (this *Scaner) Next(count int32) (*MutiResult, error) {
 	return <-respChan, nil
 }
 
-func (this *Scaner) Close() {
-	if atomic.CompareAndSwapInt32(&this.closed, 0, 1) {
-		this.conn.Close()
+func (this *Conn) onScanResp(c *cmdContext, errCode int32, resp *protocol.ScanResp) {
+	ret := MutiResult{
+		ErrCode: errCode,
+		cursor:  resp.GetCursor(),
+		finish:  resp.GetFinish(),
 	}
-}
 
-func (this *Conn) onScanResp(resp *protocol.ScanResp) {
-	c := this.removeContext(resp.Head.GetSeqno())
-	if nil != c {
-		ret := MutiResult{
-			ErrCode: resp.Head.GetErrCode(),
-			Rows:    []*Row{},
+	for _, v := range resp.GetRows() {
+		r := &Row{
+			Key:     v.GetKey(),
+			Version: v.GetVersion(),
+			Fields:  map[string]*Field{},
 		}
 
-		if ret.ErrCode == errcode.ERR_OK {
-			for _, v := range resp.GetRows() {
-				fields := v.GetFields()
-				r := &Row{
-					Key:     v.GetKey(),
-					Version: v.GetVersion(),
-					Fields:  map[string]*Field{},
-				}
-
-				for _, field := range fields {
-					r.Fields[field.GetName()] = (*Field)(field)
-				}
-
-				ret.Rows = append(ret.Rows, r)
-			}
+		for _, field := range v.GetFields() {
+			r.Fields[field.GetName()] = (*Field)(field)
 		}
-		this.c.doCallBack(c.cb, &ret)
+
+		ret.Rows = append(ret.Rows, r)
 	}
-}*/
+
+	this.c.doCallBack(c.unikey, c.cb, &ret)
+}
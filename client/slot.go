@@ -0,0 +1,128 @@
+package client
+
+import (
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/partition"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ * 获取kvnode的slot表(管理命令,直接发往kvnode)
+ * 可以用Table.GetRegion计算key所属region,获取到的slot表保存在Client中，之后的请求在包尾携带其版本(net.FlagSlotVersion)。
+ * kvnode的slot表比请求新时返回ERR_SLOT_VERSION(请求没有执行),client从该连接重新获取slot表后使用剩余的超时时间再次发送。
+ * 每个请求只重发一次，watch请求不重发。
+ */
+
+type SlotTableResult struct {
+	ErrCode int32
+	Table   *partition.Table
+}
+
+type SlotTableCmd struct {
+	conn *Conn
+	req  *net.Message
+}
+
+func (this *SlotTableCmd) asyncExec(syncFlag bool, cb func(*SlotTableResult)) {
+	context := &cmdContext{
+		cb: callback{
+			tt:   cb_slot_table,
+			cb:   cb,
+			sync: syncFlag,
+		},
+		req: this.req,
+	}
+	this.conn.exec(context)
+}
+
+func (this *SlotTableCmd) AsyncExec(cb func(*SlotTableResult)) {
+	this.asyncExec(false, cb)
+}
+
+func (this *SlotTableCmd) Exec() *SlotTableResult {
+	respChan := make(chan *SlotTableResult)
+	this.asyncExec(true, func(r *SlotTableResult) {
+		respChan <- r
+	})
+	return <-respChan
+}
+
+func (this *Conn) GetSlotTable() *SlotTableCmd {
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		Timeout: ClientTimeout,
+	}, &protocol.GetSlotTableReq{})
+
+	return &SlotTableCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
+func (this *Conn) onGetSlotTableResp(c *cmdContext, errCode int32, resp *protocol.GetSlotTableResp) {
+	ret := SlotTableResult{
+		ErrCode: errCode,
+	}
+
+	if len(resp.GetRegions()) > 0 {
+		regions := make([]int, 0, len(resp.GetRegions()))
+		for _, v := range resp.GetRegions() {
+			regions = append(regions, int(v))
+		}
+		ret.Table = partition.NewWithRegions(resp.GetVersion(), regions)
+		this.c.setSlotTable(ret.Table)
+	}
+
+	this.c.doCallBack(c.unikey, c.cb, &ret)
+}
+
+//只接受更新的slot表
+func (this *Client) setSlotTable(table *partition.Table) {
+	this.muSlot.Lock()
+	defer this.muSlot.Unlock()
+	if nil == this.slotTable || table.Version() > this.slotTable.Version() || table.SlotCount() != this.slotTable.SlotCount() {
+		this.slotTable = table
+	}
+}
+
+//返回最近获取的slot表，尚未获取时返回nil
+func (this *Client) SlotTable() *partition.Table {
+	this.muSlot.Lock()
+	defer this.muSlot.Unlock()
+	return this.slotTable
+}
+
+//请求携带的slot表版本,尚未获取slot表时为0(kvnode不检查)
+func (this *Client) getSlotVersion() int64 {
+	if table := this.SlotTable(); nil != table {
+		return table.Version()
+	}
+	return 0
+}
+
+//收到ERR_SLOT_VERSION,更新slot表后重发请求，返回false表示不重发
+func (this *Conn) resendWithSlotTable(c *cmdContext) bool {
+	if nil != c.watch || c.slotRetried || c.deadline.Sub(time.Now()) <= 0 {
+		return false
+	}
+
+	c.slotRetried = true
+
+	logger.Debugln("slot version expired", c.unikey, this.addr)
+
+	this.GetSlotTable().AsyncExec(func(r *SlotTableResult) {
+		//slot表已经在onGetSlotTableResp中更新，获取失败时以原版本重发，由kvnode再次返回ERR_SLOT_VERSION
+		this.eventQueue.Post(func() {
+			if timeout := c.deadline.Sub(time.Now()); timeout > 0 {
+				this.doExec(c, timeout)
+			} else {
+				this.c.doCallBack(c.unikey, c.cb, errcode.ERR_TIMEOUT)
+			}
+		})
+	})
+
+	return true
+}
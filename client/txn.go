@@ -0,0 +1,75 @@
+package client
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/sniperHW/flyfish/net"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"sync/atomic"
+)
+
+/*
+ * 事务,所有key必须属于同一个region,op的Table为空时使用Txn的table
+ * 所有op的条件都满足时才执行全部写入,否则ErrCode为第一个不满足条件的op的错误码,Rows中是每个op的检查结果
+ */
+
+type TxnOp struct {
+	Table   string //为空时使用Txn的table
+	Key     string
+	Version *int64                 //非nil时要求记录的版本号一致
+	Cmp     map[string]interface{} //要求记录的字段值与之相等
+	Fields  map[string]interface{} //条件满足时设置的字段
+	Del     bool                   //条件满足时删除记录
+}
+
+func (this *Conn) Txn(table string, ops ...*TxnOp) *MutiCmd {
+
+	if len(ops) == 0 {
+		return nil
+	}
+
+	pbdata := &protocol.TxnReq{
+		Table: table,
+	}
+
+	for _, v := range ops {
+		op := &protocol.TxnOp{
+			Table: v.Table,
+			Key:   v.Key,
+			Del:   v.Del,
+		}
+
+		if nil != v.Version {
+			op.Version = proto.Int64(*v.Version)
+		}
+
+		for kk, vv := range v.Cmp {
+			op.Cmp = append(op.Cmp, protocol.PackField(kk, vv))
+		}
+
+		for kk, vv := range v.Fields {
+			op.Fields = append(op.Fields, protocol.PackField(kk, vv))
+		}
+
+		pbdata.Ops = append(pbdata.Ops, op)
+	}
+
+	first := table
+	if "" != ops[0].Table {
+		first = ops[0].Table
+	}
+
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		UniKey:  mutiUniKey(first, ops[0].Key),
+		Timeout: ClientTimeout,
+	}, pbdata)
+
+	return &MutiCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
+func (this *Conn) onTxnResp(c *cmdContext, errCode int32, resp *protocol.TxnResp) {
+	this.onMutiResp(c, errCode, resp.GetRows())
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"sync/atomic"
+)
+
+/*
+ * 关注记录的变更，Watch成功后记录每次变更都会调用cb。
+ * 关注只在连接及服务端leader不变(且记录所在slot没有迁出)的情况下有效，失效时cb以ErrCode != ERR_OK被调用，需要重新Watch。
+ * 通过cmd.Seqno()获得关注的标识，用于Unwatch
+ */
+func (this *Conn) Watch(table, key string, cb func(*WatchEvent)) *StatusCmd {
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		UniKey:  table + ":" + key,
+		Timeout: ClientTimeout,
+	}, &protocol.WatchReq{})
+
+	return &StatusCmd{
+		conn: this,
+		req:  req,
+		watch: &callback{
+			tt: cb_watch,
+			cb: cb,
+		},
+	}
+}
+
+func (this *Conn) Unwatch(table, key string, watchSeqno int64) *StatusCmd {
+	req := net.NewMessage(net.CommonHead{
+		Seqno:   atomic.AddInt64(&seqno, 1),
+		UniKey:  table + ":" + key,
+		Timeout: ClientTimeout,
+	}, &protocol.UnwatchReq{
+		WatchSeqno: watchSeqno,
+	})
+
+	this.eventQueue.Post(func() {
+		delete(this.watchers, watchSeqno)
+	})
+
+	return &StatusCmd{
+		conn: this,
+		req:  req,
+	}
+}
+
+func (this *Conn) removeWatcher(c *cmdContext) {
+	if nil != c.watch {
+		delete(this.watchers, c.req.GetHead().Seqno)
+	}
+}
+
+//所有关注失效
+func (this *Conn) clearWatcher(errCode int32) {
+	watchers := this.watchers
+	this.watchers = map[int64]*cmdContext{}
+	for _, v := range watchers {
+		this.c.doCallBack(v.unikey, *v.watch, errCode)
+	}
+}
+
+func (this *Conn) onWatchResp(c *cmdContext, errCode int32, resp *protocol.WatchResp) {
+	if errCode != errcode.ERR_OK {
+		this.removeWatcher(c)
+	}
+	ret := StatusResult{
+		ErrCode: errCode,
+	}
+	this.c.doCallBack(c.unikey, c.cb, &ret)
+}
+
+func (this *Conn) onUnwatchResp(c *cmdContext, errCode int32, resp *protocol.UnwatchResp) {
+	ret := StatusResult{
+		ErrCode: errCode,
+	}
+	this.c.doCallBack(c.unikey, c.cb, &ret)
+}
+
+func (this *Conn) onWatchNotify(seqno int64, errCode int32, notify *protocol.WatchNotify) {
+	c, ok := this.watchers[seqno]
+	if !ok {
+		return
+	}
+
+	if errCode != errcode.ERR_OK {
+		delete(this.watchers, seqno)
+		this.c.doCallBack(c.unikey, *c.watch, errCode)
+		return
+	}
+
+	ret := &WatchEvent{
+		ErrCode: errCode,
+		Version: notify.GetVersion(),
+		Del:     notify.GetDel(),
+		Fields:  map[string]*Field{},
+	}
+
+	for _, v := range notify.GetFields() {
+		ret.Fields[v.GetName()] = (*Field)(v)
+	}
+
+	this.c.doCallBack(c.unikey, *c.watch, ret)
+}
@@ -41,8 +41,11 @@ func GetConfig() *Config {
 }
 
 type Config struct {
-	CacheGroupSize       int
-	MaxCachePerGroupSize int
+	CacheGroupSize          int
+	MaxCachePerGroupSize    int
+	MaxTTLCachePerGroupSize int //每组带过期时间的key数量上限,默认等于MaxCachePerGroupSize
+	SlotCount               int //slot数量,默认SlotRegions*1024,只在首次启动时生效
+	SlotRegions             int //初始拥有slot的region数量,默认CacheGroupSize,只在首次启动时生效
 
 	SqlLoadPipeLineSize int
 	SqlLoadQueueSize    int
@@ -59,6 +62,8 @@ type Config struct {
 	BatchCount            int
 	ProposalFlushInterval int
 	ReadFlushInterval     int
+	LeaseRead             bool   //leader持有读租约时Get直接从内存返回，不发起ReadIndex
+	Kvpd                  string //kvpd服务地址,逗号分隔,为空时不上报
 
 	DBConfig struct {
 		SqlType string
@@ -76,6 +81,18 @@ type Config struct {
 		ConfDataBase   string
 	}
 
+	CDC struct {
+		Enable      bool   //是否输出变更记录
+		Dir         string //变更记录目录，按节点id及region分为子目录
+		MaxFileSize int    //单个文件大小上限
+		MaxFiles    int    //每个region保留的文件数量,0表示不删除
+	}
+
+	Script struct {
+		Dir     string //脚本目录，文件名(不含.lua)为脚本名
+		Timeout int    //单次执行超时(毫秒)
+	}
+
 	Log struct {
 		MaxLogfileSize  int
 		LogDir          string
@@ -4,6 +4,7 @@ import (
 	"github.com/sniperHW/flyfish/proto"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestLoadDef(t *testing.T) {
@@ -34,3 +35,137 @@ func TestLoadDef(t *testing.T) {
 	assert.Nil(t, meta.GetTableMeta("users2"))
 
 }
+
+func TestReloadWithVersion(t *testing.T) {
+
+	defs := []string{
+		"users1@age:int:0,phone:string:123,name:string:haha,blob:blob:",
+	}
+
+	meta, _ := NewDBMeta(defs)
+
+	assert.NotNil(t, meta)
+
+	assert.Equal(t, int64(1), meta.GetTableMeta("users1").Version())
+
+	clone := meta.Clone()
+
+	newDefs := []string{
+		"users1@age:int:0,phone:string:123,name:string:haha,blob:blob:,aa:int:0",
+	}
+
+	assert.Nil(t, meta.ReloadWithVersion(newDefs, 5))
+
+	assert.Equal(t, int64(5), meta.GetVersion())
+
+	assert.Equal(t, true, meta.CheckMetaVersion(meta.GetTableMeta("users1").Version()))
+
+	assert.Equal(t, newDefs, meta.GetDef())
+
+	assert.NotNil(t, meta.GetTableMeta("users1").GetFieldMetas()["aa"])
+
+	//clone不受影响
+	assert.Equal(t, int64(1), clone.GetVersion())
+
+	assert.Nil(t, clone.GetTableMeta("users1").GetFieldMetas()["aa"])
+
+	assert.NotNil(t, meta.ReloadWithVersion([]string{"users1@age:int"}, 6))
+
+	assert.Equal(t, int64(5), meta.GetVersion())
+}
+
+func TestDefVersion(t *testing.T) {
+	a := "users1@age:int:0,phone:string:123"
+	b := "counter@c:int:0"
+
+	//与顺序无关
+	assert.Equal(t, DefVersion([]string{a, b}), DefVersion([]string{b, a}))
+
+	assert.NotEqual(t, DefVersion([]string{a, b}), DefVersion([]string{a}))
+
+	assert.True(t, DefVersion([]string{a}) > 0)
+}
+
+func TestTableTTL(t *testing.T) {
+
+	defs := []string{
+		"users1@age:int:0,phone:string:123",
+		"buff@id:int:0,__ttl__:int:60",
+	}
+
+	meta, err := NewDBMeta(defs)
+
+	assert.Nil(t, err)
+
+	assert.Equal(t, time.Duration(0), meta.GetTableMeta("users1").GetTTL())
+
+	buff := meta.GetTableMeta("buff")
+
+	assert.Equal(t, time.Second*60, buff.GetTTL())
+
+	//__ttl__不是字段
+	assert.Nil(t, buff.GetFieldMetas()["__ttl__"])
+
+	assert.Equal(t, []string{"id"}, buff.GetInsertOrder())
+
+	_, err = NewDBMeta([]string{"buff@id:int:0,__ttl__:int:abc"})
+
+	assert.NotNil(t, err)
+}
+
+func TestIndexField(t *testing.T) {
+
+	defs := []string{
+		"users1@age:int:0,name:string::index,guild:int:0:index",
+	}
+
+	meta, err := NewDBMeta(defs)
+
+	assert.Nil(t, err)
+
+	users1 := meta.GetTableMeta("users1")
+
+	assert.Equal(t, []string{"name", "guild"}, users1.GetIndexFields())
+
+	assert.Equal(t, true, users1.IsIndex("name"))
+
+	assert.Equal(t, false, users1.IsIndex("age"))
+
+	assert.Equal(t, true, users1.GetFieldMetas()["guild"].IsIndex())
+
+	_, err = NewDBMeta([]string{"users1@age:int:0:unique"})
+
+	assert.NotNil(t, err)
+
+	//blob字段不支持索引
+	_, err = NewDBMeta([]string{"users1@data:blob::index"})
+
+	assert.NotNil(t, err)
+}
+
+func TestWriteMode(t *testing.T) {
+
+	defs := []string{
+		"users1@age:int:0",
+		"payment@amount:int:0,__write__:string:through",
+		"session@data:blob:,__write__:string:cache,__ttl__:int:600",
+	}
+
+	meta, err := NewDBMeta(defs)
+
+	assert.Nil(t, err)
+
+	assert.Equal(t, WriteBehind, meta.GetTableMeta("users1").GetWriteMode())
+
+	assert.Equal(t, WriteThrough, meta.GetTableMeta("payment").GetWriteMode())
+
+	session := meta.GetTableMeta("session")
+
+	assert.Equal(t, WriteCacheOnly, session.GetWriteMode())
+
+	assert.Equal(t, []string{"data"}, session.GetInsertOrder())
+
+	_, err = NewDBMeta([]string{"users1@age:int:0,__write__:string:other"})
+
+	assert.NotNil(t, err)
+}
@@ -3,10 +3,13 @@ package dbmeta
 import (
 	"fmt"
 	"github.com/sniperHW/flyfish/proto"
+	"hash/fnv"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 	//"unsafe"
 )
 
@@ -26,9 +29,17 @@ func convert_blob(in interface{}) interface{} {
 	return *in.(*[]byte)
 }
 
+//表格的回写方式
+const (
+	WriteBehind    = 0 //提交后立即返回，异步回写数据库
+	WriteThrough   = 1 //回写数据库完成后才返回
+	WriteCacheOnly = 2 //只保存在缓存中，不访问数据库
+)
+
 type DBMeta struct {
 	version     int64
 	table_metas atomic.Value
+	def         atomic.Value //生成table_metas的配置
 }
 
 //根据表名获取表格元数据
@@ -46,6 +57,14 @@ func (this *DBMeta) CheckMetaVersion(version int64) bool {
 	return version == atomic.LoadInt64(&this.version)
 }
 
+func (this *DBMeta) GetVersion() int64 {
+	return atomic.LoadInt64(&this.version)
+}
+
+func (this *DBMeta) GetDef() []string {
+	return this.def.Load().([]string)
+}
+
 //表查询元数据
 type QueryMeta struct {
 	field_names    []string             //所有的字段名
@@ -92,12 +111,21 @@ type FieldMeta struct {
 	name     string          //字段名
 	tt       proto.ValueType //字段类型
 	defaultV interface{}     //字段默认值
+	index    bool            //是否建立二级索引
 }
 
 func (this *FieldMeta) GetDefaultV() interface{} {
 	return this.defaultV
 }
 
+func (this *FieldMeta) GetType() proto.ValueType {
+	return this.tt
+}
+
+func (this *FieldMeta) IsIndex() bool {
+	return this.index
+}
+
 //表格的元信息
 type TableMeta struct {
 	table            string                //表名
@@ -107,6 +135,9 @@ type TableMeta struct {
 	selectPrefix     string
 	insertFieldOrder []string
 	version          int64
+	ttl              time.Duration //记录默认过期时间,0表示不过期
+	indexFields      []string      //建立了二级索引的字段
+	writeMode        int
 }
 
 func (this *TableMeta) GetFieldMetas() map[string]*FieldMeta {
@@ -121,6 +152,24 @@ func (this *TableMeta) Version() int64 {
 	return this.version
 }
 
+func (this *TableMeta) GetTTL() time.Duration {
+	return this.ttl
+}
+
+func (this *TableMeta) GetWriteMode() int {
+	return this.writeMode
+}
+
+func (this *TableMeta) GetIndexFields() []string {
+	return this.indexFields
+}
+
+//字段是否建立了二级索引
+func (this *TableMeta) IsIndex(name string) bool {
+	m, ok := this.fieldMetas[name]
+	return ok && m.index
+}
+
 func (this *TableMeta) GetQueryMeta() *QueryMeta {
 	return this.queryMeta
 }
@@ -366,13 +415,39 @@ func loadMeta(def []string) (map[string]*TableMeta, error) {
 				break
 			}
 
+			//name:type:default[:index]
 			field := strings.Split(v, ":")
-			if len(field) != 3 {
-				return nil, fmt.Errorf("len(fields) != 3")
+			if len(field) != 3 && len(field) != 4 {
+				return nil, fmt.Errorf("invaild field %s, want name:type:default[:index]", v)
 			}
 
 			name := field[0]
 
+			//表格选项:__ttl__:int:秒数,记录的默认过期时间
+			if name == "__ttl__" {
+				ttl, err := strconv.ParseInt(field[2], 10, 64)
+				if nil != err || ttl < 0 {
+					return nil, fmt.Errorf("invaild __ttl__ %s", field[2])
+				}
+				t_meta.ttl = time.Duration(ttl) * time.Second
+				continue
+			}
+
+			//表格选项:__write__:string:behind|through|cache,回写方式
+			if name == "__write__" {
+				switch field[2] {
+				case "behind":
+					t_meta.writeMode = WriteBehind
+				case "through":
+					t_meta.writeMode = WriteThrough
+				case "cache":
+					t_meta.writeMode = WriteCacheOnly
+				default:
+					return nil, fmt.Errorf("invaild __write__ %s", field[2])
+				}
+				continue
+			}
+
 			//字段名不允许以__开头
 			if strings.HasPrefix(name, "__") {
 				return nil, fmt.Errorf("has prefix _")
@@ -390,10 +465,25 @@ func loadMeta(def []string) (map[string]*TableMeta, error) {
 				return nil, fmt.Errorf("no default value")
 			}
 
+			index := false
+
+			if len(field) == 4 {
+				if field[3] != "index" {
+					return nil, fmt.Errorf("unsupport field option %s", field[3])
+				}
+				//只支持int和string字段建立索引
+				if ftype != proto.ValueType_int && ftype != proto.ValueType_string {
+					return nil, fmt.Errorf("unsupport index type %s", field[1])
+				}
+				index = true
+				t_meta.indexFields = append(t_meta.indexFields, name)
+			}
+
 			t_meta.fieldMetas[name] = &FieldMeta{
 				name:     name,
 				tt:       ftype,
 				defaultV: defaultValue,
+				index:    index,
 			}
 
 			t_meta.insertFieldOrder = append(t_meta.insertFieldOrder, name)
@@ -418,15 +508,48 @@ func loadMeta(def []string) (map[string]*TableMeta, error) {
 }
 
 func (this *DBMeta) Reload(def []string) error {
+	return this.ReloadWithVersion(def, this.GetVersion()+1)
+}
+
+//按配置内容计算版本号，相同的配置(与顺序无关)总是得到相同的非0版本号
+func DefVersion(def []string) int64 {
+	sorted := append([]string{}, def...)
+	sort.Strings(sorted)
+	h := fnv.New64a()
+	for _, v := range sorted {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	if version := int64(h.Sum64() &^ (1 << 63)); 0 != version {
+		return version
+	} else {
+		return 1
+	}
+}
+
+//使用指定的版本号重新加载,用于各副本切换到相同版本
+func (this *DBMeta) ReloadWithVersion(def []string, version int64) error {
 	table_metas, err := loadMeta(def)
 	if nil == err {
+		for _, v := range table_metas {
+			v.version = version
+		}
+		this.def.Store(def)
 		this.table_metas.Store(table_metas)
-		atomic.AddInt64(&this.version, 1)
+		atomic.StoreInt64(&this.version, version)
 	}
 	return err
 }
 
+//复制一个独立的DBMeta
+func (this *DBMeta) Clone() *DBMeta {
+	dbmeta := &DBMeta{}
+	dbmeta.ReloadWithVersion(this.GetDef(), this.GetVersion())
+	return dbmeta
+}
+
 //tablename@field1:type:defaultValue,field2:type:defaultValue,field3:type:defaultValue...
+//可以用__ttl__:int:秒数为表格设置记录的默认过期时间
 func NewDBMeta(def []string) (*DBMeta, error) {
 
 	table_metas, err := loadMeta(def)
@@ -435,7 +558,12 @@ func NewDBMeta(def []string) (*DBMeta, error) {
 		return nil, err
 	}
 
+	for _, v := range table_metas {
+		v.version = 1
+	}
+
 	dbmeta := &DBMeta{version: 1}
+	dbmeta.def.Store(def)
 	dbmeta.table_metas.Store(table_metas)
 	return dbmeta, nil
 
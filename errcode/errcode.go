@@ -23,6 +23,13 @@ const (
 	ERR_CONNECTION
 	ERR_OTHER
 	ERR_RECORD_UNCHANGE
+	ERR_CANCEL            //请求被取消
+	ERR_CROSS_REGION      //事务中的key不属于同一个region
+	ERR_DUPLICATE_KEY     //事务中的key重复
+	ERR_SCRIPT            //脚本不存在或执行出错
+	ERR_SLOT_VERSION      //请求携带的slot表版本落后于kvnode
+	ERR_KICK_TTL          //带过期时间的记录不能kick
+	ERR_WRITEBACK_PENDING //写入已经提交，回写数据库尚未完成(write-through表格失去leader时)
 	ERR_END
 )
 
@@ -49,6 +56,13 @@ var err_str []string = []string{
 	"CONNECTION",
 	"OTHER",
 	"RECORD_UNCHANGE",
+	"CANCEL",
+	"CROSS_REGION",
+	"DUPLICATE_KEY",
+	"SCRIPT",
+	"SLOT_VERSION",
+	"KICK_TTL",
+	"WRITEBACK_PENDING",
 }
 
 func GetErrorStr(code int32) string {
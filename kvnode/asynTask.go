@@ -6,6 +6,7 @@ import (
 	"github.com/sniperHW/flyfish/proto"
 	"github.com/sniperHW/flyfish/util/str"
 	"sync/atomic"
+	"time"
 )
 
 const (
@@ -14,6 +15,13 @@ const (
 	proposal_update   = 2
 	proposal_kick     = 3
 	proposal_lease    = 4
+	proposal_meta     = 5 //切换表格配置
+	proposal_slot     = 6 //slot迁移
+	proposal_slots    = 7 //快照中的slot状态
+	proposal_members  = 8 //快照中的成员信息
+
+	proposal_flag_expire = 0x80 //snapshot/update携带过期时间
+	proposal_flag_load   = 0x40 //snapshot只是从数据库加载，kv没有变更
 )
 
 type asynTaskI interface {
@@ -35,6 +43,9 @@ type asynCmdTaskI interface {
 	setProposalType(int)
 	onError(errno int32)
 	fillMissingFields(map[string]*proto.Field)
+	calcExpire() bool
+	getChange() (int64, map[string]*proto.Field, bool)
+	onWriteBack(errno int32)
 }
 
 type asynCmdTaskBase struct {
@@ -46,6 +57,8 @@ type asynCmdTaskBase struct {
 	replyed      int64
 	proposalType int
 	del          bool
+	expire       int64 //写入后记录的过期时间
+	holdReply    bool  //写直达的表格，等待回写完成后返回
 }
 
 func (this *asynCmdTaskBase) fillMissingFields(fields map[string]*proto.Field) {
@@ -58,8 +71,50 @@ func (this *asynCmdTaskBase) fillMissingFields(fields map[string]*proto.Field) {
 	}
 }
 
+/*
+ * 计算写入后记录的过期时间(调用方持有kv锁)
+ * 命令指定了ttl使用命令的ttl,否则使用表格默认ttl,都没有则保持原过期时间
+ * 带过期时间的kv数量超过限制时返回false
+ */
+func (this *asynCmdTaskBase) calcExpire() bool {
+	kv := this.getKV()
+
+	if this.sqlFlag == sql_delete {
+		this.expire = 0
+		return true
+	}
+
+	this.expire = kv.getExpire()
+
+	now := time.Now()
+
+	for _, v := range this.commands {
+		ttl := v.getTTL()
+		if 0 == ttl {
+			ttl = kv.getMeta().GetTTL()
+		}
+		if ttl > 0 {
+			this.expire = now.Add(ttl).UnixNano() / int64(time.Millisecond)
+		}
+	}
+
+	if this.expire > 0 && kv.getExpire() == 0 {
+		return kv.store.checkTTLCount()
+	}
+
+	return true
+}
+
+//提交后记录发生的变更,没有变更返回false
+func (this *asynCmdTaskBase) getChange() (int64, map[string]*proto.Field, bool) {
+	if this.errno != errcode.ERR_OK || this.sqlFlag == sql_none {
+		return 0, nil, false
+	}
+	return this.version, this.fields, true
+}
+
 func (this *asynCmdTaskBase) append2Str(s *str.Str) {
-	appendProposal2Str(s, this.proposalType, this.getKV().uniKey, this.version, this.fields)
+	appendProposal2Str(s, this.proposalType, this.getKV().uniKey, this.version, this.fields, this.expire)
 }
 
 func (this *asynCmdTaskBase) getSqlFlag() uint32 {
@@ -92,6 +147,12 @@ func (this *asynCmdTaskBase) reply(errno ...int32) {
 		errCode = this.errno
 	}
 
+	//写直达的表格，写入成功提交后在回写数据库完成时才返回
+	if errCode == errcode.ERR_OK && this.sqlFlag != sql_none && !this.holdReply && this.getKV().getMeta().GetWriteMode() == dbmeta.WriteThrough {
+		this.holdReply = true
+		return
+	}
+
 	if atomic.CompareAndSwapInt64(&this.replyed, 0, 1) {
 		for _, v := range this.commands {
 			v.reply(errCode, this.fields, this.version)
@@ -99,6 +160,15 @@ func (this *asynCmdTaskBase) reply(errno ...int32) {
 	}
 }
 
+//写直达的表格回写完成
+func (this *asynCmdTaskBase) onWriteBack(errno int32) {
+	if atomic.CompareAndSwapInt64(&this.replyed, 0, 1) {
+		for _, v := range this.commands {
+			v.reply(errno, this.fields, this.version)
+		}
+	}
+}
+
 func (this *asynCmdTaskBase) dontReply() {
 	if atomic.CompareAndSwapInt64(&this.replyed, 0, 1) {
 		for _, v := range this.commands {
@@ -137,21 +207,36 @@ func (this *asynCmdTaskBase) onPorposeTimeout() {
 	this.reply()
 }
 
-func (this *asynCmdTaskBase) done() {
-	kv := this.getKV()
-	kv.Lock()
-
-	if this.proposalType == proposal_snapshot {
+//将提交的结果设置到kv(调用方持有kv锁)
+func (this *asynCmdTaskBase) commit(kv *kv) {
+	if this.proposalType&^proposal_flag_load == proposal_snapshot {
 		kv.setSnapshoted(true)
 	}
 
 	if this.version > 0 {
 		kv.setOK(this.version, this.fields)
+		kv.setExpire(this.expire)
 	} else {
 		kv.setMissing()
 	}
 
-	kv.setSqlFlag(this.sqlFlag)
+	if kv.getMeta().GetWriteMode() == dbmeta.WriteCacheOnly {
+		//不回写数据库
+		kv.setSqlFlag(sql_none)
+	} else {
+		kv.setSqlFlag(this.sqlFlag)
+	}
+}
+
+func (this *asynCmdTaskBase) done() {
+	kv := this.getKV()
+	kv.Lock()
+
+	this.commit(kv)
+
+	if this.holdReply {
+		kv.sqlWaiters = append(kv.sqlWaiters, this)
+	}
 
 	//logger.Debugln(this.sqlFlag, this.version, kv.isWriteBack())
 
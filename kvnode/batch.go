@@ -1,6 +1,7 @@
 package kvnode
 
 import (
+	"github.com/sniperHW/flyfish/errcode"
 	"github.com/sniperHW/flyfish/util/fixedarray"
 	"github.com/sniperHW/flyfish/util/str"
 	"time"
@@ -16,6 +17,9 @@ type readBatchSt struct {
 	readIndex int64
 	tasks     *fixedarray.FixedArray
 	deadline  time.Time
+	rn        *raftNode
+	term      uint64    //发起ReadIndex时的term
+	sendTime  time.Time //发起ReadIndex的时间
 }
 
 type batchProposal struct {
@@ -27,19 +31,45 @@ type batchProposal struct {
 type commitedBatchProposal struct {
 	data  []byte
 	tasks *fixedarray.FixedArray
+	index uint64 //raft日志index
+}
+
+//等待之前提交的日志都已经apply,用于不经过kv命令队列读取缓存的请求
+type readBarrier struct {
+	ch chan int32
+}
+
+func (this *readBarrier) done() {
+}
+
+func (this *readBarrier) onError(errno int32) {
+	this.ch <- errno
+}
+
+func (this *readBarrier) append2Str(*str.Str) {
+}
+
+func (this *readBarrier) onPorposeTimeout() {
 }
 
 func (this *readBatchSt) onError(err int32) {
 	this.tasks.ForEach(func(v interface{}) {
-		v.(asynCmdTaskI).onError(err)
+		v.(asynTaskI).onError(err)
 	})
 	fixedArrayPool.Put(this.tasks)
 }
 
 func (this *readBatchSt) reply() {
+	//之前提交的日志都已经apply,可以用本次ReadIndex延长读租约
+	this.rn.renewReadLease(this.term, this.sendTime)
 	this.tasks.ForEach(func(v interface{}) {
-		v.(asynCmdTaskI).reply()
-		v.(asynCmdTaskI).getKV().processCmd(nil)
+		switch v.(type) {
+		case *readBarrier:
+			v.(*readBarrier).ch <- errcode.ERR_OK
+		default:
+			v.(asynCmdTaskI).reply()
+			v.(asynCmdTaskI).getKV().processCmd(nil)
+		}
 	})
 	fixedArrayPool.Put(this.tasks)
 }
@@ -59,6 +89,7 @@ func (this *batchProposal) onPorposeTimeout() {
 }
 
 func (this *commitedBatchProposal) apply(store *kvstore) {
+	store.writeCDC(this.index, this.data)
 	if nil != this.tasks {
 		this.tasks.ForEach(func(v interface{}) {
 			switch v.(type) {
@@ -69,6 +100,12 @@ func (this *commitedBatchProposal) apply(store *kvstore) {
 
 		this.tasks.ForEach(func(v interface{}) {
 			v.(asynTaskI).done()
+			//向关注者推送变更
+			if task, ok := v.(asynCmdTaskI); ok {
+				if version, fields, changed := task.getChange(); changed {
+					store.kvNode.watchMgr.notify(task.getKV().uniKey, version, fields)
+				}
+			}
 		})
 	} else {
 		if !store.apply(this.data, false) {
@@ -0,0 +1,101 @@
+package kvnode
+
+import (
+	"github.com/sniperHW/flyfish/cdc"
+	"github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/flyfish/util/str"
+	"time"
+)
+
+/*
+ * 将提交的proposal转换成变更记录,从数据库加载kv产生的snapshot不输出。
+ * leader与follower都从日志数据生成，保证所有副本输出相同的记录序列
+ * 变更记录不能跳过：写入失败时在apply goroutine中重试，重试仍然失败或日志无法解析时停止节点，
+ * 重启后从LastIndex之后重放日志继续输出。
+ */
+
+const (
+	cdcRetryCount    = 50
+	cdcRetryInterval = time.Millisecond * 100
+)
+
+func (this *kvstore) writeCDCRecords(index uint64, records []*cdc.Record) {
+	var err error
+	for i := 0; i < cdcRetryCount; i++ {
+		if err = this.cdc.Write(index, records); nil == err {
+			return
+		}
+		logger.Errorln("cdc write error", index, err)
+		time.Sleep(cdcRetryInterval)
+	}
+	logger.Fatalln("cdc write error", index, err)
+}
+
+func (this *kvstore) writeCDC(index uint64, data []byte) {
+	if nil == this.cdc || index <= this.cdc.LastIndex() {
+		return
+	}
+
+	data, err := this.unpack(data)
+	if nil != err {
+		logger.Fatalln("cdc uncompress error", index, err)
+	}
+
+	s := str.NewStr(data, len(data))
+
+	records := []*cdc.Record{}
+
+	var p *proposal
+	for offset := 0; offset < s.Len(); {
+		p, offset = readProposal(s, offset)
+		if nil == p {
+			logger.Fatalln("cdc readProposal error", index)
+		}
+
+		if p.tt != proposal_snapshot && p.tt != proposal_update {
+			continue
+		}
+
+		//从数据库加载(Get未命中缓存及Preload)不是变更
+		if p.load {
+			continue
+		}
+
+		table, key := splitUniKey(p.values[0].(string))
+
+		record := &cdc.Record{
+			Table:   table,
+			Key:     key,
+			Version: p.values[1].(int64),
+		}
+
+		if record.Version == 0 {
+			record.Op = cdc.OpDel
+		} else {
+			if p.tt == proposal_snapshot {
+				record.Op = cdc.OpSet
+			} else {
+				record.Op = cdc.OpUpdate
+			}
+			record.Fields = map[string]interface{}{}
+			if len(p.values) > 3 {
+				for _, v := range p.values[3].([]*proto.Field) {
+					record.Fields[v.GetName()] = v.GetValue()
+				}
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	this.writeCDCRecords(index, records)
+}
+
+//通过快照追赶时快照之前的日志没有输出变更，写入OpSnapshot记录通知消费者
+func (this *kvstore) writeCDCSnapshot(index uint64) {
+	if nil == this.cdc || index <= this.cdc.LastIndex() {
+		return
+	}
+
+	this.writeCDCRecords(index, []*cdc.Record{&cdc.Record{Op: cdc.OpSnapshot}})
+}
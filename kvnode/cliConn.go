@@ -4,13 +4,17 @@ import (
 	"github.com/sniperHW/flyfish/net"
 	"github.com/sniperHW/kendynet"
 	"sync"
-	//"time"
+	"time"
 )
 
+const maxCanceledRecord = 1024
+
 type cliConn struct {
 	sync.RWMutex
 	session  kendynet.StreamSession
 	replyers map[int64]*replyer
+	canceled map[int64]time.Time //先于请求到达的cancel
+	watchers map[int64]*watcher  //watch seqno -> watcher
 	node     *KVNode
 }
 
@@ -18,6 +22,36 @@ func (this *cliConn) clear() {
 	this.Lock()
 	defer this.Unlock()
 	this.replyers = map[int64]*replyer{}
+	this.canceled = map[int64]time.Time{}
+}
+
+func (this *cliConn) addWatcher(w *watcher) bool {
+	this.Lock()
+	defer this.Unlock()
+	if this.isClosed() {
+		return false
+	}
+	this.watchers[w.seqno] = w
+	return true
+}
+
+func (this *cliConn) removeWatcher(seqno int64) *watcher {
+	this.Lock()
+	defer this.Unlock()
+	w := this.watchers[seqno]
+	delete(this.watchers, seqno)
+	return w
+}
+
+func (this *cliConn) clearWatcher() []*watcher {
+	this.Lock()
+	defer this.Unlock()
+	watchers := make([]*watcher, 0, len(this.watchers))
+	for _, v := range this.watchers {
+		watchers = append(watchers, v)
+	}
+	this.watchers = map[int64]*watcher{}
+	return watchers
 }
 
 func (this *cliConn) send(msg *net.Message) error {
@@ -35,7 +69,37 @@ func (this *cliConn) isClosed() bool {
 func (this *cliConn) addReplyer(replyer *replyer) {
 	this.Lock()
 	defer this.Unlock()
-	this.replyers[replyer.seqno] = replyer
+	if _, ok := this.canceled[replyer.seqno]; ok {
+		//请求已被取消，不注册replyer,使得isCancel返回true
+		delete(this.canceled, replyer.seqno)
+	} else {
+		this.replyers[replyer.seqno] = replyer
+	}
+}
+
+/*
+ * cancel直接在网络线程处理，而请求需要经过线程池,cancel有可能先于请求到达。
+ * 找不到replyer时记录seqno,请求到达时addReplyer会将其丢弃
+ */
+func (this *cliConn) cancel(seqno int64) {
+	this.Lock()
+	defer this.Unlock()
+	if _, ok := this.replyers[seqno]; ok {
+		delete(this.replyers, seqno)
+	} else {
+		now := time.Now()
+		if len(this.canceled) >= maxCanceledRecord {
+			//清理超过请求最大超时时间的记录
+			for k, v := range this.canceled {
+				if now.Sub(v) > time.Second*10 {
+					delete(this.canceled, k)
+				}
+			}
+		}
+		if len(this.canceled) < maxCanceledRecord {
+			this.canceled[seqno] = now
+		}
+	}
 }
 
 func (this *cliConn) removeReplyerBySeqno(seqno int64) bool {
@@ -3,6 +3,7 @@ package kvnode
 import (
 	//"fmt"
 	//"bytes"
+	"github.com/sniperHW/flyfish/errcode"
 	"github.com/sniperHW/flyfish/net"
 	"github.com/sniperHW/flyfish/proto"
 	"sync/atomic"
@@ -22,8 +23,12 @@ func makeUniKey(table string, key string) string {
 	return b.String()
 }*/
 
-type commandI interface {
+type responser interface {
 	makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message //pb.Message
+}
+
+type commandI interface {
+	responser
 	reply(errCode int32, fields map[string]*proto.Field, version int64)
 	dontReply()
 	isCancel() bool  //操作是否被取消(客户端连接断开或主动发送取消请求)
@@ -31,6 +36,7 @@ type commandI interface {
 	isTimeout() bool //命令已经超时
 	checkVersion(version int64) bool
 	prepare(asynCmdTaskI) (asynCmdTaskI, bool)
+	getTTL() time.Duration //写入后记录的过期时间,0表示使用表格默认值
 }
 
 type commandBase struct {
@@ -38,6 +44,7 @@ type commandBase struct {
 	deadline time.Time
 	replyer  *replyer
 	version  *int64
+	ttl      time.Duration
 }
 
 func (this *commandBase) dontReply() {
@@ -56,6 +63,10 @@ func (this *commandBase) isTimeout() bool {
 	return time.Now().After(this.deadline)
 }
 
+func (this *commandBase) getTTL() time.Duration {
+	return this.ttl
+}
+
 func (this *commandBase) checkVersion(version int64) bool {
 	if this.version == nil {
 		return true
@@ -69,6 +80,8 @@ type replyer struct {
 	seqno        int64
 	peer         *cliConn
 	respDeadline time.Time
+	muti         *mutiReplyer //非nil表示批量命令中的子命令，结果汇总到muti
+	index        int          //子命令在批量命令中的下标
 }
 
 func newReplyer(peer *cliConn, seqno int64, respDeadline time.Time) *replyer {
@@ -85,6 +98,21 @@ func newReplyer(peer *cliConn, seqno int64, respDeadline time.Time) *replyer {
 	return r
 }
 
+/*
+ * 批量命令的子命令共用批量命令的seqno,不向cliConn注册。
+ * 取消批量命令的seqno会使所有尚未执行的子命令isCancel返回true
+ */
+func newSubReplyer(muti *mutiReplyer, index int) *replyer {
+	atomic.AddInt64(&muti.replyer.peer.node.wait4ReplyCount, 1)
+	return &replyer{
+		peer:         muti.replyer.peer,
+		respDeadline: muti.replyer.respDeadline,
+		seqno:        muti.replyer.seqno,
+		muti:         muti,
+		index:        index,
+	}
+}
+
 func (this *replyer) isCancel() bool {
 	if this.peer.isClosed() {
 		return true
@@ -97,11 +125,17 @@ func (this *replyer) isCancel() bool {
 	return false
 }
 
-func (this *replyer) reply(cmd commandI, errCode int32, fields map[string]*proto.Field, version int64) {
+func (this *replyer) reply(cmd responser, errCode int32, fields map[string]*proto.Field, version int64) {
 	if atomic.CompareAndSwapInt64(&this.replyed, 0, 1) {
 		atomic.AddInt64(&this.peer.node.wait4ReplyCount, -1)
-		if this.peer.removeReplyer(this) && !time.Now().After(this.respDeadline) {
-			err := this.peer.send(cmd.makeResponse(errCode, fields, version))
+		if nil != this.muti {
+			this.muti.onReply(this.index, cmd, errCode, fields, version)
+		} else if this.peer.removeReplyer(this) && !time.Now().After(this.respDeadline) {
+			resp := cmd.makeResponse(errCode, fields, version)
+			if errcode.ERR_NOT_LEADER == errCode {
+				resp = this.peer.node.withLeaderHint(cmd, resp)
+			}
+			err := this.peer.send(resp)
 			if nil != err {
 				logger.Errorln("send resp error", err.Error())
 			}
@@ -112,6 +146,11 @@ func (this *replyer) reply(cmd commandI, errCode int32, fields map[string]*proto
 func (this *replyer) dontReply() {
 	if atomic.CompareAndSwapInt64(&this.replyed, 0, 1) {
 		atomic.AddInt64(&this.peer.node.wait4ReplyCount, -1)
-		this.peer.removeReplyer(this)
+		if nil != this.muti {
+			//子命令被丢弃，仍需计数以便批量命令能够结束
+			this.muti.onReply(this.index, nil, errcode.ERR_TIMEOUT, nil, 0)
+		} else {
+			this.peer.removeReplyer(this)
+		}
 	}
 }
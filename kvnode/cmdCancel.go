@@ -8,13 +8,14 @@ import (
 /*
  * 取消尚未开始执行的客户端请求
  * op准备投入执行前会调用isCancel,如果连接关闭或找不到对应的replyer，
- * isCancel将返回false。op会直接丢弃。
- * cancel操作就是请求的所有seqno对应的replyer删除，使得isCancel返回false
+ * isCancel将返回true。op会直接丢弃。
+ * cancel操作就是请求的所有seqno对应的replyer删除，使得isCancel返回true
+ * 已经开始执行的请求无法取消,执行完成后也不会再返回响应
  */
 
 func cancel(n *KVNode, cli *cliConn, msg *net.Message) {
 	req := msg.GetData().(*proto.Cancel)
 	for _, v := range req.GetSeqs() {
-		cli.removeReplyerBySeqno(v)
+		cli.cancel(v)
 	}
 }
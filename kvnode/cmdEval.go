@@ -0,0 +1,203 @@
+package kvnode
+
+import (
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/proto"
+	"github.com/yuin/gopher-lua"
+)
+
+/*
+ * 执行脚本
+ * 脚本在kv的命令队列锁定期间由scriptMgr的工作goroutine执行，不持有kv锁:
+ * 记录在缓存中时在prepare中复制记录的状态，否则使用sqlLoader加载的记录。
+ * 执行完成后确认记录的版本及状态没有变化(否则返回ERR_RETRY),脚本写入的字段与set一样通过issueUpdate提交。
+ */
+
+type asynCmdTaskEval struct {
+	*asynCmdTaskBase
+	exists bool
+	status uint32                  //执行脚本时记录的状态
+	record map[string]*proto.Field //执行脚本时记录的字段
+	loaded bool                    //记录由sqlLoader加载,kv处于cache_new
+}
+
+func (this *asynCmdTaskEval) onSqlResp(errno int32) {
+
+	this.asynCmdTaskBase.onSqlResp(errno)
+
+	if errno == errcode.ERR_OK || errno == errcode.ERR_RECORD_NOTEXIST {
+		this.exists = errno == errcode.ERR_OK
+		this.record = this.fields
+		this.loaded = true
+		this.getKV().store.getKvNode().scriptMgr.push(this)
+	}
+}
+
+//由scriptMgr的工作goroutine调用
+func (this *asynCmdTaskEval) runScript() {
+	cmd := this.commands[0].(*cmdEval)
+	kv := this.getKV()
+
+	this.errno = cmd.run(this.exists, this.version, this.record)
+
+	if this.loaded {
+		if this.errno != errcode.ERR_OK || len(cmd.writes) == 0 {
+			this.reply()
+			kv.store.issueAddkv(this)
+			return
+		}
+	} else {
+		if this.errno != errcode.ERR_OK || len(cmd.writes) == 0 {
+			this.reply()
+			kv.processCmd(nil)
+			return
+		}
+
+		kv.Lock()
+		changed := kv.getStatus() != this.status || kv.version != this.version
+		kv.Unlock()
+
+		if changed {
+			//命令队列锁定期间记录被过期删除或迁出
+			this.reply(errcode.ERR_RETRY)
+			kv.processCmd(nil)
+			return
+		}
+	}
+
+	this.fields = map[string]*proto.Field{}
+
+	if !this.exists {
+		fillDefaultValue(kv.meta, &this.fields)
+		this.sqlFlag = sql_insert_update
+	} else {
+		if this.loaded {
+			for k, v := range this.record {
+				this.fields[k] = v
+			}
+		}
+		this.sqlFlag = sql_update
+	}
+
+	for k, v := range cmd.writes {
+		this.fields[k] = v
+	}
+
+	this.version++
+	kv.store.issueUpdate(this)
+}
+
+func newAsynCmdTaskEval(cmd commandI) *asynCmdTaskEval {
+	return &asynCmdTaskEval{
+		asynCmdTaskBase: &asynCmdTaskBase{
+			commands: []commandI{cmd},
+		},
+	}
+}
+
+type cmdEval struct {
+	*commandBase
+	scriptMgr *scriptMgr
+	script    *lua.FunctionProto
+	args      []string
+	writes    map[string]*proto.Field //脚本写入的字段
+	result    string
+}
+
+func (this *cmdEval) reply(errCode int32, fields map[string]*proto.Field, version int64) {
+	this.replyer.reply(this, errCode, fields, version)
+}
+
+func (this *cmdEval) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	pbdata := &proto.EvalResp{
+		Version: version,
+		Result:  this.result,
+	}
+
+	if errCode == errcode.ERR_OK {
+		for _, v := range this.writes {
+			pbdata.Fields = append(pbdata.Fields, v)
+		}
+	}
+
+	return net.NewMessage(net.CommonHead{
+		Seqno:   this.replyer.seqno,
+		ErrCode: errCode,
+	}, pbdata)
+}
+
+func (this *cmdEval) run(exists bool, version int64, fields map[string]*proto.Field) int32 {
+	writes, result, err := this.scriptMgr.run(this.script, this.kv.getMeta(), exists, version, fields, this.args)
+	if nil != err {
+		this.result = err.Error()
+		return errcode.ERR_SCRIPT
+	} else {
+		this.writes = writes
+		this.result = result
+		return errcode.ERR_OK
+	}
+}
+
+func (this *cmdEval) prepare(t asynCmdTaskI) (asynCmdTaskI, bool) {
+
+	if t != nil {
+		return t, false
+	}
+
+	kv := this.kv
+
+	status := kv.getStatus()
+
+	if status == cache_new {
+		return newAsynCmdTaskEval(this), true
+	}
+
+	task := newAsynCmdTaskEval(this)
+	task.exists = status == cache_ok
+	task.status = status
+	task.version = kv.version
+	task.record = map[string]*proto.Field{}
+	if task.exists {
+		for k, v := range kv.fields {
+			task.record[k] = v
+		}
+	}
+
+	return task, true
+}
+
+func eval(n *KVNode, cli *cliConn, msg *net.Message) {
+
+	req := msg.GetData().(*proto.EvalReq)
+
+	head := msg.GetHead()
+
+	processDeadline, respDeadline := getDeadline(head.Timeout)
+
+	op := &cmdEval{
+		commandBase: &commandBase{
+			deadline: processDeadline,
+			replyer:  newReplyer(cli, head.Seqno, respDeadline),
+		},
+		scriptMgr: n.scriptMgr,
+		script:    n.scriptMgr.get(req.GetName()),
+		args:      req.GetArgs(),
+	}
+
+	if nil == op.script {
+		op.result = "script not found"
+		op.reply(errcode.ERR_SCRIPT, nil, 0)
+		return
+	}
+
+	table, key := head.SplitUniKey()
+
+	if kv, err := n.storeMgr.getkv(table, key, head.UniKey); errcode.ERR_OK != err {
+		op.reply(err, nil, 0)
+		return
+	} else {
+		op.kv = kv
+		kv.processCmd(op)
+	}
+}
@@ -0,0 +1,59 @@
+package kvnode
+
+import (
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/proto"
+	"time"
+)
+
+/*
+ * 过期删除,由leader的checkExpire投递,不需要应答
+ * 执行时再次检查是否过期(排队期间可能被重新设置了过期时间),过期则作为删除提交
+ */
+
+type cmdExpire struct {
+	kv *kv
+}
+
+func (this *cmdExpire) reply(errCode int32, fields map[string]*proto.Field, version int64) {
+}
+
+func (this *cmdExpire) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	return nil
+}
+
+func (this *cmdExpire) dontReply() {
+}
+
+func (this *cmdExpire) isCancel() bool {
+	return false
+}
+
+func (this *cmdExpire) getKV() *kv {
+	return this.kv
+}
+
+func (this *cmdExpire) isTimeout() bool {
+	return false
+}
+
+func (this *cmdExpire) checkVersion(version int64) bool {
+	return true
+}
+
+func (this *cmdExpire) getTTL() time.Duration {
+	return 0
+}
+
+func (this *cmdExpire) prepare(t asynCmdTaskI) (asynCmdTaskI, bool) {
+
+	if t != nil {
+		return t, false
+	}
+
+	if this.kv.getStatus() != cache_ok || !this.kv.isExpired(time.Now()) {
+		return nil, true
+	}
+
+	return newAsynCmdTaskDel(this, sql_delete), true
+}
@@ -28,7 +28,8 @@ func newAsynCmdTaskGet() *asynCmdTaskGet {
 
 type cmdGet struct {
 	*commandBase
-	fields map[string]*proto.Field
+	fields       map[string]*proto.Field
+	appliedIndex uint64 //follower读取时已应用的日志位置
 }
 
 func (this *cmdGet) reply(errCode int32, fields map[string]*proto.Field, version int64) {
@@ -38,27 +39,15 @@ func (this *cmdGet) reply(errCode int32, fields map[string]*proto.Field, version
 
 func (this *cmdGet) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
 	pbdata := &proto.GetResp{
-		Version: version,
+		Version:      version,
+		AppliedIndex: this.appliedIndex,
 	}
 
 	if errcode.ERR_OK == errCode {
 		if this.version != nil && *this.version == version {
 			errCode = errcode.ERR_RECORD_UNCHANGE
 		} else {
-			for _, field := range this.fields {
-				v := fields[field.GetName()]
-				if nil != v {
-					pbdata.Fields = append(pbdata.Fields, v)
-				} else {
-					/*
-					 * 表格新增加了列，但未设置过，使用默认值
-					 */
-					vv := this.kv.meta.GetDefaultV(field.GetName())
-					if nil != vv {
-						pbdata.Fields = append(pbdata.Fields, proto.PackField(field.GetName(), vv))
-					}
-				}
-			}
+			pbdata.Fields = this.selectFields(fields)
 		}
 	}
 
@@ -68,6 +57,50 @@ func (this *cmdGet) makeResponse(errCode int32, fields map[string]*proto.Field,
 	}, pbdata)
 }
 
+//从记录中选出请求的字段
+func (this *cmdGet) selectFields(fields map[string]*proto.Field) []*proto.Field {
+	ret := make([]*proto.Field, 0, len(this.fields))
+	for _, field := range this.fields {
+		v := fields[field.GetName()]
+		if nil != v {
+			ret = append(ret, v)
+		} else {
+			/*
+			 * 表格新增加了列，但未设置过，使用默认值
+			 */
+			vv := this.kv.meta.GetDefaultV(field.GetName())
+			if nil != vv {
+				ret = append(ret, proto.PackField(field.GetName(), vv))
+			}
+		}
+	}
+	return ret
+}
+
+//设置请求的字段,字段非法时返回错误
+func (this *cmdGet) fillFields(req *proto.GetReq) bool {
+	meta := this.kv.getMeta()
+
+	if req.GetAll() {
+		for _, name := range meta.GetQueryMeta().GetFieldNames() {
+			if name != "__key__" && name != "__version__" {
+				this.fields[name] = proto.PackField(name, nil)
+			}
+		}
+	} else {
+		for _, name := range req.GetFields() {
+			this.fields[name] = proto.PackField(name, nil)
+		}
+	}
+
+	if !meta.CheckGet(this.fields) {
+		this.reply(errcode.ERR_INVAILD_FIELD, nil, 0)
+		return false
+	}
+
+	return true
+}
+
 func (this *cmdGet) prepare(t asynCmdTaskI) (asynCmdTaskI, bool) {
 
 	task, ok := t.(*asynCmdTaskGet)
@@ -79,7 +112,7 @@ func (this *cmdGet) prepare(t asynCmdTaskI) (asynCmdTaskI, bool) {
 
 	kv := this.kv
 
-	status := kv.getStatus()
+	status := kv.getReadStatus()
 
 	if status == cache_ok && this.version != nil && *this.version == kv.version {
 		//kv跟cmdGet提交请求的版本一致，且没有新加过未设置的列，直接返回ERR_RECORD_UNCHANGE
@@ -89,12 +122,11 @@ func (this *cmdGet) prepare(t asynCmdTaskI) (asynCmdTaskI, bool) {
 
 	if nil == t {
 		task = newAsynCmdTaskGet()
-		if status == cache_missing || status == cache_ok {
+		if status == cache_ok {
 			task.fields = this.kv.fields
 			task.version = this.kv.version
-			if status == cache_missing {
-				task.errno = errcode.ERR_RECORD_NOTEXIST
-			}
+		} else if status == cache_missing {
+			task.errno = errcode.ERR_RECORD_NOTEXIST
 		}
 	}
 
@@ -103,6 +135,55 @@ func (this *cmdGet) prepare(t asynCmdTaskI) (asynCmdTaskI, bool) {
 	return task, true
 }
 
+/*
+ * follower直接从已应用的状态读取，只返回缓存中的记录(cache_ok,cache_missing)。
+ * 不在缓存中或延迟超过maxLag返回ERR_NOT_LEADER,由调用方向leader重试。
+ * 先取appliedIndex再读取kv,返回的记录至少包含appliedIndex之前的所有变更。
+ */
+func (this *cmdGet) staleRead(store *kvstore, maxLag int64) {
+
+	appliedIndex := store.getAppliedIndex()
+
+	if !store.rn.checkReadLag(appliedIndex, maxLag) {
+		this.reply(errcode.ERR_NOT_LEADER, nil, 0)
+		return
+	}
+
+	kv := this.kv
+
+	kv.Lock()
+
+	status := kv.getReadStatus()
+
+	if status != cache_ok && status != cache_missing {
+		kv.Unlock()
+		this.reply(errcode.ERR_NOT_LEADER, nil, 0)
+		return
+	}
+
+	version := kv.version
+
+	var fields map[string]*proto.Field
+
+	if status == cache_ok {
+		//kv.fields会被apply修改,在锁内复制
+		fields = map[string]*proto.Field{}
+		for _, v := range this.selectFields(kv.fields) {
+			fields[v.GetName()] = v
+		}
+	}
+
+	kv.Unlock()
+
+	this.appliedIndex = appliedIndex
+
+	if status == cache_missing {
+		this.reply(errcode.ERR_RECORD_NOTEXIST, nil, 0)
+	} else {
+		this.reply(errcode.ERR_OK, fields, version)
+	}
+}
+
 func get(n *KVNode, cli *cliConn, msg *net.Message) {
 
 	req := msg.GetData().(*proto.GetReq)
@@ -122,6 +203,28 @@ func get(n *KVNode, cli *cliConn, msg *net.Message) {
 
 	table, key := head.SplitUniKey()
 
+	var store *kvstore
+
+	if req.GetStaleOk() {
+		if store = n.storeMgr.getStore(head.UniKey); nil != store && store.rn.isLeader() {
+			//leader按一致读处理
+			store = nil
+		}
+	}
+
+	if nil != store {
+		//follower不创建新的kv
+		if kv := n.storeMgr.getkvOnly(table, key, head.UniKey); nil == kv {
+			op.reply(errcode.ERR_NOT_LEADER, nil, 0)
+		} else {
+			op.kv = kv
+			if op.fillFields(req) {
+				op.staleRead(store, req.GetMaxLag())
+			}
+		}
+		return
+	}
+
 	if kv, err := n.storeMgr.getkv(table, key, head.UniKey); errcode.ERR_OK != err {
 		op.reply(err, nil, 0)
 		return
@@ -129,24 +232,9 @@ func get(n *KVNode, cli *cliConn, msg *net.Message) {
 
 		op.kv = kv
 
-		if req.GetAll() {
-			for _, name := range op.kv.meta.GetQueryMeta().GetFieldNames() {
-				if name != "__key__" && name != "__version__" {
-					op.fields[name] = proto.PackField(name, nil)
-				}
-			}
-		} else {
-			for _, name := range req.GetFields() {
-				op.fields[name] = proto.PackField(name, nil)
-			}
+		if op.fillFields(req) {
+			kv.processCmd(op)
 		}
-
-		if !kv.meta.CheckGet(op.fields) {
-			op.reply(errcode.ERR_INVAILD_FIELD, nil, 0)
-			return
-		}
-
-		kv.processCmd(op)
 	}
 
 }
@@ -0,0 +1,186 @@
+package kvnode
+
+import (
+	"fmt"
+	"github.com/sniperHW/flyfish/dbmeta"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/proto"
+	"sort"
+	"time"
+)
+
+/*
+ * 按二级索引查询
+ * 由indexScaner(独立的数据库连接)按__key__顺序分批查询索引值匹配的记录，再与本节点缓存合并:
+ * 缓存中存在(cache_ok,cache_missing)的记录以缓存为准(尚未回写的修改只存在于缓存),其余以数据库为准。
+ * 与scan一样，本节点需要是所有store的leader,__key__列需要使用字节序的排序规则。
+ * 客户端每次携带上次返回的cursor继续查询，合并后超过count条时只返回前count条。
+ */
+
+const (
+	defaultIndexCount = maxScanCount
+)
+
+const indexQueryTemplate string = "SELECT __key__,__version__ FROM %s where %s = ? and __key__ > ? order by __key__ limit %d;"
+
+type cmdGetByIndex struct {
+	replyer  *replyer
+	storeMgr *storeMgr
+	meta     *dbmeta.TableMeta
+	value    *proto.Field
+	count    int
+	cursor   string
+	deadline time.Time
+	resp     *proto.GetByIndexResp
+}
+
+func (this *cmdGetByIndex) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	pbdata := this.resp
+	if errcode.ERR_OK != errCode || nil == pbdata {
+		pbdata = &proto.GetByIndexResp{}
+	}
+	return net.NewMessage(net.CommonHead{
+		Seqno:   this.replyer.seqno,
+		ErrCode: errCode,
+	}, pbdata)
+}
+
+func (this *cmdGetByIndex) reply(errCode int32) {
+	this.replyer.reply(this, errCode, nil, 0)
+}
+
+func (this *cmdGetByIndex) isTimeout() bool {
+	return this.replyer.isCancel() || time.Now().After(this.deadline)
+}
+
+//索引值作为绑定参数传递
+func (this *cmdGetByIndex) sqlStr() (string, interface{}) {
+	var value interface{}
+	if this.value.IsInt() {
+		value = this.value.GetInt()
+	} else {
+		value = this.value.GetString()
+	}
+	return fmt.Sprintf(indexQueryTemplate, this.meta.GetTable(), this.value.GetName(), this.count), value
+}
+
+//用缓存覆盖数据库的查询结果,finish为false时只合并(cursor,upper]范围内的key
+func (this *cmdGetByIndex) mergeCache(upper string, finish bool, rows map[string]int64) {
+	table := this.meta.GetTable()
+	name := this.value.GetName()
+	value := indexValue(this.value)
+
+	for key := range rows {
+		uniKey := table + ":" + key
+		if kv := this.storeMgr.getkvOnly(table, key, uniKey); nil != kv {
+			kv.Lock()
+			status := kv.getReadStatus()
+			kv.Unlock()
+			if status == cache_ok || status == cache_missing {
+				delete(rows, key)
+			}
+		}
+	}
+
+	this.storeMgr.RLock()
+	stores := make([]*kvstore, 0, len(this.storeMgr.stores))
+	for _, v := range this.storeMgr.stores {
+		stores = append(stores, v)
+	}
+	this.storeMgr.RUnlock()
+
+	for _, store := range stores {
+		for _, kv := range store.index.lookup(table, name, value) {
+			if kv.key <= this.cursor || (!finish && kv.key > upper) {
+				continue
+			}
+			kv.Lock()
+			if kv.getReadStatus() == cache_ok {
+				v := kv.fields[name]
+				if nil == v {
+					v = proto.PackField(name, kv.meta.GetDefaultV(name))
+				}
+				if indexValue(v) == value {
+					rows[kv.key] = kv.version
+				}
+			}
+			kv.Unlock()
+		}
+	}
+}
+
+func (this *cmdGetByIndex) onQueryResult(upper string, finish bool, rows map[string]int64) {
+	this.mergeCache(upper, finish, rows)
+
+	keys := make([]string, 0, len(rows))
+	for k := range rows {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	if len(keys) > this.count {
+		keys = keys[:this.count]
+		finish = false
+		upper = keys[len(keys)-1]
+	}
+
+	this.resp = &proto.GetByIndexResp{
+		Finish: finish,
+		Cursor: upper,
+	}
+	for _, k := range keys {
+		this.resp.Rows = append(this.resp.Rows, &proto.Row{
+			Key:     k,
+			Version: rows[k],
+		})
+	}
+
+	this.reply(errcode.ERR_OK)
+}
+
+func getByIndex(n *KVNode, cli *cliConn, msg *net.Message) {
+
+	req := msg.GetData().(*proto.GetByIndexReq)
+
+	head := msg.GetHead()
+
+	processDeadline, respDeadline := getDeadline(head.Timeout)
+
+	cmd := &cmdGetByIndex{
+		replyer:  newReplyer(cli, head.Seqno, respDeadline),
+		storeMgr: n.storeMgr,
+		value:    req.GetValue(),
+		count:    int(req.GetCount()),
+		cursor:   req.GetCursor(),
+		deadline: processDeadline,
+	}
+
+	if cmd.count <= 0 {
+		cmd.count = defaultIndexCount
+	} else if cmd.count > maxScanCount {
+		cmd.count = maxScanCount
+	}
+
+	if "" == req.GetTable() {
+		cmd.reply(errcode.ERR_MISSING_TABLE)
+		return
+	}
+
+	cmd.meta = n.storeMgr.dbmeta.GetTableMeta(req.GetTable())
+
+	if nil == cmd.meta {
+		cmd.reply(errcode.ERR_INVAILD_TABLE)
+		return
+	}
+
+	if nil == cmd.value || !cmd.meta.IsIndex(cmd.value.GetName()) || !cmd.meta.CheckFieldMeta(cmd.value) {
+		cmd.reply(errcode.ERR_INVAILD_FIELD)
+		return
+	}
+
+	if !n.sqlMgr.pushIndexReq(cmd) {
+		cmd.reply(errcode.ERR_RETRY)
+	}
+}
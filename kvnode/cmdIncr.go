@@ -4,6 +4,7 @@ import (
 	"github.com/sniperHW/flyfish/errcode"
 	"github.com/sniperHW/flyfish/net"
 	"github.com/sniperHW/flyfish/proto"
+	"time"
 )
 
 func incrBy(n *KVNode, cli *cliConn, msg *net.Message) {
@@ -19,6 +20,7 @@ func incrBy(n *KVNode, cli *cliConn, msg *net.Message) {
 			deadline: processDeadline,
 			replyer:  newReplyer(cli, msg.GetHead().Seqno, respDeadline),
 			version:  req.Version,
+			ttl:      time.Duration(req.GetTtl()) * time.Millisecond,
 		},
 		field:  req.GetField(),
 		isIncr: true,
@@ -65,6 +65,12 @@ func (this *cmdKick) prepare(t asynCmdTaskI) (asynCmdTaskI, bool) {
 		return nil, true
 	}
 
+	//过期时间不保存到数据库，kick后重新加载的记录将不再过期
+	if kv.getExpire() > 0 {
+		this.reply(errcode.ERR_KICK_TTL, nil, 0)
+		return nil, true
+	}
+
 	if kv.isWriteBack() {
 		this.reply(errcode.ERR_RETRY, nil, 0)
 		return nil, true
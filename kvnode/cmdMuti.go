@@ -0,0 +1,241 @@
+package kvnode
+
+import (
+	pb "github.com/golang/protobuf/proto"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/proto"
+	"sync"
+)
+
+/*
+ * 批量命令
+ * 每个key生成一个子命令，按所属store分组投递，每组投递完成后立即flush,使同一store的子命令合并到同一批次。
+ * 所有子命令返回后，按请求中key的顺序汇总成一个应答
+ */
+
+type mutiReplyer struct {
+	sync.Mutex
+	replyer  *replyer
+	rows     []*proto.Row
+	count    int
+	makeResp func(rows []*proto.Row) pb.Message
+}
+
+func newMutiReplyer(r *replyer, makeResp func(rows []*proto.Row) pb.Message) *mutiReplyer {
+	return &mutiReplyer{
+		replyer:  r,
+		makeResp: makeResp,
+	}
+}
+
+func (this *mutiReplyer) init(keys []string) {
+	this.rows = make([]*proto.Row, len(keys))
+	for i, k := range keys {
+		this.rows[i] = &proto.Row{Key: k}
+	}
+}
+
+func (this *mutiReplyer) onReply(index int, cmd responser, errCode int32, fields map[string]*proto.Field, version int64) {
+	this.Lock()
+	row := this.rows[index]
+	row.ErrCode = errCode
+	row.Version = version
+	if errcode.ERR_OK == errCode {
+		if get, ok := cmd.(*cmdGet); ok {
+			row.Fields = get.selectFields(fields)
+		}
+	}
+	this.count++
+	done := this.count == len(this.rows)
+	this.Unlock()
+
+	if done {
+		this.replyer.reply(this, errcode.ERR_OK, nil, 0)
+	}
+}
+
+func (this *mutiReplyer) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	var pbdata pb.Message
+	if errcode.ERR_OK == errCode {
+		pbdata = this.makeResp(this.rows)
+	} else {
+		pbdata = this.makeResp(nil)
+	}
+	return net.NewMessage(net.CommonHead{
+		Seqno:   this.replyer.seqno,
+		ErrCode: errCode,
+	}, pbdata)
+}
+
+//按store分组投递子命令
+func issueMuti(ops []commandI) {
+	groups := map[*kvstore][]commandI{}
+	for _, op := range ops {
+		store := op.getKV().store
+		groups[store] = append(groups[store], op)
+	}
+
+	for store, group := range groups {
+		for _, op := range group {
+			op.getKV().processCmd(op)
+		}
+		if _, ok := group[0].(*cmdGet); ok {
+			store.flushReadReq()
+		} else {
+			store.flushPropose()
+		}
+	}
+}
+
+func mget(n *KVNode, cli *cliConn, msg *net.Message) {
+
+	req := msg.GetData().(*proto.MgetReq)
+
+	head := msg.GetHead()
+
+	processDeadline, respDeadline := getDeadline(head.Timeout)
+
+	muti := newMutiReplyer(newReplyer(cli, head.Seqno, respDeadline), func(rows []*proto.Row) pb.Message {
+		return &proto.MgetResp{Rows: rows}
+	})
+
+	table := req.GetTable()
+
+	if "" == table {
+		muti.replyer.reply(muti, errcode.ERR_MISSING_TABLE, nil, 0)
+		return
+	}
+
+	if len(req.GetKeys()) == 0 {
+		muti.replyer.reply(muti, errcode.ERR_MISSING_KEY, nil, 0)
+		return
+	}
+
+	meta := n.storeMgr.dbmeta.GetTableMeta(table)
+	if nil == meta {
+		muti.replyer.reply(muti, errcode.ERR_INVAILD_TABLE, nil, 0)
+		return
+	}
+
+	fields := map[string]*proto.Field{}
+	if req.GetAll() {
+		for _, name := range meta.GetQueryMeta().GetFieldNames() {
+			if name != "__key__" && name != "__version__" {
+				fields[name] = proto.PackField(name, nil)
+			}
+		}
+	} else {
+		for _, name := range req.GetFields() {
+			fields[name] = proto.PackField(name, nil)
+		}
+	}
+
+	if !meta.CheckGet(fields) {
+		muti.replyer.reply(muti, errcode.ERR_INVAILD_FIELD, nil, 0)
+		return
+	}
+
+	muti.init(req.GetKeys())
+
+	ops := make([]commandI, 0, len(req.GetKeys()))
+
+	for i, key := range req.GetKeys() {
+		op := &cmdGet{
+			commandBase: &commandBase{
+				deadline: processDeadline,
+				replyer:  newSubReplyer(muti, i),
+			},
+			fields: fields,
+		}
+
+		if "" == key {
+			op.reply(errcode.ERR_MISSING_KEY, nil, 0)
+		} else if kv, err := n.storeMgr.getkv(table, key, table+":"+key); errcode.ERR_OK != err {
+			op.reply(err, nil, 0)
+		} else {
+			op.kv = kv
+			ops = append(ops, op)
+		}
+	}
+
+	issueMuti(ops)
+}
+
+func mset(n *KVNode, cli *cliConn, msg *net.Message) {
+
+	req := msg.GetData().(*proto.MsetReq)
+
+	head := msg.GetHead()
+
+	processDeadline, respDeadline := getDeadline(head.Timeout)
+
+	muti := newMutiReplyer(newReplyer(cli, head.Seqno, respDeadline), func(rows []*proto.Row) pb.Message {
+		return &proto.MsetResp{Rows: rows}
+	})
+
+	table := req.GetTable()
+
+	if "" == table {
+		muti.replyer.reply(muti, errcode.ERR_MISSING_TABLE, nil, 0)
+		return
+	}
+
+	if len(req.GetItems()) == 0 {
+		muti.replyer.reply(muti, errcode.ERR_MISSING_KEY, nil, 0)
+		return
+	}
+
+	keys := make([]string, 0, len(req.GetItems()))
+	for _, v := range req.GetItems() {
+		keys = append(keys, v.GetKey())
+	}
+
+	muti.init(keys)
+
+	ops := make([]commandI, 0, len(keys))
+
+	for i, item := range req.GetItems() {
+		op := &cmdSet{
+			commandBase: &commandBase{
+				deadline: processDeadline,
+				replyer:  newSubReplyer(muti, i),
+				version:  item.Version,
+			},
+			fields: map[string]*proto.Field{},
+		}
+
+		key := item.GetKey()
+
+		if "" == key {
+			op.reply(errcode.ERR_MISSING_KEY, nil, 0)
+			continue
+		}
+
+		if len(item.GetFields()) == 0 {
+			op.reply(errcode.ERR_MISSING_FIELDS, nil, 0)
+			continue
+		}
+
+		kv, err := n.storeMgr.getkv(table, key, table+":"+key)
+		if errcode.ERR_OK != err {
+			op.reply(err, nil, 0)
+			continue
+		}
+
+		op.kv = kv
+
+		for _, v := range item.GetFields() {
+			op.fields[v.GetName()] = v
+		}
+
+		if !kv.meta.CheckSet(op.fields) {
+			op.reply(errcode.ERR_INVAILD_FIELD, nil, 0)
+			continue
+		}
+
+		ops = append(ops, op)
+	}
+
+	issueMuti(ops)
+}
@@ -1,29 +1,218 @@
 package kvnode
 
 import (
+	"fmt"
+	"github.com/sniperHW/flyfish/dbmeta"
 	"github.com/sniperHW/flyfish/errcode"
 	"github.com/sniperHW/flyfish/net"
 	"github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/flyfish/util/str"
+	"sort"
+	"strings"
+	"sync"
 )
 
+/*
+ * 表格配置重载
+ * 新配置作为proposal_meta提交到本节点作为leader的每个store,所有副本在apply时切换，保证同一个store的所有副本在相同的日志位置切换配置。
+ * 本节点不是leader的store,将请求(只包含该region)转发到其leader所在节点(地址从kvpd获得),所有region切换成功才返回成功。
+ * 转发的请求只在接收节点作为leader的指定region上切换，不再转发，leader未知或再次变更时返回ERR_NOT_LEADER。
+ * 版本号由配置内容计算，已经切换的store收到相同的配置时直接成功，转发节点切换的版本与本节点不同时视为失败。
+ */
+
+type cmdReloadTableMeta struct {
+	sync.Mutex
+	replyer  *replyer
+	version  int64
+	count    int
+	errStr   string
+	failed   map[int]int32 //store index -> errCode
+	finished map[int]bool
+}
+
+func (this *cmdReloadTableMeta) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	resp := &proto.ReloadTableConfResp{
+		Version: this.version,
+	}
+
+	if errcode.ERR_OK != errCode {
+		if "" != this.errStr {
+			resp.Err = this.errStr
+		} else if len(this.failed) > 0 {
+			errs := []string{}
+			for k, v := range this.failed {
+				errs = append(errs, fmt.Sprintf("%d:%s", k, errcode.GetErrorStr(v)))
+			}
+			sort.Strings(errs)
+			resp.Err = strings.Join(errs, ",")
+		} else {
+			resp.Err = errcode.GetErrorStr(errCode)
+		}
+	}
+
+	return net.NewMessage(net.CommonHead{
+		Seqno:   this.replyer.seqno,
+		ErrCode: errCode,
+	}, resp)
+}
+
+func (this *cmdReloadTableMeta) onResult(index int, errCode int32) {
+	this.Lock()
+	if this.finished[index] {
+		this.Unlock()
+		return
+	}
+	this.finished[index] = true
+	if errcode.ERR_OK != errCode {
+		this.failed[index] = errCode
+	}
+	done := len(this.finished) == this.count
+	this.Unlock()
+
+	if done {
+		errCode = errcode.ERR_OK
+		for _, v := range this.failed {
+			//只要有一个store失败就返回失败，优先返回ERR_NOT_LEADER提示调用方到其它节点重试
+			if errCode != errcode.ERR_NOT_LEADER {
+				errCode = v
+			}
+		}
+		this.replyer.reply(this, errCode, nil, 0)
+	}
+}
+
+type asynTaskReloadMeta struct {
+	store *kvstore
+	index int
+	cmd   *cmdReloadTableMeta
+	def   []string
+}
+
+func (this *asynTaskReloadMeta) done() {
+	this.store.Lock()
+	ok := this.store.switchMeta(this.cmd.version, this.def)
+	this.store.Unlock()
+	if ok {
+		this.cmd.onResult(this.index, errcode.ERR_OK)
+	} else {
+		this.cmd.onResult(this.index, errcode.ERR_OTHER)
+	}
+}
+
+func (this *asynTaskReloadMeta) onError(errno int32) {
+	this.cmd.onResult(this.index, errno)
+}
+
+func (this *asynTaskReloadMeta) append2Str(s *str.Str) {
+	appendProposal2Str(s, proposal_meta, this.cmd.version, this.def)
+}
+
+func (this *asynTaskReloadMeta) onPorposeTimeout() {
+	this.onError(errcode.ERR_TIMEOUT)
+}
+
+//转发到region的leader所在节点
+func (this *cmdReloadTableMeta) forward(n *KVNode, store *kvstore, index int) {
+	leader := store.rn.getLeaderNode()
+	if 0 == leader || leader == n.id {
+		this.onResult(index, errcode.ERR_NOT_LEADER)
+		return
+	}
+
+	c := n.getPeerClient(leader)
+	if nil == c {
+		this.onResult(index, errcode.ERR_NOT_LEADER)
+		return
+	}
+
+	go func() {
+		r := c.ReloadTableConf(index).Exec()
+		if errcode.ERR_OK == r.ErrCode && r.Version != this.version {
+			//两个节点读取到的配置不同
+			logger.Errorln("reloadTableMeta region", index, "leader", leader, "switch to version", r.Version, "expect", this.version)
+			this.onResult(index, errcode.ERR_OTHER)
+		} else {
+			this.onResult(index, r.ErrCode)
+		}
+	}()
+}
+
 func reloadTableMeta(n *KVNode, cli *cliConn, msg *net.Message) {
-	dbMetaStr, err := loadMetaString()
+
+	req := msg.GetData().(*proto.ReloadTableConfReq)
+
 	head := msg.GetHead()
-	errStr := ""
 
-	if nil == err {
-		err = n.storeMgr.dbmeta.Reload(dbMetaStr)
+	_, respDeadline := getDeadline(head.Timeout)
+
+	cmd := &cmdReloadTableMeta{
+		replyer:  newReplyer(cli, head.Seqno, respDeadline),
+		failed:   map[int]int32{},
+		finished: map[int]bool{},
+	}
+
+	//需要访问数据库，不在线程池中执行
+	go func() {
+		def, err := loadMetaString()
+		if nil == err {
+			//检查配置是否合法
+			_, err = dbmeta.NewDBMeta(def)
+		}
+
 		if nil != err {
-			head.ErrCode = errcode.ERR_OTHER
-			errStr = err.Error()
+			logger.Errorln("reloadTableMeta error", err)
+			cmd.errStr = err.Error()
+			cmd.replyer.reply(cmd, errcode.ERR_OTHER, nil, 0)
+			return
+		}
+
+		n.storeMgr.RLock()
+		stores := make(map[int]*kvstore, len(n.storeMgr.stores))
+		if len(req.GetRegions()) > 0 {
+			for _, v := range req.GetRegions() {
+				if store, ok := n.storeMgr.stores[int(v)]; ok {
+					stores[int(v)] = store
+				}
+			}
 		} else {
-			head.ErrCode = errcode.ERR_OK
+			for k, v := range n.storeMgr.stores {
+				stores[k] = v
+			}
 		}
-	} else {
-		head.ErrCode = errcode.ERR_OTHER
-		errStr = err.Error()
-	}
+		n.storeMgr.RUnlock()
+
+		if len(stores) == 0 {
+			cmd.errStr = "region not found"
+			cmd.replyer.reply(cmd, errcode.ERR_OTHER, nil, 0)
+			return
+		}
+
+		//版本号由配置内容决定，各store及重试的请求使用相同的版本
+		cmd.version = dbmeta.DefVersion(def)
+		cmd.count = len(stores)
 
-	cli.send(net.NewMessage(head, &proto.ReloadTableConfResp{Err: errStr}))
+		for k, v := range stores {
+			if !v.rn.isLeader() {
+				if len(req.GetRegions()) > 0 {
+					//转发来的请求不再转发
+					cmd.onResult(k, errcode.ERR_NOT_LEADER)
+				} else {
+					cmd.forward(n, v, k)
+				}
+				continue
+			}
 
+			task := &asynTaskReloadMeta{
+				store: v,
+				index: k,
+				cmd:   cmd,
+				def:   def,
+			}
+			if err := v.proposeC.AddNoWait(task); nil != err {
+				task.onError(errcode.ERR_SERVER_STOPED)
+			} else {
+				v.flushPropose()
+			}
+		}
+	}()
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/sniperHW/flyfish/errcode"
 	"github.com/sniperHW/flyfish/net"
 	"github.com/sniperHW/flyfish/proto"
+	"time"
 )
 
 type asynCmdTaskSet struct {
@@ -137,6 +138,7 @@ func set(n *KVNode, cli *cliConn, msg *net.Message) {
 			deadline: processDeadline,
 			replyer:  newReplyer(cli, msg.GetHead().Seqno, respDeadline),
 			version:  req.Version,
+			ttl:      time.Duration(req.GetTtl()) * time.Millisecond,
 		},
 		fields: map[string]*proto.Field{},
 	}
@@ -0,0 +1,473 @@
+package kvnode
+
+import (
+	"github.com/sniperHW/flyfish/dbmeta"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/flyfish/util/str"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ * 事务
+ * 事务中的key必须属于同一个kvstore,每个op可以指定自己的表(为空时使用请求的表)。按uniKey的顺序依次向每个kv投递cmdTxn,cmdTxn到达队列头部时
+ * (记录不在缓存中先从数据库加载)锁定kv的命令队列,然后再投递下一个kv的cmdTxn。
+ * 所有kv都被锁定后检查条件,条件全部满足时所有写入作为一个proposal提交,提交后在同一个数据库事务中回写,最后释放所有kv。
+ * 所有事务都按uniKey的顺序锁定kv,不会产生死锁。
+ */
+
+type txnOp struct {
+	index   int //在请求中的下标
+	table   string
+	key     string
+	uniKey  string
+	kv      *kv
+	cmd     *cmdTxn
+	version *int64
+	cmp     map[string]*proto.Field
+	fields  map[string]*proto.Field
+	del     bool
+}
+
+//检查条件(调用方持有kv锁)
+func (this *txnOp) check(kv *kv) int32 {
+	if nil != this.version && *this.version != kv.version {
+		return errcode.ERR_VERSION_MISMATCH
+	}
+
+	if len(this.cmp) > 0 {
+		if kv.getStatus() != cache_ok {
+			return errcode.ERR_RECORD_NOTEXIST
+		}
+
+		for name, v := range this.cmp {
+			vv := kv.fields[name]
+			if nil == vv {
+				/*
+				 * 表格新增加了列，但未设置过，使用默认值
+				 */
+				vv = proto.PackField(name, kv.meta.GetDefaultV(name))
+			}
+			if !v.IsEqual(vv) {
+				return errcode.ERR_CAS_NOT_EQUAL
+			}
+		}
+	}
+
+	return errcode.ERR_OK
+}
+
+//生成写入任务,没有写入返回nil(调用方持有kv锁)
+func (this *txnOp) makeTask(kv *kv) *asynCmdTaskBase {
+	status := kv.getStatus()
+
+	if this.del {
+		if status != cache_ok {
+			return nil
+		}
+		return &asynCmdTaskBase{
+			commands: []commandI{this.cmd},
+			sqlFlag:  sql_delete,
+		}
+	}
+
+	if len(this.fields) == 0 {
+		return nil
+	}
+
+	task := &asynCmdTaskBase{
+		commands: []commandI{this.cmd},
+		fields:   map[string]*proto.Field{},
+		version:  kv.version + 1,
+	}
+
+	if status == cache_missing {
+		fillDefaultValue(kv.meta, &task.fields)
+		task.sqlFlag = sql_insert_update
+	} else {
+		task.sqlFlag = sql_update
+	}
+
+	for k, v := range this.fields {
+		task.fields[k] = v
+	}
+
+	return task
+}
+
+type txn struct {
+	replyer  *replyer
+	n        *KVNode
+	store    *kvstore
+	ops      []*txnOp //按uniKey排序
+	locked   int      //已经锁定的kv数量
+	deadline time.Time
+	finish   int32
+	rows     []*proto.Row //检查条件后才有值
+}
+
+func (this *txn) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	return net.NewMessage(net.CommonHead{
+		Seqno:   this.replyer.seqno,
+		ErrCode: errCode,
+	}, &proto.TxnResp{Rows: this.rows})
+}
+
+func (this *txn) reply(errCode int32) {
+	this.replyer.reply(this, errCode, nil, 0)
+}
+
+func (this *txn) isFinish() bool {
+	return atomic.LoadInt32(&this.finish) == 1
+}
+
+//释放所有已经锁定的kv
+func (this *txn) release() {
+	if atomic.CompareAndSwapInt32(&this.finish, 0, 1) {
+		for i := 0; i < this.locked; i++ {
+			this.ops[i].kv.processCmd(nil)
+		}
+	}
+}
+
+func (this *txn) abort(errCode int32) {
+	this.reply(errCode)
+	this.release()
+}
+
+func (this *txn) lockNext() {
+	if this.locked == len(this.ops) {
+		this.exec()
+		return
+	}
+
+	op := this.ops[this.locked]
+
+	kv, err := this.n.storeMgr.getkv(op.table, op.key, op.uniKey)
+	if errcode.ERR_OK != err {
+		this.abort(err)
+		return
+	}
+
+	op.kv = kv
+	op.cmd = &cmdTxn{
+		txn: this,
+		op:  op,
+	}
+
+	kv.processCmd(op.cmd)
+}
+
+//所有kv都已经锁定
+func (this *txn) exec() {
+
+	errCode := errcode.ERR_OK
+
+	tasks := []*asynCmdTaskBase{}
+
+	rows := make([]*proto.Row, len(this.ops))
+
+	for _, op := range this.ops {
+		kv := op.kv
+		kv.Lock()
+		row := &proto.Row{
+			Key:     op.key,
+			Version: kv.version,
+			ErrCode: op.check(kv),
+		}
+		rows[op.index] = row
+		if row.ErrCode != errcode.ERR_OK {
+			if errCode == errcode.ERR_OK {
+				errCode = row.ErrCode
+			}
+		} else if errCode == errcode.ERR_OK {
+			if task := op.makeTask(kv); nil != task {
+				row.Version = task.version
+				tasks = append(tasks, task)
+			}
+		}
+		kv.Unlock()
+	}
+
+	this.rows = rows
+
+	//条件不满足或者没有写入,直接返回
+	if errCode != errcode.ERR_OK || len(tasks) == 0 {
+		this.abort(errCode)
+		return
+	}
+
+	for _, v := range tasks {
+		if errCode := checkAsynCmdTask(v); errcode.ERR_OK != errCode {
+			this.abort(errCode)
+			return
+		}
+	}
+
+	if err := this.store.proposeC.AddNoWait(&asynTaskTxn{txn: this, tasks: tasks}); nil != err {
+		this.abort(errcode.ERR_SERVER_STOPED)
+	} else {
+		this.store.flushPropose()
+	}
+}
+
+//事务中所有写入作为一个整体提交
+type asynTaskTxn struct {
+	txn   *txn
+	tasks []*asynCmdTaskBase
+}
+
+func (this *asynTaskTxn) append2Str(s *str.Str) {
+	for _, v := range this.tasks {
+		v.append2Str(s)
+	}
+}
+
+func (this *asynTaskTxn) onError(errno int32) {
+	this.txn.abort(errno)
+}
+
+func (this *asynTaskTxn) onPorposeTimeout() {
+	this.txn.reply(errcode.ERR_TIMEOUT)
+}
+
+func (this *asynTaskTxn) done() {
+
+	t := &sqlTxnUpdate{}
+
+	writeThrough := false
+	for _, v := range this.tasks {
+		if v.getKV().getMeta().GetWriteMode() == dbmeta.WriteThrough {
+			writeThrough = true
+			break
+		}
+	}
+
+	if writeThrough {
+		//包含写直达的表格时，回写完成后才返回
+		t.onFinish = func(err error) {
+			this.txn.reply(writeBackErrCode(err))
+		}
+	} else {
+		this.txn.reply(errcode.ERR_OK)
+	}
+
+	for _, v := range this.tasks {
+		kv := v.getKV()
+		kv.Lock()
+		v.commit(kv)
+		if kv.getSqlFlag() != sql_none {
+			owned := !kv.isWriteBack()
+			if owned {
+				kv.setWriteBack(true)
+			}
+			t.kvs = append(t.kvs, kv)
+			t.owned = append(t.owned, owned)
+		}
+		kv.Unlock()
+	}
+
+	if len(t.kvs) > 0 {
+		this.txn.n.sqlMgr.pushTxnUpdateReq(t)
+	} else if nil != t.onFinish {
+		t.onFinish(nil)
+	}
+
+	//向关注者推送变更
+	for _, v := range this.tasks {
+		if version, fields, changed := v.getChange(); changed {
+			this.txn.n.watchMgr.notify(v.getKV().uniKey, version, fields)
+		}
+	}
+
+	this.txn.release()
+}
+
+//锁定kv命令队列的占位任务
+type asynCmdTaskTxnLock struct {
+	*asynCmdTaskBase
+}
+
+type cmdTxn struct {
+	txn    *txn
+	op     *txnOp
+	locked bool
+}
+
+func (this *cmdTxn) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	return nil
+}
+
+/*
+ * 加载记录的任务也会调用reply,加载成功(包括记录不存在)忽略,其它错误终止事务
+ */
+func (this *cmdTxn) reply(errCode int32, fields map[string]*proto.Field, version int64) {
+	if errCode != errcode.ERR_OK && errCode != errcode.ERR_RECORD_NOTEXIST {
+		this.txn.abort(errCode)
+	}
+}
+
+func (this *cmdTxn) dontReply() {
+	this.txn.replyer.dontReply()
+	this.txn.release()
+}
+
+//锁定后只在事务结束时才返回true,使释放kv时命令从队列中移除
+func (this *cmdTxn) isCancel() bool {
+	if this.locked {
+		return this.txn.isFinish()
+	} else {
+		return this.txn.isFinish() || this.txn.replyer.isCancel()
+	}
+}
+
+func (this *cmdTxn) getKV() *kv {
+	return this.op.kv
+}
+
+func (this *cmdTxn) isTimeout() bool {
+	if this.locked {
+		return false
+	} else {
+		return time.Now().After(this.txn.deadline)
+	}
+}
+
+func (this *cmdTxn) checkVersion(version int64) bool {
+	return true
+}
+
+func (this *cmdTxn) getTTL() time.Duration {
+	return 0
+}
+
+func (this *cmdTxn) prepare(t asynCmdTaskI) (asynCmdTaskI, bool) {
+
+	if t != nil {
+		return t, false
+	}
+
+	if this.op.kv.getStatus() == cache_new {
+		//加载完成后会再次执行prepare
+		task := newAsynCmdTaskGet()
+		task.commands = append(task.commands, this)
+		return task, false
+	}
+
+	this.locked = true
+
+	return &asynCmdTaskTxnLock{
+		asynCmdTaskBase: &asynCmdTaskBase{
+			commands: []commandI{this},
+		},
+	}, false
+}
+
+//kv已经锁定,锁定下一个kv
+func (this *cmdTxn) onLocked() {
+	this.txn.locked++
+	this.txn.lockNext()
+}
+
+func txnExec(n *KVNode, cli *cliConn, msg *net.Message) {
+
+	req := msg.GetData().(*proto.TxnReq)
+
+	head := msg.GetHead()
+
+	processDeadline, respDeadline := getDeadline(head.Timeout)
+
+	t := &txn{
+		replyer:  newReplyer(cli, head.Seqno, respDeadline),
+		n:        n,
+		deadline: processDeadline,
+	}
+
+	if len(req.GetOps()) == 0 {
+		t.reply(errcode.ERR_MISSING_KEY)
+		return
+	}
+
+	keys := map[string]bool{}
+
+	for i, v := range req.GetOps() {
+		op := &txnOp{
+			index:   i,
+			table:   v.GetTable(),
+			key:     v.GetKey(),
+			version: v.Version,
+			del:     v.GetDel(),
+			cmp:     map[string]*proto.Field{},
+			fields:  map[string]*proto.Field{},
+		}
+
+		if "" == op.table {
+			op.table = req.GetTable()
+		}
+
+		if "" == op.table {
+			t.reply(errcode.ERR_MISSING_TABLE)
+			return
+		}
+
+		if "" == op.key {
+			t.reply(errcode.ERR_MISSING_KEY)
+			return
+		}
+
+		meta := n.storeMgr.dbmeta.GetTableMeta(op.table)
+		if nil == meta {
+			t.reply(errcode.ERR_INVAILD_TABLE)
+			return
+		}
+
+		op.uniKey = op.table + ":" + op.key
+
+		if keys[op.uniKey] {
+			t.reply(errcode.ERR_DUPLICATE_KEY)
+			return
+		}
+
+		keys[op.uniKey] = true
+
+		store := n.storeMgr.getStore(op.uniKey)
+		if nil == store {
+			t.reply(errcode.ERR_NOT_LEADER)
+			return
+		} else if nil == t.store {
+			t.store = store
+		} else if t.store != store {
+			t.reply(errcode.ERR_CROSS_REGION)
+			return
+		}
+
+		for _, vv := range v.GetCmp() {
+			op.cmp[vv.GetName()] = vv
+		}
+
+		for _, vv := range v.GetFields() {
+			op.fields[vv.GetName()] = vv
+		}
+
+		if (len(op.cmp) > 0 && !meta.CheckSet(op.cmp)) || (len(op.fields) > 0 && !meta.CheckSet(op.fields)) {
+			t.reply(errcode.ERR_INVAILD_FIELD)
+			return
+		}
+
+		if op.del && len(op.fields) > 0 {
+			t.reply(errcode.ERR_INVAILD_FIELD)
+			return
+		}
+
+		t.ops = append(t.ops, op)
+	}
+
+	sort.Slice(t.ops, func(i, j int) bool {
+		return t.ops[i].uniKey < t.ops[j].uniKey
+	})
+
+	t.lockNext()
+}
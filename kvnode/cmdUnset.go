@@ -0,0 +1,149 @@
+package kvnode
+
+import (
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/proto"
+)
+
+/*
+ * 将记录的指定字段重置为默认值，作为普通的更新提交，回写使用update语句
+ */
+
+type asynCmdTaskUnset struct {
+	*asynCmdTaskBase
+}
+
+func (this *asynCmdTaskUnset) onSqlResp(errno int32) {
+	this.asynCmdTaskBase.onSqlResp(errno)
+
+	if errno == errcode.ERR_OK {
+		cmd := this.commands[0].(*cmdUnset)
+		if !cmd.checkVersion(this.version) {
+			this.errno = errcode.ERR_VERSION_MISMATCH
+		} else {
+			cmd.fillFields(this.fields)
+			this.sqlFlag = sql_update
+			this.version++
+			this.getKV().store.issueUpdate(this)
+			return
+		}
+	}
+
+	if errno != errcode.ERR_SQLERROR {
+		this.reply()
+		this.getKV().store.issueAddkv(this)
+	}
+}
+
+func newAsynCmdTaskUnset(cmd commandI) *asynCmdTaskUnset {
+	return &asynCmdTaskUnset{
+		asynCmdTaskBase: &asynCmdTaskBase{
+			commands: []commandI{cmd},
+		},
+	}
+}
+
+type cmdUnset struct {
+	*commandBase
+	fields []string
+}
+
+func (this *cmdUnset) reply(errCode int32, fields map[string]*proto.Field, version int64) {
+	this.replyer.reply(this, errCode, fields, version)
+}
+
+func (this *cmdUnset) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	return net.NewMessage(net.CommonHead{
+		Seqno:   this.replyer.seqno,
+		ErrCode: errCode,
+	}, &proto.UnsetResp{
+		Version: version,
+	})
+}
+
+//将要重置的字段设为默认值
+func (this *cmdUnset) fillFields(fields map[string]*proto.Field) {
+	meta := this.kv.getMeta()
+	for _, name := range this.fields {
+		fields[name] = proto.PackField(name, meta.GetDefaultV(name))
+	}
+}
+
+func (this *cmdUnset) prepare(t asynCmdTaskI) (asynCmdTaskI, bool) {
+
+	if t != nil {
+		return t, false
+	}
+
+	kv := this.kv
+
+	status := kv.getStatus()
+
+	if status == cache_new {
+		return newAsynCmdTaskUnset(this), true
+	}
+
+	if status == cache_missing {
+		this.reply(errcode.ERR_RECORD_NOTEXIST, nil, 0)
+		return t, true
+	}
+
+	if !this.checkVersion(kv.version) {
+		this.reply(errcode.ERR_VERSION_MISMATCH, nil, kv.version)
+		return t, true
+	}
+
+	task := newAsynCmdTaskUnset(this)
+	task.fields = map[string]*proto.Field{}
+	this.fillFields(task.fields)
+	task.sqlFlag = sql_update
+	task.version = kv.version + 1
+
+	return task, true
+}
+
+func unset(n *KVNode, cli *cliConn, msg *net.Message) {
+
+	req := msg.GetData().(*proto.UnsetReq)
+
+	head := msg.GetHead()
+
+	processDeadline, respDeadline := getDeadline(head.Timeout)
+
+	op := &cmdUnset{
+		commandBase: &commandBase{
+			deadline: processDeadline,
+			replyer:  newReplyer(cli, head.Seqno, respDeadline),
+			version:  req.Version,
+		},
+		fields: req.GetFields(),
+	}
+
+	if len(op.fields) == 0 {
+		op.reply(errcode.ERR_MISSING_FIELDS, nil, 0)
+		return
+	}
+
+	table, key := head.SplitUniKey()
+
+	if kv, err := n.storeMgr.getkv(table, key, head.UniKey); errcode.ERR_OK != err {
+		op.reply(err, nil, 0)
+		return
+	} else {
+
+		op.kv = kv
+
+		fields := map[string]*proto.Field{}
+		for _, v := range op.fields {
+			fields[v] = proto.PackField(v, nil)
+		}
+
+		if !kv.meta.CheckGet(fields) {
+			op.reply(errcode.ERR_INVAILD_FIELD, nil, 0)
+			return
+		}
+
+		kv.processCmd(op)
+	}
+}
@@ -29,11 +29,10 @@ func (this *dispatcher) Dispatch(session kendynet.StreamSession, cmd uint16, msg
 				Timestamp: time.Now().UnixNano(),
 			}))
 		case uint16(proto.CmdType_Cancel):
+			//cancel不经过线程池，尽快使被取消的请求失效
 			cancel(this.kvnode, session.GetUserData().(*cliConn), msg)
-		case uint16(proto.CmdType_ReloadTableConf):
-			reloadTableMeta(this.kvnode, session.GetUserData().(*cliConn), msg)
 		default:
-			if handler, ok := this.handlers[cmd]; ok {
+			if handler, ok := this.handlers[cmd]; ok && this.kvnode.checkSlotVersion(session, cmd, msg) {
 				//投递给线程池处理
 				this.kvnode.pushNetCmd(handler, session.GetUserData().(*cliConn), msg)
 			}
@@ -46,6 +45,7 @@ func (this *dispatcher) OnClose(session kendynet.StreamSession, reason string) {
 		switch u.(type) {
 		case *cliConn:
 			u.(*cliConn).clear()
+			this.kvnode.watchMgr.removeConn(u.(*cliConn))
 		}
 	}
 	atomic.AddInt64(&this.kvnode.clientCount, -1)
@@ -58,6 +58,8 @@ func (this *dispatcher) OnNewClient(session kendynet.StreamSession) {
 		&cliConn{
 			session:  session,
 			replyers: map[int64]*replyer{},
+			canceled: map[int64]time.Time{},
+			watchers: map[int64]*watcher{},
 			node:     this.kvnode,
 		},
 	)
@@ -0,0 +1,115 @@
+package kvnode
+
+import (
+	"container/heap"
+	"sync"
+)
+
+/*
+ * 过期时间堆
+ * 每个kvstore为带过期时间的kv维护按过期时间排序的最小堆,检查过期时只访问已经过期的部分。
+ * 在kv.setExpire中更新(kvExpire的锁总是最后获取)。
+ * 带过期时间的kv不能从数据库恢复过期时间,不参与LRU淘汰,数量由MaxTTLCachePerGroupSize单独限制。
+ */
+
+type expireItem struct {
+	kv     *kv
+	expire int64
+	index  int
+}
+
+type expireHeap []*expireItem
+
+func (h expireHeap) Len() int { return len(h) }
+
+func (h expireHeap) Less(i, j int) bool { return h[i].expire < h[j].expire }
+
+func (h expireHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *expireHeap) Push(x interface{}) {
+	item := x.(*expireItem)
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *expireHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return item
+}
+
+type kvExpire struct {
+	sync.Mutex
+	heap  expireHeap
+	items map[*kv]*expireItem
+}
+
+func newKvExpire() *kvExpire {
+	return &kvExpire{
+		items: map[*kv]*expireItem{},
+	}
+}
+
+//expire为0时从堆中移除
+func (this *kvExpire) update(k *kv, expire int64) {
+	this.Lock()
+	defer this.Unlock()
+	item, ok := this.items[k]
+	if expire <= 0 {
+		if ok {
+			heap.Remove(&this.heap, item.index)
+			delete(this.items, k)
+		}
+	} else if ok {
+		if item.expire != expire {
+			item.expire = expire
+			heap.Fix(&this.heap, item.index)
+		}
+	} else {
+		item = &expireItem{kv: k, expire: expire}
+		heap.Push(&this.heap, item)
+		this.items[k] = item
+	}
+}
+
+func (this *kvExpire) remove(k *kv) {
+	this.update(k, 0)
+}
+
+func (this *kvExpire) reset() {
+	this.Lock()
+	defer this.Unlock()
+	this.heap = nil
+	this.items = map[*kv]*expireItem{}
+}
+
+func (this *kvExpire) len() int {
+	this.Lock()
+	defer this.Unlock()
+	return len(this.heap)
+}
+
+//返回过期时间不晚于now(unix毫秒)的kv,只访问堆中已经过期的部分
+func (this *kvExpire) expired(now int64) []*kv {
+	this.Lock()
+	defer this.Unlock()
+	var ret []*kv
+	var walk func(i int)
+	walk = func(i int) {
+		if i >= len(this.heap) || this.heap[i].expire > now {
+			return
+		}
+		ret = append(ret, this.heap[i].kv)
+		walk(2*i + 1)
+		walk(2*i + 2)
+	}
+	walk(0)
+	return ret
+}
@@ -0,0 +1,143 @@
+package kvnode
+
+import (
+	"github.com/sniperHW/flyfish/proto"
+	"strconv"
+	"sync"
+)
+
+/*
+ * 二级索引
+ * 每个kvstore为缓存中存在的记录(cache_ok)维护索引字段值到kv的映射,不在缓存中的记录通过数据库查询获得。
+ * 索引在kv锁内更新(kvIndex的锁总是最后获取),查询时先取出候选kv,再在kv锁内确认当前值。
+ */
+
+type kvIndex struct {
+	sync.Mutex
+	tables map[string]map[string]map[string]map[*kv]bool //table->field->value->kv
+	values map[*kv]map[string]string                     //kv当前被索引的值
+}
+
+func newKvIndex() *kvIndex {
+	return &kvIndex{
+		tables: map[string]map[string]map[string]map[*kv]bool{},
+		values: map[*kv]map[string]string{},
+	}
+}
+
+//索引只支持int和string字段
+func indexValue(v *proto.Field) string {
+	if v.IsInt() {
+		return strconv.FormatInt(v.GetInt(), 10)
+	} else {
+		return v.GetString()
+	}
+}
+
+func (this *kvIndex) add(k *kv, field string, value string) {
+	fields, ok := this.tables[k.table]
+	if !ok {
+		fields = map[string]map[string]map[*kv]bool{}
+		this.tables[k.table] = fields
+	}
+
+	values, ok := fields[field]
+	if !ok {
+		values = map[string]map[*kv]bool{}
+		fields[field] = values
+	}
+
+	kvs, ok := values[value]
+	if !ok {
+		kvs = map[*kv]bool{}
+		values[value] = kvs
+	}
+
+	kvs[k] = true
+}
+
+func (this *kvIndex) del(k *kv, field string, value string) {
+	if fields, ok := this.tables[k.table]; ok {
+		if values, ok := fields[field]; ok {
+			if kvs, ok := values[value]; ok {
+				delete(kvs, k)
+				if len(kvs) == 0 {
+					delete(values, value)
+				}
+			}
+		}
+	}
+}
+
+//根据kv当前的状态更新索引(调用方持有kv锁)
+func (this *kvIndex) update(kv *kv) {
+
+	var values map[string]string
+
+	if kv.getStatus() == cache_ok {
+		for _, name := range kv.meta.GetIndexFields() {
+			v := kv.fields[name]
+			if nil == v {
+				v = proto.PackField(name, kv.meta.GetDefaultV(name))
+			}
+			if nil == values {
+				values = map[string]string{}
+			}
+			values[name] = indexValue(v)
+		}
+	}
+
+	this.Lock()
+	defer this.Unlock()
+
+	old := this.values[kv]
+
+	for name, v := range old {
+		if vv, ok := values[name]; !ok || vv != v {
+			this.del(kv, name, v)
+		}
+	}
+
+	for name, v := range values {
+		if vv, ok := old[name]; !ok || vv != v {
+			this.add(kv, name, v)
+		}
+	}
+
+	if nil == values {
+		delete(this.values, kv)
+	} else {
+		this.values[kv] = values
+	}
+}
+
+func (this *kvIndex) remove(kv *kv) {
+	this.Lock()
+	defer this.Unlock()
+	for name, v := range this.values[kv] {
+		this.del(kv, name, v)
+	}
+	delete(this.values, kv)
+}
+
+func (this *kvIndex) reset() {
+	this.Lock()
+	defer this.Unlock()
+	this.tables = map[string]map[string]map[string]map[*kv]bool{}
+	this.values = map[*kv]map[string]string{}
+}
+
+//返回索引值等于value的候选kv
+func (this *kvIndex) lookup(table string, field string, value string) []*kv {
+	this.Lock()
+	defer this.Unlock()
+	kvs := []*kv{}
+	if fields, ok := this.tables[table]; ok {
+		if values, ok := fields[field]; ok {
+			for k := range values[value] {
+				kvs = append(kvs, k)
+			}
+		}
+	}
+	return kvs
+}
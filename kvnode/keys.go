@@ -0,0 +1,145 @@
+package kvnode
+
+import (
+	"math/rand"
+)
+
+/*
+ * 有序key
+ * 每个kvstore按表维护缓存中kv的有序key(跳表),scan合并缓存时只访问请求范围内的kv。
+ * 与elements一起在store锁内更新。
+ */
+
+const keysMaxLevel = 24
+
+type keyNode struct {
+	kv   *kv
+	next []*keyNode
+}
+
+type keyList struct {
+	head  keyNode
+	level int
+}
+
+func newKeyList() *keyList {
+	return &keyList{
+		head:  keyNode{next: make([]*keyNode, keysMaxLevel)},
+		level: 1,
+	}
+}
+
+func randomKeyLevel() int {
+	level := 1
+	for level < keysMaxLevel && rand.Intn(4) == 0 {
+		level++
+	}
+	return level
+}
+
+//返回每一层最后一个key小于key的节点
+func (this *keyList) findPrev(key string, prev []*keyNode) *keyNode {
+	n := &this.head
+	for i := this.level - 1; i >= 0; i-- {
+		for nil != n.next[i] && n.next[i].kv.key < key {
+			n = n.next[i]
+		}
+		if nil != prev {
+			prev[i] = n
+		}
+	}
+	return n
+}
+
+func (this *keyList) add(k *kv) {
+	prev := make([]*keyNode, keysMaxLevel)
+	n := this.findPrev(k.key, prev).next[0]
+	if nil != n && n.kv.key == k.key {
+		n.kv = k
+		return
+	}
+
+	level := randomKeyLevel()
+	for i := this.level; i < level; i++ {
+		prev[i] = &this.head
+	}
+	if level > this.level {
+		this.level = level
+	}
+
+	n = &keyNode{kv: k, next: make([]*keyNode, level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = prev[i].next[i]
+		prev[i].next[i] = n
+	}
+}
+
+func (this *keyList) remove(k *kv) {
+	prev := make([]*keyNode, keysMaxLevel)
+	n := this.findPrev(k.key, prev).next[0]
+	if nil == n || n.kv != k {
+		return
+	}
+	for i := 0; i < len(n.next); i++ {
+		prev[i].next[i] = n.next[i]
+	}
+	for this.level > 1 && nil == this.head.next[this.level-1] {
+		this.level--
+	}
+}
+
+func (this *keyList) empty() bool {
+	return nil == this.head.next[0]
+}
+
+type kvKeys struct {
+	tables map[string]*keyList
+}
+
+func newKvKeys() *kvKeys {
+	return &kvKeys{
+		tables: map[string]*keyList{},
+	}
+}
+
+func (this *kvKeys) add(k *kv) {
+	l, ok := this.tables[k.table]
+	if !ok {
+		l = newKeyList()
+		this.tables[k.table] = l
+	}
+	l.add(k)
+}
+
+func (this *kvKeys) remove(k *kv) {
+	if l, ok := this.tables[k.table]; ok {
+		l.remove(k)
+		if l.empty() {
+			delete(this.tables, k.table)
+		}
+	}
+}
+
+func (this *kvKeys) reset() {
+	this.tables = map[string]*keyList{}
+}
+
+//返回table中key在(lower,upper]范围内的kv,unbounded为true时不限制上界
+func (this *kvKeys) between(table string, lower string, upper string, unbounded bool) []*kv {
+	l, ok := this.tables[table]
+	if !ok {
+		return nil
+	}
+	var ret []*kv
+	//findPrev返回key小于lower的最后一个节点，之后的第一个节点可能等于lower
+	for n := l.findPrev(lower, nil).next[0]; nil != n; n = n.next[0] {
+		if n.kv.key <= lower {
+			continue
+		}
+		if !unbounded && n.kv.key > upper {
+			break
+		}
+		ret = append(ret, n.kv)
+	}
+	return ret
+}
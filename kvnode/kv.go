@@ -80,6 +80,19 @@ func (this *cmdQueue) popFront() commandI {
 	}
 }
 
+//移除队列中已经被取消或超时的命令
+func (this *cmdQueue) removeCanceled() {
+	for e := this.queue.Front(); nil != e; {
+		next := e.Next()
+		cmd := e.Value.(commandI)
+		if cmd.isCancel() || cmd.isTimeout() {
+			this.queue.Remove(e)
+			cmd.dontReply()
+		}
+		e = next
+	}
+}
+
 func (this *cmdQueue) lock() {
 	this.locked = true
 }
@@ -104,6 +117,11 @@ type kv struct {
 	modifyFields map[string]*proto.Field //发生变更尚未更新到sql数据库的字段
 	flag         *bitfield.BitField32
 	store        *kvstore
+	expire       int64           //过期时间(unix毫秒),0表示不过期
+	slot         int             //key所属的slot
+	sqlWaiters   []asynCmdTaskI  //等待下一次回写完成后返回的写入(写直达的表格)
+	sqlBatch     int32           //包含本kv且尚未执行完成的回写批次数量
+	batchWaiters []*sqlTxnUpdate //等待sqlBatch归零的事务回写
 	nnext        *kv
 	pprev        *kv
 }
@@ -115,7 +133,9 @@ func (this *kv) resetStatus() {
 	this.modifyFields = map[string]*proto.Field{}
 	this.fields = nil
 	this.flag = bitfield.NewBitField32(field_status, field_sql_flag, field_writeback, field_snapshoted, field_tmp, field_kicking)
+	this.setExpire(0)
 	this.setStatus(cache_new)
+	this.store.index.remove(this)
 }
 
 func (this *kv) getFlagValue(field bitfield.Field32) uint32 {
@@ -127,6 +147,29 @@ func (this *kv) getMeta() *dbmeta.TableMeta {
 	return (*dbmeta.TableMeta)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&this.meta))))
 }
 
+func (this *kv) setExpire(expire int64) {
+	atomic.StoreInt64(&this.expire, expire)
+	this.store.expires.update(this, expire)
+}
+
+func (this *kv) getExpire() int64 {
+	return atomic.LoadInt64(&this.expire)
+}
+
+func (this *kv) isExpired(now time.Time) bool {
+	expire := this.getExpire()
+	return expire > 0 && expire <= now.UnixNano()/int64(time.Millisecond)
+}
+
+//读取时的状态,已经过期但尚未删除的记录视为不存在(调用方持有kv锁)
+func (this *kv) getReadStatus() uint32 {
+	status := this.getStatus()
+	if status == cache_ok && this.isExpired(time.Now()) {
+		return cache_missing
+	}
+	return status
+}
+
 func (this *kv) setSqlFlag(sqlFlag uint32) {
 	this.flag.Set(field_sql_flag, sqlFlag)
 }
@@ -190,11 +233,20 @@ func (this *kv) kickable() bool {
 	}
 }
 
+//取出等待回写的写入，这些写入包含在本次回写中(调用方持有kv锁)
+func (this *kv) takeSqlWaiters() []asynCmdTaskI {
+	waiters := this.sqlWaiters
+	this.sqlWaiters = nil
+	return waiters
+}
+
 func (this *kv) setMissing() {
 	this.version = 0
+	this.setExpire(0)
 	this.setStatus(cache_missing)
 	this.fields = nil
 	this.modifyFields = map[string]*proto.Field{}
+	this.store.index.remove(this)
 }
 
 func (this *kv) setOK(version int64, fields map[string]*proto.Field) {
@@ -217,6 +269,8 @@ func (this *kv) setOK(version int64, fields map[string]*proto.Field) {
 		}
 	}
 
+	this.store.index.update(this)
+
 	logger.Debugln("set ok", this.uniKey, this.fields)
 
 }
@@ -233,6 +287,7 @@ func newkv(store *kvstore, tableMeta *dbmeta.TableMeta, key string, uniKey strin
 		},
 		modifyFields: map[string]*proto.Field{},
 		store:        store,
+		slot:         store.storeMgr.getSlot(uniKey),
 		flag:         bitfield.NewBitField32(field_status, field_sql_flag, field_writeback, field_snapshoted, field_tmp, field_kicking),
 	}
 
@@ -248,6 +303,9 @@ func (this *kv) processCmd(op commandI) {
 	removeKv := false
 	issueUpdate := false
 	issueReadReq := false
+	loadMissing := false
+	txnLocked := false
+	runScript := false
 
 	this.Lock()
 
@@ -265,12 +323,24 @@ func (this *kv) processCmd(op commandI) {
 			this.store.issueUpdate(asynTask)
 		} else if issueReadReq {
 			this.store.issueReadReq(asynTask)
+		} else if loadMissing {
+			asynTask.onSqlResp(errcode.ERR_RECORD_NOTEXIST)
+		} else if txnLocked {
+			asynTask.getCommands()[0].(*cmdTxn).onLocked()
+		} else if runScript {
+			this.store.getKvNode().scriptMgr.push(asynTask.(*asynCmdTaskEval))
 		}
 	}()
 
 	if nil != op {
 
+		if this.cmdQueue.queue.Len() > maxPendingCmdCountPerKv {
+			//被取消的命令不应继续占用队列
+			this.cmdQueue.removeCanceled()
+		}
+
 		if this.getStatus() == cache_remove ||
+			!this.store.serveSlot(this.slot) ||
 			atomic.LoadInt64(&this.store.kvNode.wait4ReplyCount) > 500000 ||
 			this.cmdQueue.queue.Len() > maxPendingCmdCountPerKv {
 			op.reply(errcode.ERR_RETRY, nil, 0)
@@ -296,7 +366,7 @@ func (this *kv) processCmd(op commandI) {
 			cmd.dontReply()
 		} else {
 			switch cmd.(type) {
-			case *cmdGet, *cmdCompareAndSet, *cmdCompareAndSetNx, *cmdIncrDecr, *cmdDel, *cmdSet, *cmdSetNx, *cmdKick:
+			case *cmdGet, *cmdCompareAndSet, *cmdCompareAndSetNx, *cmdIncrDecr, *cmdDel, *cmdSet, *cmdSetNx, *cmdKick, *cmdExpire, *cmdTxn, *cmdEval, *cmdUnset, *cmdPreload:
 				asynTask, flagPop = cmd.prepare(asynTask)
 
 				if flagPop {
@@ -326,6 +396,21 @@ loopEnd:
 		case *asynCmdTaskKick:
 			removeKv = true
 			return
+		case *asynCmdTaskPreload:
+			//预热任务在load队列满时不返回retry
+			if this.store.getKvNode().sqlMgr.pushLoadReq(asynTask) {
+				this.cmdQueue.lock()
+			} else {
+				asynTask.reply(errcode.ERR_RETRY)
+			}
+			return
+		}
+
+		if this.getMeta().GetWriteMode() == dbmeta.WriteCacheOnly {
+			//不访问数据库，不在缓存中的记录视为不存在
+			this.cmdQueue.lock()
+			loadMissing = true
+			return
 		}
 
 		/*
@@ -346,6 +431,12 @@ loopEnd:
 			issueReadReq = true
 		case *asynCmdTaskKick:
 			callKick = true
+		case *asynCmdTaskTxnLock:
+			//队列保持锁定直到事务结束
+			txnLocked = true
+		case *asynCmdTaskEval:
+			//队列保持锁定直到脚本执行完成
+			runScript = true
 		default:
 			issueUpdate = true
 		}
@@ -2,6 +2,7 @@ package kvnode
 
 import (
 	"fmt"
+	"github.com/sniperHW/flyfish/client"
 	"github.com/sniperHW/flyfish/conf"
 	"github.com/sniperHW/flyfish/dbmeta"
 	"github.com/sniperHW/flyfish/net"
@@ -37,6 +38,13 @@ type KVNode struct {
 	wait4ReplyCount int64
 	id              int
 	mutilRaft       *mutilRaft
+	watchMgr        *watchMgr
+	scriptMgr       *scriptMgr
+	preloadMgr      *preloadMgr
+	join            bool //以新成员身份加入已有集群
+	muPeer          sync.Mutex
+	peerServices    map[int]string            //节点id -> 服务地址，从kvpd获取
+	peerClients     map[string]*client.Client //服务地址 -> 管理命令连接
 }
 
 func verifyLogin(loginReq *protocol.LoginReq) bool {
@@ -108,12 +116,17 @@ func (this *KVNode) Start(id *int, cluster *string) error {
 		return err
 	}
 
+	metaVersion := dbmeta.DefVersion(dbMetaStr)
+
 	dbmeta, err := dbmeta.NewDBMeta(dbMetaStr)
 
 	if nil != err {
 		return err
 	}
 
+	//与重载时一样按配置内容设置版本
+	dbmeta.ReloadWithVersion(dbMetaStr, metaVersion)
+
 	this.id = *id
 
 	this.listener, err = net.NewListener("tcp", fmt.Sprintf("%s:%d", config.ServiceHost, config.ServicePort), verifyLogin)
@@ -128,6 +141,12 @@ func (this *KVNode) Start(id *int, cluster *string) error {
 		return err
 	}
 
+	this.scriptMgr, err = newScriptMgr(config.Script.Dir, config.Script.Timeout)
+
+	if nil != err {
+		return err
+	}
+
 	clusterArray := strings.Split(*cluster, ",")
 
 	peers := map[int]string{}
@@ -149,10 +168,17 @@ func (this *KVNode) Start(id *int, cluster *string) error {
 		}
 	}
 
-	this.storeMgr = newStoreMgr(this, this.mutilRaft, dbmeta, id, peers, config.CacheGroupSize)
+	this.storeMgr = newStoreMgr(this, this.mutilRaft, dbmeta, id, peers, this.join, config.CacheGroupSize)
+
+	this.sqlMgr.scaner.storeMgr = this.storeMgr
+	go this.sqlMgr.scaner.run()
+	this.sqlMgr.indexScaner.storeMgr = this.storeMgr
+	go this.sqlMgr.indexScaner.run()
 
 	go this.mutilRaft.serveMutilRaft(selfUrl)
 
+	this.startReport(selfUrl)
+
 	this.cmdChan = []chan *netCmd{}
 	cpuNum := runtime.NumCPU()
 
@@ -175,6 +201,12 @@ func (this *KVNode) Start(id *int, cluster *string) error {
 	return nil
 }
 
+//以新成员身份启动，需要先通过MemberChange将本节点添加到集群,cluster包含现有成员及本节点
+func (this *KVNode) Join(id *int, cluster *string) error {
+	this.join = true
+	return this.Start(id, cluster)
+}
+
 func waitCondition(fn func() bool) {
 	wg := sync.WaitGroup{}
 	wg.Add(1)
@@ -252,6 +284,7 @@ func (this *KVNode) initHandler() {
 	}
 
 	this.dispatcher.Register(uint16(protocol.CmdType_Del), del)
+	this.dispatcher.Register(uint16(protocol.CmdType_Unset), unset)
 	this.dispatcher.Register(uint16(protocol.CmdType_Get), get)
 	this.dispatcher.Register(uint16(protocol.CmdType_Set), set)
 	this.dispatcher.Register(uint16(protocol.CmdType_SetNx), setNx)
@@ -260,14 +293,28 @@ func (this *KVNode) initHandler() {
 	this.dispatcher.Register(uint16(protocol.CmdType_IncrBy), incrBy)
 	this.dispatcher.Register(uint16(protocol.CmdType_DecrBy), decrBy)
 	this.dispatcher.Register(uint16(protocol.CmdType_Kick), kick)
-	//this.dispatcher.Register(uint16(protocol.CmdType_Cancel), cancel)
-	//this.dispatcher.Register(uint16(protocol.CmdType_ReloadTableConf), reloadTableMeta)
+	this.dispatcher.Register(uint16(protocol.CmdType_MGet), mget)
+	this.dispatcher.Register(uint16(protocol.CmdType_MSet), mset)
+	this.dispatcher.Register(uint16(protocol.CmdType_Txn), txnExec)
+	this.dispatcher.Register(uint16(protocol.CmdType_Eval), eval)
+	this.dispatcher.Register(uint16(protocol.CmdType_GetByIndex), getByIndex)
+	this.dispatcher.Register(uint16(protocol.CmdType_Preload), preload)
+	this.dispatcher.Register(uint16(protocol.CmdType_Scan), scan)
+	this.dispatcher.Register(uint16(protocol.CmdType_ReloadTableConf), reloadTableMeta)
+	this.dispatcher.Register(uint16(protocol.CmdType_MemberChange), memberChange)
+	this.dispatcher.Register(uint16(protocol.CmdType_TransferLeader), transferLeader)
+	this.dispatcher.Register(uint16(protocol.CmdType_MoveSlot), moveSlot)
+	this.dispatcher.Register(uint16(protocol.CmdType_GetSlotTable), getSlotTable)
+	this.dispatcher.Register(uint16(protocol.CmdType_Watch), watch)
+	this.dispatcher.Register(uint16(protocol.CmdType_UnWatch), unWatch)
 
 }
 
 func NewKvNode() *KVNode {
 	s := &KVNode{
-		mutilRaft: newMutilRaft(),
+		mutilRaft:  newMutilRaft(),
+		watchMgr:   newWatchMgr(),
+		preloadMgr: newPreloadMgr(),
 	}
 	s.initHandler()
 	return s
@@ -4,13 +4,21 @@ package kvnode
 //go tool cover -html=coverage.out
 
 import (
+	"container/list"
 	"fmt"
 	"github.com/BurntSushi/toml"
+	"github.com/sniperHW/flyfish/cdc"
 	"github.com/sniperHW/flyfish/client"
 	"github.com/sniperHW/flyfish/conf"
+	"github.com/sniperHW/flyfish/dbmeta"
 	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/flyfish/util/bitfield"
+	"github.com/sniperHW/flyfish/util/str"
+	"github.com/sniperHW/kendynet/util"
 	"github.com/stretchr/testify/assert"
 	"go.etcd.io/etcd/raft/raftpb"
+	"io"
 	"os"
 	"strings"
 	"sync/atomic"
@@ -72,6 +80,10 @@ ConfDbPassword  = "%s"
 ConfDataBase    = "%s"
 
 
+[Script]
+Dir             = "testscript"
+
+
 [Log]
 MaxLogfileSize  = 104857600 # 100mb
 LogDir          = "log1"
@@ -452,13 +464,20 @@ func test(t *testing.T, c *client.Client) {
 
 	{
 		//reload
+		var version int64
 		for {
 			r := c.ReloadTableConf().Exec()
 			if r.ErrCode == errcode.ERR_OK {
+				version = r.Version
 				break
 			}
 			time.Sleep(time.Millisecond * 100)
 		}
+
+		//配置没有变化时版本号不变
+		r := c.ReloadTableConf().Exec()
+		assert.Equal(t, errcode.ERR_OK, r.ErrCode)
+		assert.Equal(t, version, r.Version)
 	}
 
 	{
@@ -614,6 +633,434 @@ func test(t *testing.T, c *client.Client) {
 		c.SetNx("users1", "sniperHW2", fields).Exec()
 	}
 
+	{
+		//mset/mget
+		fields := map[string]interface{}{}
+		fields["age"] = 20
+		fields["name"] = "muti"
+
+		r1 := c.MSet("users1",
+			&client.MSetItem{Key: "muti1", Fields: fields},
+			&client.MSetItem{Key: "muti2", Fields: fields},
+			&client.MSetItem{Key: "muti3", Fields: map[string]interface{}{"aa": 1}}).Exec()
+		assert.Equal(t, errcode.ERR_OK, r1.ErrCode)
+		assert.Equal(t, 3, len(r1.Rows))
+		assert.Equal(t, errcode.ERR_OK, r1.Rows[0].ErrCode)
+		assert.Equal(t, errcode.ERR_OK, r1.Rows[1].ErrCode)
+		assert.Equal(t, errcode.ERR_INVAILD_FIELD, r1.Rows[2].ErrCode)
+
+		r2 := c.MGetAll("users1", "muti2", "muti1", "muti4").Exec()
+		assert.Equal(t, errcode.ERR_OK, r2.ErrCode)
+		assert.Equal(t, 3, len(r2.Rows))
+		assert.Equal(t, "muti2", r2.Rows[0].Key)
+		assert.Equal(t, errcode.ERR_OK, r2.Rows[0].ErrCode)
+		assert.Equal(t, int64(20), r2.Rows[0].Fields["age"].GetInt())
+		assert.Equal(t, "muti1", r2.Rows[1].Key)
+		assert.Equal(t, errcode.ERR_OK, r2.Rows[1].ErrCode)
+		assert.Equal(t, errcode.ERR_RECORD_NOTEXIST, r2.Rows[2].ErrCode)
+
+		r3 := c.MGet("users1", []string{"muti1"}, "bb").Exec()
+		assert.Equal(t, errcode.ERR_INVAILD_FIELD, r3.ErrCode)
+
+		r4 := c.MGetAll("table_not_exist", "muti1").Exec()
+		assert.Equal(t, errcode.ERR_INVAILD_TABLE, r4.ErrCode)
+
+		c.Del("users1", "muti1").Exec()
+		c.Del("users1", "muti2").Exec()
+	}
+
+	{
+		//scan
+		fields := map[string]interface{}{}
+		fields["age"] = 30
+		fields["name"] = "scan"
+
+		c.Set("users1", "scan1", fields).Exec()
+		c.Set("users1", "scan2", fields).Exec()
+
+		keys := map[string]bool{}
+
+		scaner := c.Scaner("users1", "age")
+		for !scaner.Finish() {
+			r, err := scaner.Next(1)
+			assert.Nil(t, err)
+			assert.Equal(t, errcode.ERR_OK, r.ErrCode)
+			for _, v := range r.Rows {
+				keys[v.Key] = true
+				if v.Key == "scan1" || v.Key == "scan2" {
+					assert.Equal(t, int64(30), v.Fields["age"].GetInt())
+				}
+				assert.Nil(t, v.Fields["name"])
+			}
+		}
+
+		assert.Equal(t, true, keys["scan1"])
+		assert.Equal(t, true, keys["scan2"])
+
+		_, err := scaner.Next(1)
+		assert.Equal(t, client.ErrScanFinish, err)
+
+		c.Del("users1", "scan2").Exec()
+
+		keys = map[string]bool{}
+		scaner = c.Scaner("users1")
+		for !scaner.Finish() {
+			r, _ := scaner.Next(100)
+			assert.Equal(t, errcode.ERR_OK, r.ErrCode)
+			for _, v := range r.Rows {
+				keys[v.Key] = true
+			}
+		}
+
+		assert.Equal(t, true, keys["scan1"])
+		assert.Equal(t, false, keys["scan2"])
+
+		r, _ := c.Scaner("users1", "bb").Next(1)
+		assert.Equal(t, errcode.ERR_INVAILD_FIELD, r.ErrCode)
+
+		c.Del("users1", "scan1").Exec()
+	}
+
+	{
+		//cancel
+		ch := make(chan int32, 1)
+		cmd := c.GetAll("users1", "sniperHW2")
+		cmd.AsyncExec(func(r *client.SliceResult) {
+			ch <- r.ErrCode
+		})
+		c.Cancel(cmd.Seqno())
+		code := <-ch
+		assert.Equal(t, true, code == errcode.ERR_CANCEL || code == errcode.ERR_OK)
+
+		//取消后连接仍可正常使用
+		r := c.GetAll("users1", "sniperHW2").Exec()
+		assert.Equal(t, errcode.ERR_OK, r.ErrCode)
+	}
+
+	{
+		//ttl
+		fields := map[string]interface{}{}
+		fields["age"] = 1
+
+		r1 := c.SetWithTTL("users1", "ttl1", fields, time.Second).Exec()
+		assert.Equal(t, errcode.ERR_OK, r1.ErrCode)
+
+		r2 := c.IncrByWithTTL("users1", "ttl2", "age", 1, time.Second).Exec()
+		assert.Equal(t, errcode.ERR_OK, r2.ErrCode)
+
+		r3 := c.SetNxWithTTL("users1", "ttl3", fields, time.Second).Exec()
+		assert.Equal(t, errcode.ERR_OK, r3.ErrCode)
+
+		r4 := c.Get("users1", "ttl1", "age").Exec()
+		assert.Equal(t, errcode.ERR_OK, r4.ErrCode)
+
+		//过期前不能kick
+		r6 := c.Kick("users1", "ttl1").Exec()
+		assert.Equal(t, errcode.ERR_KICK_TTL, r6.ErrCode)
+
+		//等待过期删除
+		time.Sleep(time.Second * 3)
+
+		for _, key := range []string{"ttl1", "ttl2", "ttl3"} {
+			r := c.Get("users1", key, "age").Exec()
+			assert.Equal(t, errcode.ERR_RECORD_NOTEXIST, r.ErrCode)
+		}
+
+		//kick之后从数据库加载，记录已被删除
+		for {
+			r := c.Kick("users1", "ttl1").Exec()
+			if r.ErrCode == errcode.ERR_OK {
+				break
+			}
+			time.Sleep(time.Millisecond * 100)
+		}
+
+		r5 := c.Get("users1", "ttl1", "age").Exec()
+		assert.Equal(t, errcode.ERR_RECORD_NOTEXIST, r5.ErrCode)
+	}
+
+	{
+		//watch
+		ch := make(chan *client.WatchEvent, 10)
+		cmd := c.Watch("users1", "watch1", func(e *client.WatchEvent) {
+			ch <- e
+		})
+		r1 := cmd.Exec()
+		assert.Equal(t, errcode.ERR_OK, r1.ErrCode)
+
+		fields := map[string]interface{}{}
+		fields["age"] = 10
+
+		r2 := c.Set("users1", "watch1", fields).Exec()
+		assert.Equal(t, errcode.ERR_OK, r2.ErrCode)
+
+		e := <-ch
+		assert.Equal(t, errcode.ERR_OK, e.ErrCode)
+		assert.Equal(t, r2.Version, e.Version)
+		assert.Equal(t, int64(10), e.Fields["age"].GetInt())
+
+		r3 := c.Del("users1", "watch1").Exec()
+		assert.Equal(t, errcode.ERR_OK, r3.ErrCode)
+
+		e = <-ch
+		assert.Equal(t, true, e.Del)
+
+		r4 := c.Unwatch("users1", "watch1", cmd.Seqno()).Exec()
+		assert.Equal(t, errcode.ERR_OK, r4.ErrCode)
+
+		c.Set("users1", "watch1", fields).Exec()
+
+		select {
+		case <-ch:
+			t.Fatal("should not receive notify after unwatch")
+		case <-time.After(time.Millisecond * 500):
+		}
+
+		r5 := c.Watch("users2", "watch1", func(e *client.WatchEvent) {}).Exec()
+		assert.Equal(t, errcode.ERR_INVAILD_TABLE, r5.ErrCode)
+
+		c.Del("users1", "watch1").Exec()
+	}
+
+	{
+		//txn
+		fields := map[string]interface{}{}
+		fields["age"] = 1
+		fields["name"] = "txn"
+
+		r1 := c.Txn("users1",
+			&client.TxnOp{Key: "txn1", Fields: fields},
+			&client.TxnOp{Key: "txn2", Fields: fields}).Exec()
+		assert.Equal(t, errcode.ERR_OK, r1.ErrCode)
+		assert.Equal(t, 2, len(r1.Rows))
+		assert.Equal(t, "txn1", r1.Rows[0].Key)
+		assert.Equal(t, int64(1), r1.Rows[0].Version)
+
+		//条件不满足,不执行任何写入
+		r2 := c.Txn("users1",
+			&client.TxnOp{Key: "txn1", Cmp: map[string]interface{}{"age": 1}, Fields: map[string]interface{}{"age": 2}},
+			&client.TxnOp{Key: "txn2", Cmp: map[string]interface{}{"age": 100}, Fields: map[string]interface{}{"age": 2}}).Exec()
+		assert.Equal(t, errcode.ERR_CAS_NOT_EQUAL, r2.ErrCode)
+		assert.Equal(t, errcode.ERR_OK, r2.Rows[0].ErrCode)
+		assert.Equal(t, errcode.ERR_CAS_NOT_EQUAL, r2.Rows[1].ErrCode)
+
+		r3 := c.Get("users1", "txn1", "age").Exec()
+		assert.Equal(t, int64(1), r3.Fields["age"].GetInt())
+
+		version := r3.Version
+
+		r4 := c.Txn("users1",
+			&client.TxnOp{Key: "txn1", Version: &version, Fields: map[string]interface{}{"age": 2}},
+			&client.TxnOp{Key: "txn2", Del: true}).Exec()
+		assert.Equal(t, errcode.ERR_OK, r4.ErrCode)
+
+		r5 := c.Get("users1", "txn1", "age").Exec()
+		assert.Equal(t, int64(2), r5.Fields["age"].GetInt())
+
+		r6 := c.Get("users1", "txn2", "age").Exec()
+		assert.Equal(t, errcode.ERR_RECORD_NOTEXIST, r6.ErrCode)
+
+		r7 := c.Txn("users1",
+			&client.TxnOp{Key: "txn1", Version: &version},
+			&client.TxnOp{Key: "txn1", Del: true}).Exec()
+		assert.Equal(t, errcode.ERR_DUPLICATE_KEY, r7.ErrCode)
+
+		//op指定的表优先
+		r8 := c.Txn("users2",
+			&client.TxnOp{Table: "users1", Key: "txn3", Fields: fields},
+			&client.TxnOp{Table: "users1", Key: "txn4", Fields: fields}).Exec()
+		assert.Equal(t, errcode.ERR_OK, r8.ErrCode)
+
+		//不同表中的同名key不是重复的key
+		r9 := c.Txn("users1",
+			&client.TxnOp{Key: "txn3"},
+			&client.TxnOp{Table: "users2", Key: "txn3"}).Exec()
+		assert.Equal(t, errcode.ERR_INVAILD_TABLE, r9.ErrCode)
+
+		c.Del("users1", "txn1").Exec()
+		c.Del("users1", "txn3").Exec()
+		c.Del("users1", "txn4").Exec()
+	}
+
+	{
+		//eval
+		c.Del("users1", "eval1").Exec()
+
+		r1 := c.Eval("users1", "eval1", "incr_age", "2").Exec()
+		assert.Equal(t, errcode.ERR_OK, r1.ErrCode)
+		assert.Equal(t, "2", r1.Result)
+		assert.Equal(t, int64(2), r1.Fields["age"].GetInt())
+
+		r2 := c.Eval("users1", "eval1", "incr_age", "3", "2").Exec()
+		assert.Equal(t, errcode.ERR_OK, r2.ErrCode)
+		assert.Equal(t, r1.Version+1, r2.Version)
+
+		r3 := c.Get("users1", "eval1", "age").Exec()
+		assert.Equal(t, int64(5), r3.Fields["age"].GetInt())
+
+		r4 := c.Eval("users1", "eval1", "incr_age", "3", "2").Exec()
+		assert.Equal(t, errcode.ERR_SCRIPT, r4.ErrCode)
+
+		r5 := c.Eval("users1", "eval1", "not_exist").Exec()
+		assert.Equal(t, errcode.ERR_SCRIPT, r5.ErrCode)
+
+		c.Del("users1", "eval1").Exec()
+	}
+
+	{
+		//getByIndex(users1的name字段需要声明为index)
+		fields := map[string]interface{}{}
+		fields["age"] = 1
+		fields["name"] = "index_test"
+
+		c.Set("users1", "index1", fields).Exec()
+		c.Set("users1", "index2", fields).Exec()
+
+		r1 := c.GetByIndex("users1", "name", "index_test").Exec()
+		assert.Equal(t, errcode.ERR_OK, r1.ErrCode)
+		assert.Equal(t, 2, len(r1.Rows))
+		assert.Equal(t, "index1", r1.Rows[0].Key)
+		assert.Equal(t, "index2", r1.Rows[1].Key)
+
+		//分批获取
+		scaner := c.IndexScaner("users1", "name", "index_test")
+		keys := []string{}
+		for !scaner.Finish() {
+			r, err := scaner.Next(1)
+			assert.Nil(t, err)
+			assert.Equal(t, errcode.ERR_OK, r.ErrCode)
+			assert.True(t, len(r.Rows) <= 1)
+			for _, v := range r.Rows {
+				keys = append(keys, v.Key)
+			}
+		}
+		assert.Equal(t, []string{"index1", "index2"}, keys)
+
+		//修改后不再匹配
+		c.Set("users1", "index2", map[string]interface{}{"name": "index_test2"}).Exec()
+
+		r2 := c.GetByIndex("users1", "name", "index_test").Exec()
+		assert.Equal(t, errcode.ERR_OK, r2.ErrCode)
+		assert.Equal(t, 1, len(r2.Rows))
+		assert.Equal(t, "index1", r2.Rows[0].Key)
+
+		//不在缓存中的记录从数据库查询
+		for {
+			r := c.Kick("users1", "index1").Exec()
+			if r.ErrCode == errcode.ERR_OK {
+				break
+			}
+			time.Sleep(time.Millisecond * 100)
+		}
+
+		r3 := c.GetByIndex("users1", "name", "index_test").Exec()
+		assert.Equal(t, errcode.ERR_OK, r3.ErrCode)
+		assert.Equal(t, 1, len(r3.Rows))
+		assert.Equal(t, r2.Rows[0].Version, r3.Rows[0].Version)
+
+		r4 := c.GetByIndex("users1", "age", 1).Exec()
+		assert.Equal(t, errcode.ERR_INVAILD_FIELD, r4.ErrCode)
+
+		c.Del("users1", "index1").Exec()
+		c.Del("users1", "index2").Exec()
+	}
+
+	{
+		//unset
+		fields := map[string]interface{}{}
+		fields["age"] = 12
+		fields["phone"] = "456"
+
+		r1 := c.Set("users1", "unset1", fields).Exec()
+		assert.Equal(t, errcode.ERR_OK, r1.ErrCode)
+
+		r2 := c.Unset("users1", "unset1", "age", "phone").Exec()
+		assert.Equal(t, errcode.ERR_OK, r2.ErrCode)
+		assert.Equal(t, r1.Version+1, r2.Version)
+
+		r3 := c.Get("users1", "unset1", "age", "phone").Exec()
+		assert.Equal(t, int64(0), r3.Fields["age"].GetInt())
+		assert.Equal(t, "123", r3.Fields["phone"].GetString())
+
+		r4 := c.UnsetWithVersion("users1", "unset1", r1.Version, "age").Exec()
+		assert.Equal(t, errcode.ERR_VERSION_MISMATCH, r4.ErrCode)
+
+		r5 := c.Unset("users1", "unset1", "none").Exec()
+		assert.Equal(t, errcode.ERR_INVAILD_FIELD, r5.ErrCode)
+
+		c.Del("users1", "unset1").Exec()
+
+		r6 := c.Unset("users1", "unset1", "age").Exec()
+		assert.Equal(t, errcode.ERR_RECORD_NOTEXIST, r6.ErrCode)
+	}
+
+	{
+		//preload
+		fields := map[string]interface{}{}
+		fields["age"] = 1
+
+		for _, v := range []string{"preload1", "preload2"} {
+			c.Set("users1", v, fields).Exec()
+			for {
+				r := c.Kick("users1", v).Exec()
+				if r.ErrCode == errcode.ERR_OK {
+					break
+				}
+				time.Sleep(time.Millisecond * 100)
+			}
+		}
+
+		r1 := c.PreloadKeys("users1", "preload1", "preload2", "preload3").Exec()
+		assert.Equal(t, errcode.ERR_OK, r1.ErrCode)
+
+		for {
+			r := c.PreloadStatus(r1.Job).Exec()
+			assert.Equal(t, errcode.ERR_OK, r.ErrCode)
+			if r.Finish {
+				assert.Equal(t, int64(2), r.Scanned)
+				assert.Equal(t, int64(2), r.Loaded)
+				break
+			}
+			time.Sleep(time.Millisecond * 100)
+		}
+
+		//已经在缓存中
+		r2 := c.PreloadRange("users1", "preload1", "preload3").Exec()
+		assert.Equal(t, errcode.ERR_OK, r2.ErrCode)
+
+		for {
+			r := c.PreloadStatus(r2.Job).Exec()
+			if r.Finish {
+				assert.Equal(t, int64(0), r.Loaded)
+				assert.Equal(t, int64(2), r.Skipped)
+				break
+			}
+			time.Sleep(time.Millisecond * 100)
+		}
+
+		r3 := c.PreloadAll("users2").Exec()
+		assert.Equal(t, errcode.ERR_INVAILD_TABLE, r3.ErrCode)
+
+		c.Del("users1", "preload1").Exec()
+		c.Del("users1", "preload2").Exec()
+	}
+
+	{
+		fields := map[string]interface{}{}
+		fields["age"] = 12
+
+		r1 := c.Set("users1", "stale", fields).Exec()
+		assert.Equal(t, errcode.ERR_OK, r1.ErrCode)
+
+		//leader按一致读处理
+		r2 := c.GetStale("users1", "stale", 10, "age").Exec()
+		assert.Equal(t, errcode.ERR_OK, r2.ErrCode)
+		assert.Equal(t, int64(12), r2.Fields["age"].GetInt())
+		assert.Equal(t, r1.Version, r2.Version)
+
+		c.Del("users1", "stale").Exec()
+	}
+
 }
 
 func TestMysql(t *testing.T) {
@@ -807,6 +1254,104 @@ func TestKvnode2(t *testing.T) {
 
 }
 
+func TestMoveSlot(t *testing.T) {
+
+	dbConf := &dbconf{}
+	if _, err := toml.DecodeFile("test_dbconf.toml", dbConf); nil != err {
+		panic(err)
+	}
+
+	os.RemoveAll("./kv-1-1")
+	os.RemoveAll("./kv-1-1-snap")
+	os.RemoveAll("./kv-1-2")
+	os.RemoveAll("./kv-1-2-snap")
+	os.Remove("./kv-1-slot")
+	defer os.Remove("./kv-1-slot")
+
+	//两个region,slot初始全部由region 1负责
+	str := strings.Replace(configStr, "CacheGroupSize          = 1", "CacheGroupSize          = 2\nSlotRegions             = 1", 1)
+
+	conf.LoadConfigStr(fmt.Sprintf(str, 10018, "pgsql", "localhost", 5432, dbConf.PgUser, dbConf.PgPwd, dbConf.PgDB, "localhost", 5432, dbConf.PgUser, dbConf.PgPwd, dbConf.PgDB))
+
+	InitLogger()
+
+	cluster := "1@http://127.0.0.1:12380"
+	id := 1
+
+	node := NewKvNode()
+
+	if err := node.Start(&id, &cluster); nil != err {
+		panic(err)
+	}
+
+	waitCondition(func() bool {
+		node.storeMgr.RLock()
+		defer node.storeMgr.RUnlock()
+		for _, v := range node.storeMgr.stores {
+			if !v.rn.isLeader() {
+				return false
+			}
+		}
+		return true
+	})
+
+	c := client.OpenClient("localhost:10018", false)
+
+	fields := map[string]interface{}{}
+	fields["age"] = 12
+	fields["name"] = "sniperHW"
+
+	r1 := c.Set("users1", "sniperHW", fields).Exec()
+	assert.Equal(t, errcode.ERR_OK, r1.ErrCode)
+
+	slot := node.storeMgr.getSlot("users1:sniperHW")
+	assert.Equal(t, 1, node.storeMgr.getStoreBySlot(slot).rn.region)
+
+	t1 := c.GetSlotTable().Exec()
+	assert.Equal(t, errcode.ERR_OK, t1.ErrCode)
+	assert.Equal(t, int64(0), t1.Table.Version())
+	assert.Equal(t, node.storeMgr.slotInfo.SlotCount, t1.Table.SlotCount())
+	assert.Equal(t, 1, t1.Table.GetRegion("users1:sniperHW"))
+
+	assert.Equal(t, errcode.ERR_OTHER, c.MoveSlot(node.storeMgr.slotInfo.SlotCount, 2).Exec().ErrCode)
+	assert.Equal(t, errcode.ERR_OTHER, c.MoveSlot(slot, 3).Exec().ErrCode)
+
+	r2 := c.MoveSlot(slot, 2).Exec()
+	assert.Equal(t, errcode.ERR_OK, r2.ErrCode)
+
+	assert.Equal(t, 2, node.storeMgr.getStoreBySlot(slot).rn.region)
+	assert.False(t, node.storeMgr.getStoreByIndex(1).ownSlot(slot))
+
+	t2 := c.GetSlotTable().Exec()
+	assert.Equal(t, errcode.ERR_OK, t2.ErrCode)
+	assert.Equal(t, int64(1), t2.Table.Version())
+	assert.Equal(t, 2, t2.Table.GetRegion("users1:sniperHW"))
+
+	//迁移后的kv直接由region 2提供
+	r3 := c.GetAll("users1", "sniperHW").Exec()
+	assert.Equal(t, errcode.ERR_OK, r3.ErrCode)
+	assert.Equal(t, int64(12), r3.Fields["age"].GetInt())
+
+	fields["age"] = 13
+	r4 := c.Set("users1", "sniperHW", fields).Exec()
+	assert.Equal(t, errcode.ERR_OK, r4.ErrCode)
+
+	//迁回
+	r5 := c.MoveSlot(slot, 1).Exec()
+	assert.Equal(t, errcode.ERR_OK, r5.ErrCode)
+	assert.Equal(t, 1, node.storeMgr.getStoreBySlot(slot).rn.region)
+	assert.Equal(t, int64(2), node.storeMgr.getSlotTable().Version())
+
+	r6 := c.GetAll("users1", "sniperHW").Exec()
+	assert.Equal(t, errcode.ERR_OK, r6.ErrCode)
+	assert.Equal(t, int64(13), r6.Fields["age"].GetInt())
+
+	node.Stop()
+
+	time.Sleep(time.Second)
+
+}
+
 func TestCluster(t *testing.T) {
 
 	dbConf := &dbconf{}
@@ -822,6 +1367,10 @@ func TestCluster(t *testing.T) {
 	os.RemoveAll("./kv-3-1-snap")
 	os.RemoveAll("./kv-4-1")
 	os.RemoveAll("./kv-4-1-snap")
+	os.Remove("./kv-1-1-member")
+	os.Remove("./kv-2-1-member")
+	os.Remove("./kv-3-1-member")
+	os.Remove("./kv-4-1-member")
 
 	conf.LoadConfigStr(fmt.Sprintf(configStr, 20018, "pgsql", "localhost", 5432, dbConf.PgUser, dbConf.PgPwd, dbConf.PgDB, "localhost", 5432, dbConf.PgUser, dbConf.PgPwd, dbConf.PgDB))
 
@@ -992,6 +1541,24 @@ func TestCluster(t *testing.T) {
 		assert.Equal(t, errcode.ERR_NOT_LEADER, r.ErrCode)
 	}
 
+	{
+		r1 := getClient().GetAll("users1", "sniperHW").Exec()
+		assert.Equal(t, errcode.ERR_OK, r1.ErrCode)
+
+		//follower应用到最新的提交后可以读取
+		c := getNoLeaderClient()
+		deadline := time.Now().Add(time.Second * 5)
+		for {
+			r2 := c.GetAllStale("users1", "sniperHW", 0).Exec()
+			if (r2.ErrCode == errcode.ERR_OK && r2.Version == r1.Version) || time.Now().After(deadline) {
+				assert.Equal(t, errcode.ERR_OK, r2.ErrCode)
+				assert.Equal(t, r1.Version, r2.Version)
+				break
+			}
+			time.Sleep(time.Millisecond * 100)
+		}
+	}
+
 	{
 
 		c := getClient()
@@ -1088,6 +1655,58 @@ func TestCluster(t *testing.T) {
 
 		node4.Stop()
 
+		//删除节点4,被删除的id不能再次加入
+		r2 := getClient().RemoveMember(4).Exec()
+		assert.Equal(t, errcode.ERR_OK, r2.ErrCode)
+
+		r3 := getClient().AddMember(4, "http://127.0.0.1:22381").Exec()
+		assert.Equal(t, errcode.ERR_OTHER, r3.ErrCode)
+
+		r4 := getNoLeaderClient().RemoveMember(4).Exec()
+		assert.Equal(t, errcode.ERR_NOT_LEADER, r4.ErrCode)
+
+	}
+
+	{
+		//转移leader
+		leader := getLeader()
+		target := leader.id%3 + 1
+
+		//失去leadership后关注失效
+		watchC := make(chan *client.WatchEvent, 1)
+		rw := getClient().Watch("users1", "sniperHW", func(e *client.WatchEvent) {
+			watchC <- e
+		}).Exec()
+		assert.Equal(t, errcode.ERR_OK, rw.ErrCode)
+
+		r1 := getClient().TransferLeader(1, target).Exec()
+		assert.Equal(t, errcode.ERR_OK, r1.ErrCode)
+
+		select {
+		case e := <-watchC:
+			assert.Equal(t, errcode.ERR_NOT_LEADER, e.ErrCode)
+		case <-time.After(time.Second * 5):
+			t.Fatal("watch should be invalidated after losing leadership")
+		}
+
+		leader = getLeader()
+		assert.Equal(t, target, leader.id)
+
+		r2 := getNoLeaderClient().TransferLeader(1, target).Exec()
+		assert.Equal(t, errcode.ERR_NOT_LEADER, r2.ErrCode)
+
+		//新leader无需等待leaseTimeout即获得回写租约
+		deadline := time.Now().Add(time.Second * 5)
+		for !leader.storeMgr.getStoreByIndex(1).rn.hasLease() && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond * 100)
+		}
+		assert.True(t, leader.storeMgr.getStoreByIndex(1).rn.hasLease())
+
+		c := getClient()
+		fields := map[string]interface{}{}
+		fields["age"] = 13
+		r3 := c.Set("users1", "sniperHW", fields).Exec()
+		assert.Equal(t, errcode.ERR_OK, r3.ErrCode)
 	}
 
 	node1.Stop()
@@ -1097,3 +1716,350 @@ func TestCluster(t *testing.T) {
 	time.Sleep(time.Second)
 
 }
+
+func TestProposalExpire(t *testing.T) {
+	fields := map[string]*proto.Field{"age": proto.PackField("age", 12)}
+
+	//旧格式:没有过期时间
+	s := str.NewStr(make([]byte, 1024), 0)
+	s.AppendByte(byte(proposal_snapshot))
+	s.AppendInt32(int32(len("users1:a")))
+	s.AppendString("users1:a")
+	s.AppendInt64(1)
+	s.AppendInt32(1)
+	s.AppendField(fields["age"])
+
+	//没有过期时间时与旧格式一致
+	s1 := str.NewStr(make([]byte, 1024), 0)
+	appendProposal2Str(s1, proposal_snapshot, "users1:a", int64(1), fields, int64(0))
+	assert.Equal(t, s.ToString(), s1.ToString())
+
+	appendProposal2Str(s, proposal_update, "users1:b", int64(2), fields, int64(1000))
+
+	p, offset := readProposal(s, 0)
+	assert.NotNil(t, p)
+	assert.Equal(t, proposal_snapshot, p.tt)
+	assert.Equal(t, "users1:a", p.values[0].(string))
+	assert.Equal(t, int64(1), p.values[1].(int64))
+	assert.Equal(t, int64(0), p.values[2].(int64))
+	assert.Equal(t, int64(12), p.values[3].([]*proto.Field)[0].GetInt())
+
+	p, offset = readProposal(s, offset)
+	assert.NotNil(t, p)
+	assert.Equal(t, proposal_update, p.tt)
+	assert.Equal(t, "users1:b", p.values[0].(string))
+	assert.Equal(t, int64(2), p.values[1].(int64))
+	assert.Equal(t, int64(1000), p.values[2].(int64))
+	assert.Equal(t, s.Len(), offset)
+}
+
+func TestCDCLoad(t *testing.T) {
+	dir := "./testcdc"
+	os.RemoveAll(dir)
+	defer os.RemoveAll(dir)
+
+	w, err := cdc.NewWriter(dir, 1, 1024*1024, 0)
+	assert.Nil(t, err)
+	defer w.Close()
+
+	store := &kvstore{cdc: w}
+
+	fields := map[string]*proto.Field{"age": proto.PackField("age", 12)}
+
+	s := str.NewStr(make([]byte, 1024), 0)
+	//不压缩
+	s.AppendBytes(0, 0)
+	//Get未命中缓存，记录不存在
+	appendProposal2Str(s, proposal_snapshot|proposal_flag_load, "users1:a", int64(0), nil, int64(0))
+	//Get未命中缓存，从数据库加载
+	appendProposal2Str(s, proposal_snapshot|proposal_flag_load, "users1:b", int64(1), fields, int64(0))
+	store.writeCDC(1, s.Bytes())
+
+	s = str.NewStr(make([]byte, 1024), 0)
+	s.AppendBytes(0, 0)
+	appendProposal2Str(s, proposal_snapshot, "users1:c", int64(1), fields, int64(0))
+	store.writeCDC(2, s.Bytes())
+
+	p, _ := readProposal(str.NewStr(s.Bytes()[2:], s.Len()-2), 0)
+	assert.False(t, p.load)
+
+	r := cdc.NewReader(dir, 1, 0, 0)
+	defer r.Close()
+
+	record, err := r.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, "c", record.Key)
+	assert.Equal(t, cdc.OpSet, record.Op)
+
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestKvExpire(t *testing.T) {
+	e := newKvExpire()
+	kvs := []*kv{}
+	for i := 0; i < 10; i++ {
+		k := &kv{}
+		kvs = append(kvs, k)
+		e.update(k, int64(10-i))
+	}
+
+	assert.Equal(t, 10, e.len())
+	assert.Equal(t, 3, len(e.expired(3)))
+
+	e.update(kvs[9], 100)
+	e.remove(kvs[8])
+	expired := e.expired(3)
+	assert.Equal(t, 1, len(expired))
+	assert.Equal(t, kvs[7], expired[0])
+	assert.Equal(t, 9, e.len())
+
+	e.reset()
+	assert.Equal(t, 0, e.len())
+}
+
+func TestKvKeys(t *testing.T) {
+	keys := newKvKeys()
+	kvs := map[string]*kv{}
+	for i := 0; i < 100; i++ {
+		k := &kv{table: "users1", key: fmt.Sprintf("key:%03d", i)}
+		kvs[k.key] = k
+		keys.add(k)
+	}
+	keys.add(&kv{table: "users2", key: "key:050"})
+
+	r := keys.between("users1", "key:010", "key:019", false)
+	assert.Equal(t, 9, len(r))
+	assert.Equal(t, "key:011", r[0].key)
+	assert.Equal(t, "key:019", r[8].key)
+
+	keys.remove(kvs["key:015"])
+	assert.Equal(t, 8, len(keys.between("users1", "key:010", "key:019", false)))
+	assert.Equal(t, 89, len(keys.between("users1", "key:009", "", true)))
+	assert.Equal(t, 1, len(keys.between("users2", "", "", true)))
+
+	keys.reset()
+	assert.Equal(t, 0, len(keys.between("users1", "", "", true)))
+}
+
+func TestReadLag(t *testing.T) {
+	rc := &raftNode{}
+
+	//没有收到过leader的消息
+	assert.False(t, rc.checkReadLag(0, 0))
+
+	rc.onLeaderMessage(raftpb.Message{Type: raftpb.MsgApp, Commit: 20})
+	assert.True(t, rc.checkReadLag(10, 0))
+	assert.True(t, rc.checkReadLag(10, 10))
+	assert.False(t, rc.checkReadLag(9, 10))
+
+	//心跳中的提交位置可能小于已知的位置
+	rc.onLeaderMessage(raftpb.Message{Type: raftpb.MsgHeartbeat, Commit: 5})
+	assert.Equal(t, uint64(20), rc.leaderCommit)
+
+	//与leader隔离超过选举超时
+	rc.leaderContact = time.Now().Add(-electionTick * tickInterval * 2).UnixNano()
+	assert.False(t, rc.checkReadLag(20, 0))
+}
+
+func TestScriptInt(t *testing.T) {
+	meta, err := dbmeta.NewDBMeta([]string{"users1@age:int:0"})
+	assert.Nil(t, err)
+	tmeta := meta.GetTableMeta("users1")
+
+	mgr := &scriptMgr{timeout: defaultScriptTimeout}
+
+	run := func(src string, v int64) (map[string]*proto.Field, error) {
+		p, err := compileScript("test", src)
+		assert.Nil(t, err)
+		writes, _, err := mgr.run(p, tmeta, true, 1, map[string]*proto.Field{"age": proto.PackField("age", v)}, nil)
+		return writes, err
+	}
+
+	//超过2^53的值原样写回
+	big := int64(1)<<62 + 1
+	writes, err := run("return {age = record.fields.age}, nil", big)
+	assert.Nil(t, err)
+	assert.Equal(t, big, writes["age"].GetInt())
+
+	writes, err = run("return {age = record.fields.age + 1}, nil", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), writes["age"].GetInt())
+
+	//带小数
+	_, err = run("return {age = record.fields.age / 2}, nil", 11)
+	assert.NotNil(t, err)
+
+	//超出精确表示的范围
+	_, err = run("return {age = 2^60}, nil", 0)
+	assert.NotNil(t, err)
+
+	_, err = run("return {age = \"9223372036854775808\"}, nil", 0)
+	assert.NotNil(t, err)
+}
+
+func TestLeaseAfterTransferFail(t *testing.T) {
+	commitC := make(chan interface{}, 10)
+	rc := &raftNode{id: 1, leader: 1, term: 5, lease: &lease{}, commitC: commitC}
+
+	(&asynTaskLease{rn: rc, term: 5}).done()
+	assert.Equal(t, 1, len(commitC))
+	<-commitC
+	assert.True(t, rc.hasLease())
+
+	//续约
+	(&asynTaskLease{rn: rc, term: 5}).done()
+	assert.Equal(t, 0, len(commitC))
+
+	//转移leader前放弃租约
+	atomic.StoreInt32(&rc.transferring, 1)
+	rc.lease.setReleasing(true)
+	(&asynTaskLeaseRelease{rn: rc, term: 5, doneCB: func() {}}).done()
+	assert.False(t, rc.hasLease())
+
+	//转移失败，同一term重新续约需要再次通知store回写
+	rc.onTransferFinish(false)
+	(&asynTaskLease{rn: rc, term: 5}).done()
+	assert.Equal(t, 1, len(commitC))
+	_, ok := (<-commitC).(leaseNotify)
+	assert.True(t, ok)
+	assert.True(t, rc.hasLease())
+}
+
+type leaseReadTask struct {
+	asynCmdTaskBase
+	kv      *kv
+	replied bool
+}
+
+func (this *leaseReadTask) getKV() *kv {
+	return this.kv
+}
+
+func (this *leaseReadTask) reply(errno ...int32) {
+	this.replied = true
+}
+
+func TestLeaseRead(t *testing.T) {
+	conf.LoadConfigStr("LeaseRead = true")
+
+	rc := &raftNode{id: 1, leader: 1, term: 5}
+	store := &kvstore{rn: rc, readReqC: util.NewBlockQueue()}
+	k := &kv{cmdQueue: &cmdQueue{queue: list.New()}, store: store}
+
+	//持有读租约,不发起ReadIndex
+	rc.renewReadLease(5, time.Now())
+	task := &leaseReadTask{kv: k}
+	store.issueReadReq(task)
+	assert.True(t, task.replied)
+	assert.Equal(t, 0, store.readReqC.Len())
+
+	//租约过期
+	rc.readLeaseExpire = time.Now().Add(-time.Millisecond)
+	task = &leaseReadTask{kv: k}
+	store.issueReadReq(task)
+	assert.False(t, task.replied)
+	assert.Equal(t, 1, store.readReqC.Len())
+
+	//开始转移leader
+	rc.renewReadLease(5, time.Now())
+	assert.True(t, rc.hasReadLease())
+	atomic.StoreInt32(&rc.transferring, 1)
+	task = &leaseReadTask{kv: k}
+	store.issueReadReq(task)
+	assert.False(t, task.replied)
+	assert.Equal(t, 2, store.readReqC.Len())
+
+	//转移期间不续约
+	rc.readLeaseExpire = time.Time{}
+	rc.renewReadLease(5, time.Now())
+	assert.False(t, rc.hasReadLease())
+}
+
+func TestKvReadStatus(t *testing.T) {
+	k := &kv{
+		store: &kvstore{expires: newKvExpire()},
+		flag:  bitfield.NewBitField32(field_status, field_sql_flag, field_writeback, field_snapshoted, field_tmp, field_kicking),
+	}
+	k.setStatus(cache_ok)
+	assert.Equal(t, cache_ok, k.getReadStatus())
+
+	k.setExpire(time.Now().Add(time.Second).UnixNano() / int64(time.Millisecond))
+	assert.Equal(t, cache_ok, k.getReadStatus())
+
+	//过期后尚未删除
+	k.setExpire(time.Now().Add(-time.Second).UnixNano() / int64(time.Millisecond))
+	assert.Equal(t, cache_missing, k.getReadStatus())
+	assert.Equal(t, cache_ok, k.getStatus())
+}
+
+func TestWaitReadIndex(t *testing.T) {
+	conf.LoadConfigStr("LeaseRead = true")
+
+	rc := &raftNode{id: 1, leader: 2, term: 5}
+	store := &kvstore{rn: rc, readReqC: util.NewBlockQueue()}
+
+	assert.Equal(t, errcode.ERR_NOT_LEADER, store.waitReadIndex(time.Now().Add(time.Second)))
+
+	//持有读租约
+	rc.leader = 1
+	rc.renewReadLease(5, time.Now())
+	assert.Equal(t, errcode.ERR_OK, store.waitReadIndex(time.Now().Add(time.Second)))
+	assert.Equal(t, 0, store.readReqC.Len())
+
+	//租约过期,等待ReadIndex完成
+	rc.readLeaseExpire = time.Now().Add(-time.Millisecond)
+	ch := make(chan int32)
+	go func() {
+		ch <- store.waitReadIndex(time.Now().Add(time.Second))
+	}()
+
+	tasks := fixedArrayPool.Get()
+	for tasks.Empty() {
+		_, l := store.readReqC.Get()
+		for _, v := range l {
+			if nil != v {
+				tasks.Append(v)
+			}
+		}
+	}
+	(&readBatchSt{tasks: tasks, rn: rc, term: 5, sendTime: time.Now()}).reply()
+	assert.Equal(t, errcode.ERR_OK, <-ch)
+	assert.True(t, rc.hasReadLease())
+
+	//ReadIndex超时
+	rc.readLeaseExpire = time.Now().Add(-time.Millisecond)
+	assert.Equal(t, errcode.ERR_TIMEOUT, store.waitReadIndex(time.Now().Add(time.Millisecond*10)))
+}
+
+func TestMemberSnap(t *testing.T) {
+	rc := &raftNode{
+		id:      1<<16 + 1,
+		nodeID:  1,
+		region:  1,
+		peers:   map[int]string{1: "http://127.0.0.1:1", 2: "http://127.0.0.1:2"},
+		removed: map[uint64]bool{3<<16 + 1: true},
+	}
+
+	s := str.NewStr(make([]byte, 1024), 0)
+	rc.getMemberSnap().append2Str(s)
+
+	p, offset := readProposal(s, 0)
+	assert.NotNil(t, p)
+	assert.Equal(t, proposal_members, p.tt)
+	assert.Equal(t, s.Len(), offset)
+
+	//以-join启动的节点只知道自己的地址
+	joiner := &raftNode{
+		id:      4<<16 + 1,
+		nodeID:  4,
+		region:  1,
+		peers:   map[int]string{3: "http://127.0.0.1:3", 4: "http://127.0.0.1:4"},
+		removed: map[uint64]bool{},
+	}
+	defer os.Remove(joiner.memberFile())
+
+	joiner.applyMembers(p.values[0].(map[int]string), p.values[1].([]uint64))
+	assert.Equal(t, map[int]string{1: "http://127.0.0.1:1", 2: "http://127.0.0.1:2", 4: "http://127.0.0.1:4"}, joiner.peers)
+	assert.True(t, joiner.IsIDRemoved(3<<16+1))
+}
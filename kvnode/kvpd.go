@@ -0,0 +1,121 @@
+package kvnode
+
+import (
+	"fmt"
+	"github.com/sniperHW/flyfish/client"
+	"github.com/sniperHW/flyfish/conf"
+	"github.com/sniperHW/flyfish/kvpd"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/proto"
+	"strings"
+	"time"
+)
+
+/*
+ * 向kvpd上报服务地址及各region所见的leader,kvpd据此提供路由并均衡leader
+ * 同时从kvpd获取其它节点的服务地址，用于ERR_NOT_LEADER响应中的leader提示
+ * 上报的region数量是slot初始分配的SlotRegions而不是CacheGroupSize,增大CacheGroupSize拆分region不改变key的初始映射
+ */
+
+const reportInterval = time.Second
+
+func (this *KVNode) makeReport(raftUrl string) *proto.ReportRegionReq {
+	config := conf.GetConfig()
+
+	req := &proto.ReportRegionReq{
+		NodeId:      int32(this.id),
+		Service:     fmt.Sprintf("%s:%d", config.ServiceHost, config.ServicePort),
+		RaftUrl:     raftUrl,
+		RegionCount: int32(this.storeMgr.slotInfo.SlotRegions),
+		Join:        this.join,
+	}
+
+	this.storeMgr.RLock()
+	defer this.storeMgr.RUnlock()
+
+	for k, v := range this.storeMgr.stores {
+		rc := v.rn
+		rc.muLeader.Lock()
+		req.Regions = append(req.Regions, &proto.RegionInfo{
+			Region: int32(k),
+			Leader: int32(rc.leader >> 16),
+			Term:   rc.term,
+		})
+		rc.muLeader.Unlock()
+	}
+
+	return req
+}
+
+func (this *KVNode) startReport(raftUrl string) {
+	addrs := conf.GetConfig().Kvpd
+	if "" == addrs {
+		return
+	}
+
+	c := kvpd.NewClient(strings.Split(addrs, ","))
+
+	go func() {
+		for !this.isStoped() {
+			if err := c.Report(this.makeReport(raftUrl)); nil != err {
+				logger.Infoln("report to kvpd error", err)
+			}
+			if resp, err := c.QueryRoute(); nil == err {
+				this.updatePeerServices(resp.GetNodes())
+			}
+			time.Sleep(reportInterval)
+		}
+	}()
+}
+
+func (this *KVNode) updatePeerServices(nodes []*proto.NodeInfo) {
+	services := map[int]string{}
+	for _, v := range nodes {
+		services[int(v.GetNodeId())] = v.GetService()
+	}
+	this.muPeer.Lock()
+	this.peerServices = services
+	this.muPeer.Unlock()
+}
+
+func (this *KVNode) getPeerService(nodeID int) string {
+	this.muPeer.Lock()
+	defer this.muPeer.Unlock()
+	return this.peerServices[nodeID]
+}
+
+//向其它节点发送管理命令的连接，节点地址未知时返回nil
+func (this *KVNode) getPeerClient(nodeID int) *client.Client {
+	this.muPeer.Lock()
+	defer this.muPeer.Unlock()
+	service := this.peerServices[nodeID]
+	if "" == service {
+		return nil
+	}
+	if nil == this.peerClients {
+		this.peerClients = map[string]*client.Client{}
+	}
+	c, ok := this.peerClients[service]
+	if !ok {
+		c = client.OpenClient(service, false)
+		this.peerClients[service] = c
+	}
+	return c
+}
+
+//在kv命令的ERR_NOT_LEADER响应中附带所属region的leader
+func (this *KVNode) withLeaderHint(cmd responser, resp *net.Message) *net.Message {
+	op, ok := cmd.(commandI)
+	if !ok || nil == op.getKV() {
+		return resp
+	}
+
+	leader := op.getKV().store.rn.getLeaderNode()
+	if 0 == leader || leader == this.id {
+		return resp
+	}
+
+	head := resp.GetHead()
+	head.UniKey = net.MakeLeaderHint(leader, this.getPeerService(leader))
+	return net.NewMessageWithCmd(resp.GetCmd(), head, resp.GetData())
+}
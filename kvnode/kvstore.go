@@ -3,10 +3,12 @@ package kvnode
 import (
 	"encoding/binary"
 	"fmt"
+	"github.com/sniperHW/flyfish/cdc"
 	"github.com/sniperHW/flyfish/conf"
 	"github.com/sniperHW/flyfish/dbmeta"
 	"github.com/sniperHW/flyfish/errcode"
 	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/partition"
 	"github.com/sniperHW/flyfish/proto"
 	futil "github.com/sniperHW/flyfish/util"
 	"github.com/sniperHW/flyfish/util/str"
@@ -14,6 +16,8 @@ import (
 	"github.com/sniperHW/kendynet/util"
 	"go.etcd.io/etcd/etcdserver/api/snap"
 	//"go.etcd.io/etcd/raft/raftpb"
+	"path/filepath"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -62,6 +66,16 @@ type kvstore struct {
 	storeMgr     *storeMgr
 	lruTimer     *timer.Timer
 	unCompressor net.UnCompressorI
+	dbmeta       *dbmeta.DBMeta //每个store独立切换表格配置，保证所有副本在相同的日志位置切换
+	index        *kvIndex       //缓存中记录的二级索引
+	expires      *kvExpire      //带过期时间的kv
+	keys         *kvKeys        //按表有序的key,store锁内更新
+	cdc          *cdc.Writer    //变更记录输出，未开启时为nil
+	appliedIndex uint64         //已经应用到store的raft日志位置
+	muSlot       sync.RWMutex
+	slots        map[int]int64 //本region负责的slot -> epoch
+	migrating    map[int]int   //正在迁出的slot -> 目标region
+	moving       int32         //正在执行MoveSlot(作为源或目标region)
 }
 
 func (this *kvstore) getKvNode() *KVNode {
@@ -72,6 +86,10 @@ func (this *kvstore) getRaftNode() *raftNode {
 	return this.rn
 }
 
+func (this *kvstore) getAppliedIndex() uint64 {
+	return atomic.LoadUint64(&this.appliedIndex)
+}
+
 func (this *kvstore) removeKv(k *kv) {
 
 	processAgain := false
@@ -85,6 +103,9 @@ func (this *kvstore) removeKv(k *kv) {
 	} else {
 		k.setStatus(cache_remove)
 		this.removeLRU(k)
+		this.index.remove(k)
+		this.expires.remove(k)
+		this.keys.remove(k)
 		delete(this.elements, k.uniKey)
 	}
 
@@ -98,11 +119,55 @@ func (this *kvstore) removeKv(k *kv) {
 
 //发起一致读请求
 func (this *kvstore) issueReadReq(task asynCmdTaskI) {
+	if conf.GetConfig().LeaseRead && this.rn.hasReadLease() {
+		//持有读租约，直接从内存返回
+		task.reply()
+		task.getKV().processCmd(nil)
+		return
+	}
+
 	if err := this.readReqC.AddNoWait(task); nil != err {
 		task.onError(errcode.ERR_SERVER_STOPED)
 	}
 }
 
+/*
+ * 确认本节点是leader且之前提交的日志都已经apply,此时缓存中的kv不旧于任何已经返回的写入。
+ * 用于scan及索引查询这类不经过kv命令队列直接读取缓存的请求，持有读租约时直接返回。
+ */
+func (this *kvstore) waitReadIndex(deadline time.Time) int32 {
+	if !this.rn.isLeader() {
+		return errcode.ERR_NOT_LEADER
+	}
+
+	if conf.GetConfig().LeaseRead && this.rn.hasReadLease() {
+		return errcode.ERR_OK
+	}
+
+	b := &readBarrier{ch: make(chan int32, 1)}
+	if nil != this.readReqC.AddNoWait(b) {
+		return errcode.ERR_SERVER_STOPED
+	}
+	this.flushReadReq()
+
+	select {
+	case errno := <-b.ch:
+		return errno
+	case <-time.After(time.Until(deadline)):
+		return errcode.ERR_TIMEOUT
+	}
+}
+
+//立即将readReqC中累积的读请求作为一个批次提交
+func (this *kvstore) flushReadReq() {
+	this.readReqC.AddNoWait(nil)
+}
+
+//立即将proposeC中累积的请求作为一个proposal提交
+func (this *kvstore) flushPropose() {
+	this.proposeC.AddNoWait(nil)
+}
+
 func (this *kvstore) issueConfChange(task *asynTaskConfChange) {
 	this.confChangeC <- task
 }
@@ -141,11 +206,30 @@ func (this *kvstore) doLRU() {
 		if this.lruHead.nnext != &this.lruTail {
 			kv := this.lruTail.pprev
 			count := 0
-			for len(this.elements)-count > MaxCachePerGroupSize {
+			//带过期时间的kv单独计数
+			for len(this.elements)-this.expires.len()-count > MaxCachePerGroupSize {
 				if kv == &this.lruHead {
 					return
 				}
 
+				if kv.getExpire() > 0 {
+					//过期时间无法从数据库恢复，带过期时间的kv常驻内存直到过期删除
+					kv = kv.pprev
+					continue
+				}
+
+				if kv.getMeta().GetWriteMode() == dbmeta.WriteCacheOnly {
+					//只存在于缓存中的kv不能被踢出
+					kv = kv.pprev
+					continue
+				}
+
+				if this.isSlotMigrating(kv.slot) {
+					//迁出中的kv由slot_out删除
+					kv = kv.pprev
+					continue
+				}
+
 				ok, removeDirect := this.tryKick(kv)
 				if !ok {
 					return
@@ -155,6 +239,9 @@ func (this *kvstore) doLRU() {
 				if removeDirect {
 					this.removeLRU(kv)
 					kv.setStatus(cache_remove)
+					this.index.remove(kv)
+					this.expires.remove(kv)
+					this.keys.remove(kv)
 					delete(this.elements, kv.uniKey)
 				} else {
 					count++
@@ -166,6 +253,25 @@ func (this *kvstore) doLRU() {
 	}
 }
 
+/*
+ * 检查过期的kv
+ * 只由持有租约的leader执行，过期删除作为proposal同步到所有副本
+ */
+func (this *kvstore) checkExpire() {
+	if !this.rn.hasLease() {
+		return
+	}
+
+	expired := this.expires.expired(time.Now().UnixNano() / int64(time.Millisecond))
+
+	if len(expired) > 0 {
+		for _, v := range expired {
+			v.processCmd(&cmdExpire{kv: v})
+		}
+		this.flushPropose()
+	}
+}
+
 func (this *kvstore) kick(taskKick *asynCmdTaskKick) bool {
 	kv := taskKick.getKV()
 	kv.setKicking(true)
@@ -222,19 +328,22 @@ func splitUniKey(s string) (table string, key string) {
 	return
 }
 
-func (this *kvstore) apply(data []byte, snapshot bool) bool {
-
+//去掉压缩标记，如果数据被压缩则解压
+func (this *kvstore) unpack(data []byte) ([]byte, error) {
 	compressFlag := binary.BigEndian.Uint16(data[:2])
-
 	if compressFlag == compressMagic {
-		var err error
-		data, err = this.unCompressor.UnCompress(data[2:])
-		if nil != err {
-			logger.Errorln("uncompress error")
-			return false
-		}
+		return this.unCompressor.UnCompress(data[2:])
 	} else {
-		data = data[2:]
+		return data[2:], nil
+	}
+}
+
+func (this *kvstore) apply(data []byte, snapshot bool) bool {
+
+	data, err := this.unpack(data)
+	if nil != err {
+		logger.Errorln("uncompress error")
+		return false
 	}
 
 	s := str.NewStr(data, len(data))
@@ -246,8 +355,13 @@ func (this *kvstore) apply(data []byte, snapshot bool) bool {
 
 	if snapshot {
 		this.elements = map[string]*kv{}
+		this.index.reset()
+		this.expires.reset()
+		this.keys.reset()
 		this.lruHead.nnext = &this.lruTail
 		this.lruTail.pprev = &this.lruHead
+		//不包含slot状态的旧快照使用初始分配
+		this.resetSlots()
 	}
 
 	var p *proposal
@@ -261,6 +375,10 @@ func (this *kvstore) apply(data []byte, snapshot bool) bool {
 		switch p.tt {
 		case proposal_lease:
 			this.rn.lease.update(this.rn, p.values[0].(int), p.values[1].(uint64))
+		case proposal_meta:
+			if !this.switchMeta(p.values[0].(int64), p.values[1].([]string)) {
+				return false
+			}
 		case proposal_snapshot, proposal_update, proposal_kick:
 			unikey := p.values[0].(string)
 
@@ -268,62 +386,91 @@ func (this *kvstore) apply(data []byte, snapshot bool) bool {
 				logger.Debugln(unikey, "cache_kick")
 				kv, ok := this.elements[unikey]
 				if !ok {
+					//kv已经随slot迁出
+					if !this.ownSlot(this.storeMgr.getSlot(unikey)) {
+						continue
+					}
 					return false
 				} else {
 					this.removeLRU(kv)
+					this.index.remove(kv)
+					this.expires.remove(kv)
+					this.keys.remove(kv)
 					delete(this.elements, unikey)
 				}
 			} else {
-				kv, ok := this.elements[unikey]
-				if p.tt == proposal_update && !ok {
+				if _, ok := this.elements[unikey]; p.tt == proposal_update && !ok {
 					return false
 				}
 
-				version := p.values[1].(int64)
-
-				if !ok {
+				var fields []*proto.Field
+				if len(p.values) > 3 {
+					fields = p.values[3].([]*proto.Field)
+				}
 
-					table, key := splitUniKey(unikey)
-					meta := this.storeMgr.dbmeta.GetTableMeta(table)
-					if nil == meta {
-						return false
-					}
-					kv = newkv(this, meta, key, unikey, false)
-					this.elements[unikey] = kv
+				if !this.applyKv(p.tt, unikey, p.values[1].(int64), p.values[2].(int64), fields) {
+					return false
 				}
+			}
+		case proposal_slot:
+			this.applySlotOp(p.values[0].(int), p.values[1].(int), p.values[2].(int), p.values[3].(int64), p.values[4].([]*proposal))
+		case proposal_slots:
+			this.applySlots(p.values[0].(map[int]int64), p.values[1].(map[int]int))
+		case proposal_members:
+			this.rn.applyMembers(p.values[0].(map[int]string), p.values[1].([]uint64))
+		default:
+			return false
+		}
+	}
+	return true
+}
 
-				if version == 0 {
-					kv.setStatus(cache_missing)
-					kv.fields = nil
-					logger.Debugln(p.tt, unikey, version, "cache_missing", kv.fields)
-				} else {
-					kv.setStatus(cache_ok)
-					kv.version = version
-					fields := p.values[2].([]*proto.Field)
+//用快照或日志中的数据设置kv,调用方需持有store的锁
+func (this *kvstore) applyKv(tt int, unikey string, version int64, expire int64, fields []*proto.Field) bool {
+	kv, ok := this.elements[unikey]
+
+	if !ok {
+		table, key := splitUniKey(unikey)
+		meta := this.dbmeta.GetTableMeta(table)
+		if nil == meta {
+			return false
+		}
+		kv = newkv(this, meta, key, unikey, false)
+		this.elements[unikey] = kv
+		this.keys.add(kv)
+	}
 
-					logger.Debugln(p.tt, unikey, version, "cache_ok", kv.getStatus(), kv.isWriteBack(), fields)
+	if version == 0 {
+		kv.setStatus(cache_missing)
+		kv.fields = nil
+		kv.setExpire(0)
+		logger.Debugln(tt, unikey, version, "cache_missing", kv.fields)
+	} else {
+		kv.setStatus(cache_ok)
+		kv.version = version
+		kv.setExpire(expire)
 
-					if nil == kv.fields {
-						kv.fields = map[string]*proto.Field{}
-					}
+		logger.Debugln(tt, unikey, version, "cache_ok", kv.getStatus(), kv.isWriteBack(), fields)
 
-					for _, v := range fields {
-						//不一致表示数据库字段类型发生变更，老数据直接丢弃
-						if !kv.meta.CheckFieldMeta(v) {
-							logger.Debugln("drop field", v.GetName())
-						} else {
-							kv.fields[v.GetName()] = v
-						}
-					}
-				}
-				kv.setSnapshoted(true)
+		if nil == kv.fields {
+			kv.fields = map[string]*proto.Field{}
+		}
 
-				this.updateLRU(kv)
+		for _, v := range fields {
+			//不一致表示数据库字段类型发生变更，老数据直接丢弃
+			if !kv.meta.CheckFieldMeta(v) {
+				logger.Debugln("drop field", v.GetName())
+			} else {
+				kv.fields[v.GetName()] = v
 			}
-		default:
-			return false
 		}
 	}
+	kv.setSnapshoted(true)
+
+	this.index.update(kv)
+
+	this.updateLRU(kv)
+
 	return true
 }
 
@@ -344,12 +491,15 @@ func (this *kvstore) readCommits(snapshotter *snap.Snapshotter, commitC <-chan i
 					if !this.apply(snapshot.Data[8:], true) {
 						logger.Fatalln("recoverFromSnapshot failed")
 					}
+					this.writeCDCSnapshot(snapshot.Metadata.Index)
+					atomic.StoreUint64(&this.appliedIndex, snapshot.Metadata.Index)
 				}
 			} else if data == replayOK {
 				logger.Infoln("reply ok,keycount", len(this.elements))
 				return
 			} else {
 				data.apply(this)
+				atomic.StoreUint64(&this.appliedIndex, data.index)
 			}
 		case *readBatchSt:
 			e.(*readBatchSt).reply()
@@ -359,6 +509,10 @@ func (this *kvstore) readCommits(snapshotter *snap.Snapshotter, commitC <-chan i
 
 	}
 
+	if nil != this.cdc {
+		this.cdc.Close()
+	}
+
 	if err, ok := <-errorC; ok {
 		logger.Fatalln(err)
 	}
@@ -366,36 +520,66 @@ func (this *kvstore) readCommits(snapshotter *snap.Snapshotter, commitC <-chan i
 
 func (this *kvstore) checkKvCount() bool {
 	MaxCachePerGroupSize := conf.GetConfig().MaxCachePerGroupSize
-	if len(this.elements) > MaxCachePerGroupSize {
+	if len(this.elements)-this.expires.len() > MaxCachePerGroupSize {
 		return false
 	} else {
 		return true
 	}
 }
 
+//带过期时间的kv数量限制
+func (this *kvstore) checkTTLCount() bool {
+	config := conf.GetConfig()
+	max := config.MaxTTLCachePerGroupSize
+	if max <= 0 {
+		max = config.MaxCachePerGroupSize
+	}
+	return this.expires.len() < max
+}
+
+type snapItem interface {
+	append2Str(*str.Str)
+}
+
 type kvsnap struct {
 	uniKey  string
 	fields  map[string]*proto.Field
 	version int64
+	expire  int64
 }
 
 func (this *kvsnap) append2Str(s *str.Str) {
-	appendProposal2Str(s, proposal_snapshot, this.uniKey, this.version, this.fields)
+	appendProposal2Str(s, proposal_snapshot, this.uniKey, this.version, this.fields, this.expire)
 }
 
-func (this *kvstore) getSnapshot() [][]*kvsnap {
+type metasnap struct {
+	version int64
+	def     []string
+}
+
+func (this *metasnap) append2Str(s *str.Str) {
+	appendProposal2Str(s, proposal_meta, this.version, this.def)
+}
+
+func (this *kvstore) getSnapshot() [][]snapItem {
 
 	beg := time.Now()
 
-	ret := make([][]*kvsnap, 0, snapGroupSize)
+	ret := make([][]snapItem, 0, snapGroupSize+1)
 
 	snapGroup := make([][]*kv, snapGroupSize, snapGroupSize)
 
-	ch := make(chan []*kvsnap, snapGroupSize)
+	ch := make(chan []snapItem, snapGroupSize)
 
 	this.Lock()
 	defer this.Unlock()
 
+	//表格配置必须在所有kv之前恢复
+	ret = append(ret, []snapItem{&metasnap{
+		version: this.dbmeta.GetVersion(),
+		def:     this.dbmeta.GetDef(),
+	}, this.getSlotSnap(), this.rn.getMemberSnap()})
+
 	//根据key对kv分组
 	for k, v := range this.elements {
 		i := futil.StringHash(k) % snapGroupSize
@@ -405,7 +589,7 @@ func (this *kvstore) getSnapshot() [][]*kvsnap {
 	//并行序列化每组中的kv
 	for i := 0; i < snapGroupSize; i++ {
 		go func(i int) {
-			kvsnaps := make([]*kvsnap, 0, len(this.elements))
+			kvsnaps := make([]snapItem, 0, len(this.elements))
 			for _, v := range snapGroup[i] {
 				v.Lock()
 				status := v.getStatus()
@@ -413,6 +597,7 @@ func (this *kvstore) getSnapshot() [][]*kvsnap {
 					snap := &kvsnap{
 						uniKey:  v.uniKey,
 						version: v.version,
+						expire:  v.getExpire(),
 					}
 
 					if v.fields != nil {
@@ -440,34 +625,110 @@ func (this *kvstore) getSnapshot() [][]*kvsnap {
 
 }
 
+/*
+ * 切换到version版本的表格配置,调用方需持有store的锁
+ * 版本号由配置内容决定(dbmeta.DefVersion),版本相同时不需要切换，重复提交相同的配置不会产生新版本
+ * 已有kv立即切换到新的TableMeta,表格被删除的kv保留原TableMeta,在getkv时返回ERR_INVAILD_TABLE
+ */
+func (this *kvstore) switchMeta(version int64, def []string) bool {
+	if version == this.dbmeta.GetVersion() {
+		return true
+	}
+
+	if err := this.dbmeta.ReloadWithVersion(def, version); nil != err {
+		logger.Errorln("switchMeta error", version, err)
+		return false
+	}
+
+	for _, v := range this.elements {
+		if meta := this.dbmeta.GetTableMeta(v.table); nil != meta {
+			atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&v.meta)), unsafe.Pointer(meta))
+		}
+		//索引字段可能发生变化
+		v.Lock()
+		this.index.update(v)
+		v.Unlock()
+	}
+
+	this.storeMgr.updateMeta(this, version, def)
+
+	logger.Infoln("switchMeta ok", this.rn.id, version)
+
+	return true
+}
+
 func (this *kvstore) gotLease() {
 	this.Lock()
 	defer this.Unlock()
 	//获得租约,强制store对所有kv执行一次sql回写
+	//迁出中及不由本region负责的slot由迁移流程回写
 	for _, vv := range this.elements {
+		if !this.serveSlot(vv.slot) {
+			continue
+		}
 		vv.Lock()
 		if !vv.isWriteBack() {
-			status := vv.getStatus()
-			if status == cache_ok || status == cache_missing {
-				vv.setWriteBack(true)
-				if status == cache_ok {
-					vv.setSqlFlag(sql_insert_update)
-				} else if status == cache_missing {
-					vv.setSqlFlag(sql_delete)
-				}
-				logger.Debugln("pushUpdateReq", vv.uniKey, status, vv.fields)
-				this.kvNode.sqlMgr.pushUpdateReq(vv)
-			}
+			this.forceWriteBack(vv)
 		}
 		vv.Unlock()
 	}
 }
 
+//按kv当前的状态整体回写一次,已经在回写队列中的kv只更新回写方式(调用方持有kv锁)
+func (this *kvstore) forceWriteBack(vv *kv) {
+	if vv.getMeta().GetWriteMode() == dbmeta.WriteCacheOnly {
+		return
+	}
+	status := vv.getStatus()
+	if status == cache_ok {
+		vv.setSqlFlag(sql_insert_update)
+	} else if status == cache_missing {
+		vv.setSqlFlag(sql_delete)
+	} else {
+		return
+	}
+	if !vv.isWriteBack() {
+		vv.setWriteBack(true)
+		logger.Debugln("pushUpdateReq", vv.uniKey, status, vv.fields)
+		this.kvNode.sqlMgr.pushUpdateReq(vv)
+	}
+}
+
 type storeMgr struct {
 	sync.RWMutex
-	stores map[int]*kvstore
-	mask   int
-	dbmeta *dbmeta.DBMeta
+	stores       map[int]*kvstore
+	mask         int
+	dbmeta       *dbmeta.DBMeta
+	metaMtx      sync.Mutex
+	metaVersions map[*kvstore]int64 //各store当前的表格配置版本
+	slotInfo     slotInfo
+	muSlot       sync.RWMutex
+	slotTable    *partition.Table //由各store apply slot变更时更新
+}
+
+//对所有store执行waitReadIndex,任何一个store失败时返回其错误码
+func (this *storeMgr) waitReadIndex(deadline time.Time) int32 {
+	this.RLock()
+	stores := make([]*kvstore, 0, len(this.stores))
+	for _, v := range this.stores {
+		stores = append(stores, v)
+	}
+	this.RUnlock()
+
+	ch := make(chan int32, len(stores))
+	for _, v := range stores {
+		go func(store *kvstore) {
+			ch <- store.waitReadIndex(deadline)
+		}(v)
+	}
+
+	errno := errcode.ERR_OK
+	for range stores {
+		if e := <-ch; errcode.ERR_OK != e && errcode.ERR_OK == errno {
+			errno = e
+		}
+	}
+	return errno
 }
 
 func (this *storeMgr) getkvOnly(table string, key string, uniKey string) *kv {
@@ -487,12 +748,16 @@ func (this *storeMgr) getkv(table string, key string, uniKey string) (*kv, int32
 	var err int32 = errcode.ERR_OK
 	store := this.getStore(uniKey)
 	if nil != store {
+		if !store.serveSlot(this.getSlot(uniKey)) {
+			//slot正在迁移
+			return nil, errcode.ERR_RETRY
+		}
 		store.Lock()
 		defer store.Unlock()
 		k, ok = store.elements[uniKey]
 		if ok {
-			if !this.dbmeta.CheckMetaVersion(k.meta.Version()) {
-				newMeta := this.dbmeta.GetTableMeta(table)
+			if !store.dbmeta.CheckMetaVersion(k.meta.Version()) {
+				newMeta := store.dbmeta.GetTableMeta(table)
 				if newMeta != nil {
 					atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&k.meta)), unsafe.Pointer(newMeta))
 				} else {
@@ -506,12 +771,13 @@ func (this *storeMgr) getkv(table string, key string, uniKey string) (*kv, int32
 				err = errcode.ERR_BUSY
 			} else {
 
-				meta := this.dbmeta.GetTableMeta(table)
+				meta := store.dbmeta.GetTableMeta(table)
 				if meta == nil {
 					err = errcode.ERR_INVAILD_TABLE
 				} else {
 					k = newkv(store, meta, key, uniKey, true)
 					store.elements[uniKey] = k
+					store.keys.add(k)
 					store.updateLRU(k)
 				}
 			}
@@ -523,6 +789,25 @@ func (this *storeMgr) getkv(table string, key string, uniKey string) (*kv, int32
 	return k, err
 }
 
+/*
+ * 节点级的表格配置在所有store切换到相同版本后切换
+ * 版本号没有先后关系，部分store切换时(或重启后回放日志的过程中)保持原配置
+ */
+func (this *storeMgr) updateMeta(store *kvstore, version int64, def []string) {
+	this.metaMtx.Lock()
+	defer this.metaMtx.Unlock()
+	this.metaVersions[store] = version
+	if len(this.metaVersions) < this.mask || version == this.dbmeta.GetVersion() {
+		return
+	}
+	for _, v := range this.metaVersions {
+		if v != version {
+			return
+		}
+	}
+	this.dbmeta.ReloadWithVersion(def, version)
+}
+
 func (this *storeMgr) getStoreByIndex(index int) *kvstore {
 	this.RLock()
 	defer this.RUnlock()
@@ -530,10 +815,7 @@ func (this *storeMgr) getStoreByIndex(index int) *kvstore {
 }
 
 func (this *storeMgr) getStore(uniKey string) *kvstore {
-	this.RLock()
-	defer this.RUnlock()
-	index := (futil.StringHash(uniKey) % this.mask) + 1
-	return this.stores[index]
+	return this.getStoreBySlot(this.getSlot(uniKey))
 }
 
 func (this *storeMgr) addStore(index int, store *kvstore) bool {
@@ -568,6 +850,10 @@ func newKVStore(storeMgr *storeMgr, kvNode *KVNode, proposeC *util.BlockQueue, r
 		kvNode:       kvNode,
 		storeMgr:     storeMgr,
 		unCompressor: &net.ZipUnCompressor{},
+		dbmeta:       storeMgr.dbmeta.Clone(),
+		index:        newKvIndex(),
+		expires:      newKvExpire(),
+		keys:         newKvKeys(),
 	}
 
 	s.lruHead.nnext = &s.lruTail
@@ -576,13 +862,16 @@ func newKVStore(storeMgr *storeMgr, kvNode *KVNode, proposeC *util.BlockQueue, r
 	return s
 }
 
-func newStoreMgr(kvnode *KVNode, mutilRaft *mutilRaft, dbmeta *dbmeta.DBMeta, id *int, peers map[int]string, mask int) *storeMgr {
+func newStoreMgr(kvnode *KVNode, mutilRaft *mutilRaft, dbmeta *dbmeta.DBMeta, id *int, peers map[int]string, join bool, mask int) *storeMgr {
 	mgr := &storeMgr{
-		stores: map[int]*kvstore{},
-		mask:   mask,
-		dbmeta: dbmeta,
+		stores:       map[int]*kvstore{},
+		mask:         mask,
+		dbmeta:       dbmeta,
+		metaVersions: map[*kvstore]int64{},
 	}
 
+	mgr.initSlots(*id)
+
 	for i := 1; i <= mask; i++ {
 
 		proposeC := util.NewBlockQueue()
@@ -591,12 +880,29 @@ func newStoreMgr(kvnode *KVNode, mutilRaft *mutilRaft, dbmeta *dbmeta.DBMeta, id
 
 		store := newKVStore(mgr, kvnode, proposeC, readC, confChangeC)
 
-		rn, commitC, errorC, snapshotterReady := newRaftNode(mutilRaft, (*id<<16)+i, peers, false, proposeC, confChangeC, readC, store.getSnapshot)
+		mgr.metaMtx.Lock()
+		mgr.metaVersions[store] = store.dbmeta.GetVersion()
+		mgr.metaMtx.Unlock()
+
+		rn, commitC, errorC, snapshotterReady := newRaftNode(mutilRaft, (*id<<16)+i, peers, join, proposeC, confChangeC, readC, store.getSnapshot)
 
 		store.rn = rn
+		rn.kvstore = store
+
+		store.resetSlots()
+
+		if config := conf.GetConfig(); config.CDC.Enable {
+			//多个节点可能使用相同的配置，目录包含节点id
+			w, err := cdc.NewWriter(filepath.Join(config.CDC.Dir, strconv.Itoa(*id)), i, config.CDC.MaxFileSize, config.CDC.MaxFiles)
+			if nil != err {
+				logger.Fatalln("open cdc writer error", err)
+			}
+			store.cdc = w
+		}
 
 		store.lruTimer = timer.Repeat(time.Second, nil, func(t *timer.Timer, _ interface{}) {
 			store.doLRU()
+			store.checkExpire()
 		}, nil)
 
 		store.stop = func() {
@@ -5,8 +5,10 @@
 package kvnode
 
 import (
+	"github.com/sniperHW/flyfish/errcode"
 	"github.com/sniperHW/flyfish/util/str"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -26,6 +28,34 @@ const (
 	wait_timeout        = 2
 )
 
+/*
+ * 读租约(LeaseRead开启时)
+ * leader在sendTime发起的ReadIndex成功，说明sendTime之后多数派仍然认可leader,这些follower在收到leader消息后的
+ * 选举超时内拒绝投票(CheckQuorum),所以sendTime+readLeaseTimeout之前不会产生新的leader,Get可以直接从内存返回。
+ * readLeaseTimeout小于最小选举超时，留出时钟误差。租约过期后Get重新走ReadIndex,成功后续约。
+ */
+const readLeaseTimeout = electionTick * tickInterval * 8 / 10
+
+//用一次成功的ReadIndex续约,由kvstore在apply之后调用
+func (rc *raftNode) renewReadLease(term uint64, sendTime time.Time) {
+	rc.muLeader.Lock()
+	defer rc.muLeader.Unlock()
+	//转移leader期间及转移成功后不再续约,MsgTimeoutNow不受CheckQuorum约束
+	if rc.leader == rc.id && rc.term == term && rc.transferedTerm != term && atomic.LoadInt32(&rc.transferring) == 0 {
+		expire := sendTime.Add(readLeaseTimeout)
+		if rc.readLeaseTerm != term || expire.After(rc.readLeaseExpire) {
+			rc.readLeaseTerm = term
+			rc.readLeaseExpire = expire
+		}
+	}
+}
+
+func (rc *raftNode) hasReadLease() bool {
+	rc.muLeader.Lock()
+	defer rc.muLeader.Unlock()
+	return rc.leader == rc.id && rc.readLeaseTerm == rc.term && time.Now().Before(rc.readLeaseExpire) && atomic.LoadInt32(&rc.transferring) == 0
+}
+
 type asynTaskLease struct {
 	rn   *raftNode
 	term uint64
@@ -50,12 +80,41 @@ func (this *asynTaskLease) onPorposeTimeout() {
 
 }
 
+/*
+ * 主动放弃租约(转移leader前),所有副本apply后租约持有者为0,新leader无需等待leaseTimeout即可续约。
+ * 与续约使用同一个propose管道，之前发起的续约总是先于放弃被apply。
+ */
+type asynTaskLeaseRelease struct {
+	rn      *raftNode
+	term    uint64
+	doneCB  func()
+	errorCB func(errno int32)
+}
+
+func (this *asynTaskLeaseRelease) done() {
+	this.rn.lease.update(this.rn, 0, this.term)
+	this.doneCB()
+}
+
+func (this *asynTaskLeaseRelease) onError(errno int32) {
+	this.errorCB(errno)
+}
+
+func (this *asynTaskLeaseRelease) append2Str(s *str.Str) {
+	appendProposal2Str(s, proposal_lease, 0, this.term)
+}
+
+func (this *asynTaskLeaseRelease) onPorposeTimeout() {
+	this.errorCB(errcode.ERR_TIMEOUT)
+}
+
 type lease struct {
 	sync.Mutex
 	term      uint64
 	owner     int //当前租约持有者
 	startTime time.Time
 	stopc     chan struct{}
+	releasing bool //转移leader期间停止续约
 }
 
 //返回当前raftNode是否持有租约
@@ -71,15 +130,19 @@ func (l *lease) hasLease(rn *raftNode) bool {
 	}
 }
 
-//更新租约,返回rn是否获得租约(非续约)
+/*
+ * 更新租约,返回rn是否获得租约(非续约)
+ * 转移leader失败后在同一term重新续约也算获得租约，放弃租约期间回写被丢弃的kv需要重新回写
+ */
 func (l *lease) update(rn *raftNode, id int, term uint64) bool {
 	l.Lock()
 	defer l.Unlock()
 	oldTerm := l.term
+	oldOwner := l.owner
 	l.term = term
 	l.owner = id
 	l.startTime = time.Now()
-	return rn.id == id && oldTerm != term
+	return rn.id == id && (oldTerm != term || oldOwner != id)
 }
 
 func (l *lease) wait(stopc chan struct{}, second time.Duration) int {
@@ -98,13 +161,20 @@ func (l *lease) stop() {
 	}
 }
 
+func (l *lease) setReleasing(releasing bool) {
+	l.Lock()
+	defer l.Unlock()
+	l.releasing = releasing
+}
+
 func (l *lease) startLeaseRoutine(rn *raftNode) {
 	stopc := make(chan struct{})
 	l.stopc = stopc
+	l.setReleasing(false)
 	go func() {
 		for rn.isLeader() {
 			l.Lock()
-			waitLeaseTimeout := l.owner != 0 && l.owner != rn.id && !(time.Now().Sub(l.startTime) > leaseTimeout)
+			waitLeaseTimeout := l.releasing || (l.owner != 0 && l.owner != rn.id && !(time.Now().Sub(l.startTime) > leaseTimeout))
 			l.Unlock()
 			if waitLeaseTimeout {
 				//owner非自己，等待owner的lease过期
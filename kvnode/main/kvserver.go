@@ -19,6 +19,7 @@ func main() {
 	id := flag.Int("id", 1, "node ID")
 	pprof := flag.String("pprof", "localhost:8899", "pprof")
 	config := flag.String("config", "config.toml", "config")
+	join := flag.Bool("join", false, "join an existing cluster")
 
 	go func() {
 		http.ListenAndServe(*pprof, nil)
@@ -32,9 +33,14 @@ func main() {
 
 	node := kvnode.NewKvNode()
 
-	err := node.Start(id, cluster)
+	var err error
+	if *join {
+		err = node.Join(id, cluster)
+	} else {
+		err = node.Start(id, cluster)
+	}
 	if nil == err {
-		c := make(chan os.Signal)
+		c := make(chan os.Signal, 1)
 		signal.Notify(c, syscall.SIGINT) //监听指定信号
 		_ = <-c                          //阻塞直至有信号传入
 		node.Stop()
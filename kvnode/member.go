@@ -0,0 +1,305 @@
+package kvnode
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/flyfish/util/str"
+	"go.etcd.io/etcd/pkg/types"
+	"go.etcd.io/etcd/raft/raftpb"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+/*
+ * 集群成员变更
+ * 添加或删除节点对所有region执行，每个region在本节点作为leader的store上发起ConfChange,本节点不是leader的store返回ERR_NOT_LEADER,
+ * 需要向其leader所在节点再次发起。
+ * 新节点以-join方式启动，从leader接收日志及快照。
+ * 每个raftNode在apply ConfChange后将成员及被删除的id保存到kv-节点-region-member,重启时恢复,被删除的节点发来的消息会被拒绝。
+ * 成员信息同时写入kvstore的快照，通过快照追赶的节点据此添加日志已被压缩的成员。
+ */
+
+type memberInfo struct {
+	Peers   map[int]string //节点id->raft地址
+	Removed []uint64       //被删除的raft id
+}
+
+func (rc *raftNode) memberFile() string {
+	return fmt.Sprintf("kv-%d-%d-member", rc.nodeID, rc.region)
+}
+
+//启动时合并保存的成员信息
+func (rc *raftNode) loadMembers() {
+	b, err := ioutil.ReadFile(rc.memberFile())
+	if nil != err {
+		if !os.IsNotExist(err) {
+			logger.Fatalln("load member error", err)
+		}
+		return
+	}
+
+	info := memberInfo{}
+
+	if err = json.Unmarshal(b, &info); nil != err {
+		logger.Fatalln("load member error", err)
+	}
+
+	rc.muMember.Lock()
+	defer rc.muMember.Unlock()
+
+	for k, v := range info.Peers {
+		rc.peers[k] = v
+	}
+
+	for _, v := range info.Removed {
+		rc.removed[v] = true
+		delete(rc.peers, int(v>>16))
+	}
+}
+
+//快照中的成员信息，以-join启动的节点通过快照追赶时获得之前加入的节点地址(对应的ConfChange日志可能已被压缩)
+type membersnap struct {
+	peers   map[int]string
+	removed []uint64
+}
+
+func (this *membersnap) append2Str(s *str.Str) {
+	appendProposal2Str(s, proposal_members, this.peers, this.removed)
+}
+
+func (rc *raftNode) getMemberSnap() *membersnap {
+	rc.muMember.Lock()
+	defer rc.muMember.Unlock()
+	snap := &membersnap{
+		peers: map[int]string{},
+	}
+	for k, v := range rc.peers {
+		snap.peers[k] = v
+	}
+	for k := range rc.removed {
+		snap.removed = append(snap.removed, k)
+	}
+	sort.Slice(snap.removed, func(i, j int) bool {
+		return snap.removed[i] < snap.removed[j]
+	})
+	return snap
+}
+
+//合并快照中的成员信息
+func (rc *raftNode) applyMembers(peers map[int]string, removed []uint64) {
+	rc.muMember.Lock()
+
+	for _, v := range removed {
+		id := int(v >> 16)
+		if _, ok := rc.peers[id]; ok && rc.transportStarted && int(v) != rc.id {
+			rc.transport.RemovePeer(types.ID(v))
+		}
+		rc.removed[v] = true
+		delete(rc.peers, id)
+	}
+
+	for k, v := range peers {
+		id := k<<16 + rc.region
+		if rc.removed[uint64(id)] {
+			continue
+		}
+
+		old, ok := rc.peers[k]
+		if ok && old == v {
+			continue
+		}
+
+		rc.peers[k] = v
+
+		if rc.transportStarted && id != rc.id {
+			logger.Infoln("AddPeer from snapshot", types.ID(id).String(), v)
+			if ok {
+				rc.transport.UpdatePeer(types.ID(id), []string{v})
+			} else {
+				rc.transport.AddPeer(types.ID(id), []string{v})
+			}
+		}
+	}
+
+	rc.muMember.Unlock()
+	rc.saveMembers()
+}
+
+func (rc *raftNode) saveMembers() {
+	rc.muMember.Lock()
+	info := memberInfo{
+		Peers: map[int]string{},
+	}
+	for k, v := range rc.peers {
+		info.Peers[k] = v
+	}
+	for k := range rc.removed {
+		info.Removed = append(info.Removed, k)
+	}
+	rc.muMember.Unlock()
+
+	b, _ := json.Marshal(&info)
+
+	//先写临时文件再改名，避免写入中途退出损坏
+	tmp := rc.memberFile() + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0644); nil != err {
+		logger.Errorln("save member error", err)
+		return
+	}
+
+	if err := os.Rename(tmp, rc.memberFile()); nil != err {
+		logger.Errorln("save member error", err)
+	}
+}
+
+//apply ConfChange后更新成员
+func (rc *raftNode) onMemberChange(changeType raftpb.ConfChangeType, id uint64, url string) {
+	rc.muMember.Lock()
+	if changeType == raftpb.ConfChangeAddNode {
+		if url != "" {
+			rc.peers[int(id>>16)] = url
+		}
+	} else if changeType == raftpb.ConfChangeRemoveNode {
+		delete(rc.peers, int(id>>16))
+		rc.removed[id] = true
+	}
+	rc.muMember.Unlock()
+	rc.saveMembers()
+}
+
+type cmdMemberChange struct {
+	sync.Mutex
+	replyer  *replyer
+	count    int
+	errStr   string
+	failed   map[int]int32 //store index -> errCode
+	finished map[int]bool
+}
+
+func (this *cmdMemberChange) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	resp := &proto.MemberChangeResp{}
+
+	if errcode.ERR_OK != errCode {
+		if "" != this.errStr {
+			resp.Err = this.errStr
+		} else if len(this.failed) > 0 {
+			errs := []string{}
+			for k, v := range this.failed {
+				errs = append(errs, fmt.Sprintf("%d:%s", k, errcode.GetErrorStr(v)))
+			}
+			sort.Strings(errs)
+			resp.Err = strings.Join(errs, ",")
+		} else {
+			resp.Err = errcode.GetErrorStr(errCode)
+		}
+	}
+
+	return net.NewMessage(net.CommonHead{
+		Seqno:   this.replyer.seqno,
+		ErrCode: errCode,
+	}, resp)
+}
+
+func (this *cmdMemberChange) onResult(index int, errCode int32) {
+	this.Lock()
+	if this.finished[index] {
+		this.Unlock()
+		return
+	}
+	this.finished[index] = true
+	if errcode.ERR_OK != errCode {
+		this.failed[index] = errCode
+	}
+	done := len(this.finished) == this.count
+	this.Unlock()
+
+	if done {
+		errCode = errcode.ERR_OK
+		for _, v := range this.failed {
+			//优先返回ERR_NOT_LEADER提示调用方到其它节点重试
+			if errCode != errcode.ERR_NOT_LEADER {
+				errCode = v
+			}
+		}
+		this.replyer.reply(this, errCode, nil, 0)
+	}
+}
+
+func (this *cmdMemberChange) reply(errCode int32, errStr string) {
+	this.errStr = errStr
+	this.replyer.reply(this, errCode, nil, 0)
+}
+
+func memberChange(n *KVNode, cli *cliConn, msg *net.Message) {
+
+	req := msg.GetData().(*proto.MemberChangeReq)
+
+	head := msg.GetHead()
+
+	_, respDeadline := getDeadline(head.Timeout)
+
+	cmd := &cmdMemberChange{
+		replyer:  newReplyer(cli, head.Seqno, respDeadline),
+		failed:   map[int]int32{},
+		finished: map[int]bool{},
+	}
+
+	nodeID := int(req.GetNodeId())
+
+	if nodeID <= 0 || nodeID > 0xFFFF {
+		cmd.reply(errcode.ERR_OTHER, "invaild node id")
+		return
+	}
+
+	changeType := raftpb.ConfChangeAddNode
+	if req.GetRemove() {
+		changeType = raftpb.ConfChangeRemoveNode
+	} else if "" == req.GetUrl() {
+		cmd.reply(errcode.ERR_OTHER, "missing url")
+		return
+	}
+
+	n.storeMgr.RLock()
+	stores := make(map[int]*kvstore, len(n.storeMgr.stores))
+	for k, v := range n.storeMgr.stores {
+		stores[k] = v
+	}
+	n.storeMgr.RUnlock()
+
+	cmd.count = len(stores)
+
+	for k, v := range stores {
+		index := k
+		id := uint64(nodeID<<16 + index)
+
+		if !v.rn.isLeader() {
+			cmd.onResult(index, errcode.ERR_NOT_LEADER)
+		} else if v.rn.IsIDRemoved(id) {
+			//被删除的id不能再次加入
+			if req.GetRemove() {
+				cmd.onResult(index, errcode.ERR_OK)
+			} else {
+				cmd.onResult(index, errcode.ERR_OTHER)
+			}
+		} else {
+			task := &asynTaskConfChange{
+				nodeid:     id,
+				changeType: changeType,
+				url:        req.GetUrl(),
+				doneCB: func() {
+					cmd.onResult(index, errcode.ERR_OK)
+				},
+				errorCB: func(errno int32) {
+					cmd.onResult(index, errno)
+				},
+			}
+			go v.issueConfChange(task)
+		}
+	}
+}
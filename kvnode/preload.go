@@ -0,0 +1,379 @@
+package kvnode
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/sniperHW/flyfish/dbmeta"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/proto"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ * 缓存预热
+ * 后台任务分批从数据库读取key,对本节点作为leader的store,为不在缓存中的key创建kv,
+ * 在kv的命令队列中通过sqlLoader重新读取记录后作为proposal_snapshot提交到所有副本,
+ * 扫描得到的只是key,避免用扫描时读到的旧记录覆盖之后的变更。
+ * 每批记录全部提交后才读取下一批，批次之间间隔preloadInterval,避免影响正常请求。
+ * 新建kv受MaxCachePerGroupSize限制，store的缓存已满时任务结束。
+ */
+
+const (
+	preloadBatchSize   = 100
+	preloadInterval    = time.Millisecond * 10
+	preloadCmdTimeout  = time.Second * 10
+	preloadJobLifeTime = time.Minute * 10 //任务结束后保留进度的时间
+)
+
+const preloadRangeTemplate string = "SELECT __key__ FROM %s where __key__ %s ?%s order by __key__ limit %d;"
+
+const preloadKeysTemplate string = "SELECT __key__ FROM %s where __key__ in(%s);"
+
+type preloadJob struct {
+	id      int64
+	n       *KVNode
+	meta    *dbmeta.TableMeta
+	keys    []string
+	begin   string
+	end     string
+	scanned int64
+	loaded  int64
+	skipped int64
+	finish  int32
+	err     string
+	wg      sync.WaitGroup //当前批次尚未完成的记录
+}
+
+func (this *preloadJob) isFinish() bool {
+	return atomic.LoadInt32(&this.finish) == 1
+}
+
+func (this *preloadJob) makeResp() *proto.PreloadResp {
+	resp := &proto.PreloadResp{
+		Job:     this.id,
+		Scanned: atomic.LoadInt64(&this.scanned),
+		Loaded:  atomic.LoadInt64(&this.loaded),
+		Skipped: atomic.LoadInt64(&this.skipped),
+		Finish:  this.isFinish(),
+	}
+	if resp.Finish {
+		resp.Err = this.err
+	}
+	return resp
+}
+
+func (this *preloadJob) query(db *sqlx.DB, s string, args ...interface{}) ([]string, error) {
+	r, err := db.Query(db.Rebind(s), args...)
+	if nil != err {
+		return nil, err
+	}
+
+	defer r.Close()
+
+	keys := []string{}
+
+	for r.Next() {
+		var key string
+		if err := r.Scan(&key); nil != err {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, r.Err()
+}
+
+//返回false表示store的缓存已满
+func (this *preloadJob) load(key string) bool {
+	uniKey := this.meta.GetTable() + ":" + key
+	store := this.n.storeMgr.getStore(uniKey)
+	if nil == store || !store.rn.isLeader() {
+		atomic.AddInt64(&this.skipped, 1)
+		return true
+	}
+
+	kv, err := this.n.storeMgr.getkv(this.meta.GetTable(), key, uniKey)
+	if errcode.ERR_BUSY == err {
+		return false
+	} else if errcode.ERR_OK != err {
+		atomic.AddInt64(&this.skipped, 1)
+		return true
+	}
+
+	this.wg.Add(1)
+
+	kv.processCmd(&cmdPreload{
+		job:      this,
+		kv:       kv,
+		deadline: time.Now().Add(preloadCmdTimeout),
+	})
+
+	return true
+}
+
+//提交一批记录并等待完成,返回false表示缓存已满
+func (this *preloadJob) loadBatch(keys []string) bool {
+	full := false
+	for _, v := range keys {
+		if !this.load(v) {
+			full = true
+			break
+		}
+		atomic.AddInt64(&this.scanned, 1)
+	}
+	this.wg.Wait()
+	logger.Infoln("preload", this.id, this.meta.GetTable(), "scanned", atomic.LoadInt64(&this.scanned), "loaded", atomic.LoadInt64(&this.loaded), "skipped", atomic.LoadInt64(&this.skipped))
+	if full {
+		this.err = "cache full"
+		return false
+	}
+	time.Sleep(preloadInterval)
+	return true
+}
+
+func (this *preloadJob) run(db *sqlx.DB) {
+
+	defer func() {
+		atomic.StoreInt32(&this.finish, 1)
+		logger.Infoln("preload", this.id, "finish", this.err)
+		time.AfterFunc(preloadJobLifeTime, func() {
+			this.n.preloadMgr.remove(this.id)
+		})
+	}()
+
+	if len(this.keys) > 0 {
+		for i := 0; i < len(this.keys); i += preloadBatchSize {
+			if this.n.sqlMgr.isStoped() {
+				this.err = "server stoped"
+				return
+			}
+
+			j := i + preloadBatchSize
+			if j > len(this.keys) {
+				j = len(this.keys)
+			}
+
+			marks := []string{}
+			args := []interface{}{}
+			for _, v := range this.keys[i:j] {
+				marks = append(marks, "?")
+				args = append(args, v)
+			}
+
+			keys, err := this.query(db, fmt.Sprintf(preloadKeysTemplate, this.meta.GetTable(), strings.Join(marks, ",")), args...)
+			if nil != err {
+				this.err = err.Error()
+				return
+			}
+
+			if !this.loadBatch(keys) {
+				return
+			}
+		}
+	} else {
+		op := ">="
+		cursor := this.begin
+		upper := ""
+		if "" != this.end {
+			upper = " and __key__ < ?"
+		}
+
+		for {
+			if this.n.sqlMgr.isStoped() {
+				this.err = "server stoped"
+				return
+			}
+
+			s := fmt.Sprintf(preloadRangeTemplate, this.meta.GetTable(), op, upper, preloadBatchSize)
+
+			args := []interface{}{cursor}
+			if "" != this.end {
+				args = append(args, this.end)
+			}
+
+			keys, err := this.query(db, s, args...)
+			if nil != err {
+				this.err = err.Error()
+				return
+			}
+
+			if len(keys) == 0 {
+				return
+			}
+
+			if !this.loadBatch(keys) || len(keys) < preloadBatchSize {
+				return
+			}
+
+			op = ">"
+			cursor = keys[len(keys)-1]
+		}
+	}
+}
+
+//kv处于cache_new时由sqlLoader读取记录，再同步到所有副本
+type asynCmdTaskPreload struct {
+	*asynCmdTaskBase
+}
+
+func (this *asynCmdTaskPreload) onSqlResp(errno int32) {
+	this.asynCmdTaskBase.onSqlResp(errno)
+	if errno == errcode.ERR_OK || errno == errcode.ERR_RECORD_NOTEXIST {
+		this.getKV().store.issueAddkv(this)
+	}
+}
+
+type cmdPreload struct {
+	job      *preloadJob
+	kv       *kv
+	deadline time.Time
+	replyed  int32
+}
+
+func (this *cmdPreload) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	return nil
+}
+
+func (this *cmdPreload) reply(errCode int32, fields map[string]*proto.Field, version int64) {
+	if atomic.CompareAndSwapInt32(&this.replyed, 0, 1) {
+		if errCode == errcode.ERR_OK {
+			atomic.AddInt64(&this.job.loaded, 1)
+		} else {
+			atomic.AddInt64(&this.job.skipped, 1)
+		}
+		this.job.wg.Done()
+	}
+}
+
+func (this *cmdPreload) dontReply() {
+	this.reply(errcode.ERR_TIMEOUT, nil, 0)
+}
+
+func (this *cmdPreload) isCancel() bool {
+	return false
+}
+
+func (this *cmdPreload) getKV() *kv {
+	return this.kv
+}
+
+func (this *cmdPreload) isTimeout() bool {
+	return time.Now().After(this.deadline)
+}
+
+func (this *cmdPreload) checkVersion(version int64) bool {
+	return true
+}
+
+func (this *cmdPreload) getTTL() time.Duration {
+	return 0
+}
+
+func (this *cmdPreload) prepare(t asynCmdTaskI) (asynCmdTaskI, bool) {
+
+	if t != nil {
+		return t, false
+	}
+
+	if this.kv.getStatus() != cache_new {
+		//已经在缓存中
+		this.reply(errcode.ERR_RECORD_EXIST, nil, 0)
+		return t, true
+	}
+
+	return &asynCmdTaskPreload{
+		asynCmdTaskBase: &asynCmdTaskBase{
+			commands: []commandI{this},
+		},
+	}, true
+}
+
+type preloadMgr struct {
+	sync.Mutex
+	jobs   map[int64]*preloadJob
+	nextID int64
+}
+
+func newPreloadMgr() *preloadMgr {
+	return &preloadMgr{
+		jobs: map[int64]*preloadJob{},
+	}
+}
+
+func (this *preloadMgr) add(job *preloadJob) {
+	this.Lock()
+	defer this.Unlock()
+	this.nextID++
+	job.id = this.nextID
+	this.jobs[job.id] = job
+}
+
+func (this *preloadMgr) get(id int64) *preloadJob {
+	this.Lock()
+	defer this.Unlock()
+	return this.jobs[id]
+}
+
+func (this *preloadMgr) remove(id int64) {
+	this.Lock()
+	defer this.Unlock()
+	delete(this.jobs, id)
+}
+
+func preload(n *KVNode, cli *cliConn, msg *net.Message) {
+
+	req := msg.GetData().(*proto.PreloadReq)
+
+	head := msg.GetHead()
+
+	reply := func(errCode int32, resp *proto.PreloadResp) {
+		cli.send(net.NewMessage(net.CommonHead{
+			Seqno:   head.Seqno,
+			ErrCode: errCode,
+		}, resp))
+	}
+
+	if 0 != req.GetJob() {
+		if job := n.preloadMgr.get(req.GetJob()); nil == job {
+			reply(errcode.ERR_OTHER, &proto.PreloadResp{Job: req.GetJob(), Err: "job not found"})
+		} else {
+			reply(errcode.ERR_OK, job.makeResp())
+		}
+		return
+	}
+
+	if "" == req.GetTable() {
+		reply(errcode.ERR_MISSING_TABLE, &proto.PreloadResp{})
+		return
+	}
+
+	meta := n.storeMgr.dbmeta.GetTableMeta(req.GetTable())
+
+	if nil == meta || meta.GetWriteMode() == dbmeta.WriteCacheOnly {
+		reply(errcode.ERR_INVAILD_TABLE, &proto.PreloadResp{})
+		return
+	}
+
+	if len(req.GetKeys()) == 0 && !req.GetAll() && "" == req.GetBegin() && "" == req.GetEnd() {
+		reply(errcode.ERR_MISSING_KEY, &proto.PreloadResp{})
+		return
+	}
+
+	job := &preloadJob{
+		n:     n,
+		meta:  meta,
+		keys:  req.GetKeys(),
+		begin: req.GetBegin(),
+		end:   req.GetEnd(),
+	}
+
+	n.preloadMgr.add(job)
+
+	reply(errcode.ERR_OK, job.makeResp())
+
+	go job.run(n.sqlMgr.preloadDB)
+}
@@ -4,31 +4,109 @@ import (
 	"github.com/sniperHW/flyfish/errcode"
 	"github.com/sniperHW/flyfish/proto"
 	"github.com/sniperHW/flyfish/util/str"
+	"sort"
 )
 
 type proposal struct {
 	tt     int
 	values []interface{}
+	load   bool //proposal_flag_load
 }
 
 func appendProposal2Str(s *str.Str, tt int, values ...interface{}) {
+	flag := tt & proposal_flag_load
+	tt &^= proposal_flag_load
 	switch tt {
 	case proposal_lease:
 		s.AppendByte(byte(tt))
 		s.AppendInt32(int32(values[0].(int)))
 		s.AppendInt64(int64(values[1].(uint64)))
-	case proposal_snapshot, proposal_update, proposal_kick:
+	case proposal_meta:
+		s.AppendByte(byte(tt))
+		s.AppendInt64(values[0].(int64))
+		def := values[1].([]string)
+		s.AppendInt32(int32(len(def)))
+		for _, v := range def {
+			s.AppendInt32(int32(len(v)))
+			s.AppendString(v)
+		}
+	case proposal_slot:
+		s.AppendByte(byte(tt))
+		s.AppendInt32(int32(values[0].(int)))
+		s.AppendInt32(int32(values[1].(int)))
+		s.AppendInt32(int32(values[2].(int)))
+		//迁入后slot的epoch
+		s.AppendInt64(values[3].(int64))
+		//迁入的kv
+		kvs, _ := values[4].([]*kvsnap)
+		s.AppendInt32(int32(len(kvs)))
+		for _, v := range kvs {
+			v.append2Str(s)
+		}
+	case proposal_slots:
 		s.AppendByte(byte(tt))
+		//slot -> epoch
+		slots := values[0].(map[int]int64)
+		keys := make([]int, 0, len(slots))
+		for k := range slots {
+			keys = append(keys, k)
+		}
+		sort.Ints(keys)
+		s.AppendInt32(int32(len(keys)))
+		for _, k := range keys {
+			s.AppendInt32(int32(k))
+			s.AppendInt64(slots[k])
+		}
+		migrating := values[1].(map[int]int)
+		s.AppendInt32(int32(len(migrating)))
+		for k, v := range migrating {
+			s.AppendInt32(int32(k))
+			s.AppendInt32(int32(v))
+		}
+	case proposal_members:
+		s.AppendByte(byte(tt))
+		//节点id -> raft地址
+		peers := values[0].(map[int]string)
+		keys := make([]int, 0, len(peers))
+		for k := range peers {
+			keys = append(keys, k)
+		}
+		sort.Ints(keys)
+		s.AppendInt32(int32(len(keys)))
+		for _, k := range keys {
+			s.AppendInt32(int32(k))
+			s.AppendInt32(int32(len(peers[k])))
+			s.AppendString(peers[k])
+		}
+		removed := values[1].([]uint64)
+		s.AppendInt32(int32(len(removed)))
+		for _, v := range removed {
+			s.AppendInt64(int64(v))
+		}
+	case proposal_snapshot, proposal_update, proposal_kick:
+		//带过期时间的kv在类型上设置proposal_flag_expire,没有过期时间时与旧格式一致
+		var expire int64
+		if tt != proposal_kick {
+			expire = values[3].(int64)
+		}
+		if expire > 0 {
+			s.AppendByte(byte(tt | flag | proposal_flag_expire))
+		} else {
+			s.AppendByte(byte(tt | flag))
+		}
 		unikey := values[0].(string)
 		s.AppendInt32(int32(len(unikey)))
 		s.AppendString(unikey)
 		if tt != proposal_kick {
 			version := values[1].(int64)
 			s.AppendInt64(version)
+			if expire > 0 {
+				s.AppendInt64(expire)
+			}
 			//fields数量
 			pos := s.Len()
 			s.AppendInt32(int32(0))
-			if len(values) == 3 && nil != values[2] {
+			if nil != values[2] {
 				fields := values[2].(map[string]*proto.Field)
 				c := int32(0)
 				for n, v := range fields {
@@ -53,8 +131,21 @@ func readProposal(s *str.Str, offset int) (*proposal, int) {
 		return nil, 0
 	}
 
+	hasExpire := int(tt)&proposal_flag_expire != 0
+	load := int(tt)&proposal_flag_load != 0
+	tt = byte(int(tt) &^ (proposal_flag_expire | proposal_flag_load))
+
 	p := &proposal{
-		tt: int(tt),
+		tt:   int(tt),
+		load: load,
+	}
+
+	if hasExpire && int(tt) != proposal_snapshot && int(tt) != proposal_update {
+		return nil, 0
+	}
+
+	if load && int(tt) != proposal_snapshot {
+		return nil, 0
 	}
 
 	switch int(tt) {
@@ -74,6 +165,160 @@ func readProposal(s *str.Str, offset int) (*proposal, int) {
 
 		p.values = append(p.values, uint64(i64))
 
+		return p, offset
+	case proposal_meta:
+		var version int64
+		version, offset, err = s.ReadInt64(offset)
+		if nil != err {
+			return nil, 0
+		}
+		p.values = append(p.values, version)
+
+		var count int32
+		count, offset, err = s.ReadInt32(offset)
+		if nil != err {
+			return nil, 0
+		}
+
+		def := make([]string, 0, int(count))
+		for i := 0; i < int(count); i++ {
+			var l int32
+			l, offset, err = s.ReadInt32(offset)
+			if nil != err {
+				return nil, 0
+			}
+			var v string
+			v, offset, err = s.ReadString(offset, int(l))
+			if nil != err {
+				return nil, 0
+			}
+			def = append(def, v)
+		}
+		p.values = append(p.values, def)
+
+		return p, offset
+	case proposal_slot:
+		for i := 0; i < 3; i++ {
+			var v int32
+			v, offset, err = s.ReadInt32(offset)
+			if nil != err {
+				return nil, 0
+			}
+			p.values = append(p.values, int(v))
+		}
+
+		var epoch int64
+		epoch, offset, err = s.ReadInt64(offset)
+		if nil != err {
+			return nil, 0
+		}
+		p.values = append(p.values, epoch)
+
+		var count int32
+		count, offset, err = s.ReadInt32(offset)
+		if nil != err {
+			return nil, 0
+		}
+
+		kvs := make([]*proposal, 0, int(count))
+		for i := 0; i < int(count); i++ {
+			var kv *proposal
+			kv, offset = readProposal(s, offset)
+			if nil == kv || kv.tt != proposal_snapshot {
+				return nil, 0
+			}
+			kvs = append(kvs, kv)
+		}
+		p.values = append(p.values, kvs)
+
+		return p, offset
+	case proposal_slots:
+		var count int32
+		count, offset, err = s.ReadInt32(offset)
+		if nil != err {
+			return nil, 0
+		}
+
+		slots := map[int]int64{}
+		for i := 0; i < int(count); i++ {
+			var slot int32
+			var epoch int64
+			slot, offset, err = s.ReadInt32(offset)
+			if nil != err {
+				return nil, 0
+			}
+			epoch, offset, err = s.ReadInt64(offset)
+			if nil != err {
+				return nil, 0
+			}
+			slots[int(slot)] = epoch
+		}
+		p.values = append(p.values, slots)
+
+		count, offset, err = s.ReadInt32(offset)
+		if nil != err {
+			return nil, 0
+		}
+
+		migrating := map[int]int{}
+		for i := 0; i < int(count); i++ {
+			var slot, region int32
+			slot, offset, err = s.ReadInt32(offset)
+			if nil != err {
+				return nil, 0
+			}
+			region, offset, err = s.ReadInt32(offset)
+			if nil != err {
+				return nil, 0
+			}
+			migrating[int(slot)] = int(region)
+		}
+		p.values = append(p.values, migrating)
+
+		return p, offset
+	case proposal_members:
+		var count int32
+		count, offset, err = s.ReadInt32(offset)
+		if nil != err {
+			return nil, 0
+		}
+
+		peers := map[int]string{}
+		for i := 0; i < int(count); i++ {
+			var node, l int32
+			node, offset, err = s.ReadInt32(offset)
+			if nil != err {
+				return nil, 0
+			}
+			l, offset, err = s.ReadInt32(offset)
+			if nil != err {
+				return nil, 0
+			}
+			var url string
+			url, offset, err = s.ReadString(offset, int(l))
+			if nil != err {
+				return nil, 0
+			}
+			peers[int(node)] = url
+		}
+		p.values = append(p.values, peers)
+
+		count, offset, err = s.ReadInt32(offset)
+		if nil != err {
+			return nil, 0
+		}
+
+		removed := make([]uint64, 0, int(count))
+		for i := 0; i < int(count); i++ {
+			var id int64
+			id, offset, err = s.ReadInt64(offset)
+			if nil != err {
+				return nil, 0
+			}
+			removed = append(removed, uint64(id))
+		}
+		p.values = append(p.values, removed)
+
 		return p, offset
 	case proposal_snapshot, proposal_update, proposal_kick:
 		var unikeyLen int32
@@ -96,6 +341,15 @@ func readProposal(s *str.Str, offset int) (*proposal, int) {
 				return nil, 0
 			}
 			p.values = append(p.values, version)
+			//旧格式没有过期时间
+			var expire int64
+			if hasExpire {
+				expire, offset, err = s.ReadInt64(offset)
+				if nil != err {
+					return nil, 0
+				}
+			}
+			p.values = append(p.values, expire)
 			var fieldCount int32
 			fieldCount, offset, err = s.ReadInt32(offset)
 			if nil != err {
@@ -122,7 +376,7 @@ func readProposal(s *str.Str, offset int) (*proposal, int) {
 
 }
 
-func checkAsynCmdTask(task asynCmdTaskI) bool {
+func checkAsynCmdTask(task asynCmdTaskI) int32 {
 	kv := task.getKV()
 	kv.Lock()
 	defer kv.Unlock()
@@ -138,22 +392,22 @@ func checkAsynCmdTask(task asynCmdTaskI) bool {
 		} else if taskSqlFlag == sql_delete {
 			sqlFlag = sql_delete
 		} else {
-			return false
+			return errcode.ERR_OTHER
 		}
 	case sql_delete:
 		if taskSqlFlag == sql_insert_update {
 			sqlFlag = taskSqlFlag
 		} else {
-			return false
+			return errcode.ERR_OTHER
 		}
 	case sql_update:
 		if taskSqlFlag == sql_update || taskSqlFlag == sql_delete {
 			sqlFlag = taskSqlFlag
 		} else {
-			return false
+			return errcode.ERR_OTHER
 		}
 	default:
-		return false
+		return errcode.ERR_OTHER
 	}
 
 	switch sqlFlag {
@@ -168,7 +422,12 @@ func checkAsynCmdTask(task asynCmdTaskI) bool {
 		}
 	}
 
-	return true
+	if !task.calcExpire() {
+		//带过期时间的kv数量超过限制
+		return errcode.ERR_BUSY
+	}
+
+	return errcode.ERR_OK
 }
 
 //发起更新请求
@@ -180,8 +439,8 @@ func (this *kvstore) issueUpdate(task asynCmdTaskI) {
 
 	//logger.Debugln("issueUpdate")
 
-	if !checkAsynCmdTask(task) {
-		task.onError(errcode.ERR_OTHER)
+	if errCode := checkAsynCmdTask(task); errcode.ERR_OK != errCode {
+		task.onError(errCode)
 		return
 	}
 
@@ -190,9 +449,9 @@ func (this *kvstore) issueUpdate(task asynCmdTaskI) {
 	}
 }
 
-//请求向所有副本中新增kv
+//请求向所有副本中新增从数据库加载的kv,kv没有变更
 func (this *kvstore) issueAddkv(task asynCmdTaskI) {
-	task.setProposalType(proposal_snapshot)
+	task.setProposalType(proposal_snapshot | proposal_flag_load)
 	if err := this.proposeC.AddNoWait(task); nil != err {
 		task.onError(errcode.ERR_SERVER_STOPED)
 	}
@@ -62,6 +62,8 @@ type raftNode struct {
 	id int // client ID for raft session
 	//peers     []string // raft peer URLs
 	peers     map[int]string
+	removed   map[uint64]bool //被删除的raft id,受muMember保护
+	muMember  sync.Mutex
 	join      bool   // node is joining an existing cluster
 	waldir    string // path to WAL directory
 	snapdir   string // path to snapshot directory
@@ -76,7 +78,7 @@ type raftNode struct {
 	raftStorage *raft.MemoryStorage
 	wal         *wal.WAL
 
-	getSnapshot func() [][]*kvsnap
+	getSnapshot func() [][]snapItem
 
 	snapshotter      *snap.Snapshotter
 	snapshotterReady chan *snap.Snapshotter // signals when snapshotter is ready
@@ -111,6 +113,18 @@ type raftNode struct {
 	readIndex int64
 	lease     *lease
 
+	readLeaseTerm   uint64    //读租约对应的term,受muLeader保护
+	readLeaseExpire time.Time //读租约到期时间
+	transferedTerm  uint64    //在该term中已经转移leader,不再续约
+
+	transportStarted bool //transport已经添加peers中的节点,受muMember保护
+
+	transferring int32 //正在转移leader
+	sqlInflight  int32 //正在执行的回写数量
+
+	leaderContact int64  //最近一次收到leader消息的时间(unix纳秒)
+	leaderCommit  uint64 //从leader消息中得知的提交位置
+
 	term    uint64
 	kvstore *kvstore
 
@@ -118,6 +132,11 @@ type raftNode struct {
 	snapshotCompressor net.CompressorI
 }
 
+const (
+	tickInterval = 100 * time.Millisecond
+	electionTick = 10
+)
+
 var defaultSnapshotCount uint64 = 3000
 var snapshotCatchUpEntriesN uint64 = 3000
 
@@ -127,7 +146,7 @@ var snapshotCatchUpEntriesN uint64 = 3000
 // commit channel, followed by a nil message (to indicate the channel is
 // current), then new log entries. To shutdown, close proposeC and read errorC.
 func newRaftNode(mutilRaft *mutilRaft, id int, peers map[int]string, join bool, proposeC *util.BlockQueue,
-	confChangeC <-chan *asynTaskConfChange, readC *util.BlockQueue, getSnapshot func() [][]*kvsnap) (*raftNode, <-chan interface{}, <-chan error, <-chan *snap.Snapshotter) {
+	confChangeC <-chan *asynTaskConfChange, readC *util.BlockQueue, getSnapshot func() [][]snapItem) (*raftNode, <-chan interface{}, <-chan error, <-chan *snap.Snapshotter) {
 
 	/*
 	 *  如果commitC设置成无缓冲，则raftNode会等待上层提取commitedEntry之后才继续后续处理。
@@ -146,7 +165,8 @@ func newRaftNode(mutilRaft *mutilRaft, id int, peers map[int]string, join bool,
 		commitC:           commitC,
 		errorC:            errorC,
 		id:                id,
-		peers:             peers, //map[int]string{},
+		peers:             map[int]string{},
+		removed:           map[uint64]bool{},
 		join:              join,
 		waldir:            fmt.Sprintf("kv-%d-%d", nodeID, region),
 		snapdir:           fmt.Sprintf("kv-%d-%d-snap", nodeID, region),
@@ -173,6 +193,11 @@ func newRaftNode(mutilRaft *mutilRaft, id int, peers map[int]string, join bool,
 		// rest of structure populated after WAL replay
 	}
 
+	//所有store共享启动参数中的peers,复制一份
+	for k, v := range peers {
+		rc.peers[k] = v
+	}
+
 	rc.startProposePipeline()
 	rc.startReadPipeline()
 	go rc.startRaft()
@@ -186,6 +211,49 @@ func (rc *raftNode) isLeader() bool {
 	return rc.leader == rc.id
 }
 
+/*
+ * follower读取时检查已应用状态的延迟:
+ * 最近一次收到leader的心跳或日志在选举超时之内(与leader隔离的follower不能判断延迟)，
+ * 且从leader得知的提交位置与appliedIndex之差不超过maxLag(0表示不限制)
+ */
+func (rc *raftNode) checkReadLag(appliedIndex uint64, maxLag int64) bool {
+	contact := atomic.LoadInt64(&rc.leaderContact)
+	if 0 == contact || time.Now().UnixNano()-contact > int64(electionTick*tickInterval) {
+		return false
+	}
+	return maxLag <= 0 || atomic.LoadUint64(&rc.leaderCommit) <= appliedIndex+uint64(maxLag)
+}
+
+//记录收到leader消息的时间及leader的提交位置
+func (rc *raftNode) onLeaderMessage(m raftpb.Message) {
+	switch m.Type {
+	case raftpb.MsgApp, raftpb.MsgHeartbeat, raftpb.MsgSnap:
+	default:
+		return
+	}
+
+	if m.Term < rc.getTerm() {
+		//已经被取代的leader
+		return
+	}
+
+	for {
+		commit := atomic.LoadUint64(&rc.leaderCommit)
+		if m.Commit <= commit || atomic.CompareAndSwapUint64(&rc.leaderCommit, commit, m.Commit) {
+			break
+		}
+	}
+
+	atomic.StoreInt64(&rc.leaderContact, time.Now().UnixNano())
+}
+
+//leader所在节点id,0表示未知
+func (rc *raftNode) getLeaderNode() int {
+	rc.muLeader.Lock()
+	defer rc.muLeader.Unlock()
+	return rc.leader >> 16
+}
+
 func (rc *raftNode) getTerm() uint64 {
 	rc.muLeader.Lock()
 	defer rc.muLeader.Unlock()
@@ -331,7 +399,8 @@ func (rc *raftNode) publishEntries(ents []raftpb.Entry) bool {
 
 			index := int64(binary.BigEndian.Uint64(ents[i].Data[0:8]))
 			committedEntry := &commitedBatchProposal{
-				data: ents[i].Data[8:],
+				data:  ents[i].Data[8:],
+				index: ents[i].Index,
 			}
 			rc.muPendingPropose.Lock()
 			front := rc.pendingPropose.Front()
@@ -363,12 +432,19 @@ func (rc *raftNode) publishEntries(ents []raftpb.Entry) bool {
 				if rc.isLeader() {
 					rc.muPendingConfChange.Lock()
 					e := rc.pendingConfChange.Front()
-					rc.pendingConfChange.Remove(e)
+					if nil != e {
+						//变更可能由之前的leader发起
+						rc.pendingConfChange.Remove(e)
+					}
 					rc.muPendingConfChange.Unlock()
-					e.Value.(*asynTaskConfChange).done()
+					if nil != e {
+						e.Value.(*asynTaskConfChange).done()
+					}
 				}
 			}
 
+			rc.onMemberChange(cc.Type, cc.NodeID, url)
+
 			switch cc.Type {
 			case raftpb.ConfChangeAddNode:
 				if url != "" {
@@ -492,21 +568,27 @@ func (rc *raftNode) startRaft() {
 	oldwal := wal.Exist(rc.waldir)
 	rc.wal = rc.replayWAL()
 
+	rc.loadMembers()
+
 	rpeers := []raft.Peer{}
 
+	rc.muMember.Lock()
 	for k, _ := range rc.peers {
 		id := k<<16 + rc.region
 		rpeers = append(rpeers, raft.Peer{ID: uint64(id)})
 	}
+	rc.muMember.Unlock()
 
 	c := &raft.Config{
 		ID:                        uint64(rc.id),
-		ElectionTick:              10,
+		ElectionTick:              electionTick,
 		HeartbeatTick:             1,
 		Storage:                   rc.raftStorage,
 		MaxSizePerMsg:             math.MaxUint64, //1024 * 1024,
 		MaxInflightMsgs:           256,
 		MaxUncommittedEntriesSize: 1 << 30,
+		//读租约依赖follower在选举超时内拒绝投票
+		CheckQuorum: conf.GetConfig().LeaseRead,
 	}
 
 	if oldwal {
@@ -532,6 +614,8 @@ func (rc *raftNode) startRaft() {
 	rc.mutilRaft.addTransport(types.ID(rc.id), rc.transport)
 	rc.transport.Start()
 
+	//之后从快照恢复的成员直接添加到transport
+	rc.muMember.Lock()
 	for k, v := range rc.peers {
 		id := k<<16 + rc.region
 		if id != rc.id {
@@ -539,6 +623,8 @@ func (rc *raftNode) startRaft() {
 			rc.transport.AddPeer(types.ID(id), []string{v})
 		}
 	}
+	rc.transportStarted = true
+	rc.muMember.Unlock()
 
 	rc.readIndexTimer = timer.Repeat(time.Second, nil, rc.processTimeoutReadReq, nil)
 
@@ -673,6 +759,11 @@ func (rc *raftNode) maybeTriggerSnapshot() {
 func (rc *raftNode) onLoseLeadership() {
 
 	rc.lease.stop()
+
+	if nil != rc.kvstore {
+		//关注只在leader上有效
+		rc.kvstore.kvNode.watchMgr.invalidate(rc.kvstore, -1, errcode.ERR_NOT_LEADER)
+	}
 	rc.muPendingPropose.Lock()
 	pendingPropose := rc.pendingPropose
 	rc.pendingPropose = list.New()
@@ -712,6 +803,9 @@ func (rc *raftNode) issueRead(tasks *fixedarray.FixedArray) {
 		readIndex: atomic.AddInt64(&rc.readIndex, 1),
 		deadline:  time.Now().Add(time.Second * 5),
 		tasks:     tasks,
+		rn:        rc,
+		term:      rc.getTerm(),
+		sendTime:  time.Now(),
 	}
 
 	binary.BigEndian.PutUint64(ctxToSend, uint64(c.readIndex))
@@ -1031,7 +1125,7 @@ func (rc *raftNode) serveChannels() {
 
 	defer rc.wal.Close()
 
-	ticker := time.NewTicker(100 * time.Millisecond)
+	ticker := time.NewTicker(tickInterval)
 	defer ticker.Stop()
 
 	// send proposals over raft
@@ -1067,6 +1161,7 @@ func (rc *raftNode) serveChannels() {
 				oldLeader := rc.leader
 				rc.leader = int(rd.SoftState.Lead)
 				rc.term = rd.HardState.Term
+				rc.readLeaseExpire = time.Time{}
 				rc.muLeader.Unlock()
 
 				if oldLeader == rc.id && rc.leader != rc.id {
@@ -1133,10 +1228,19 @@ func (rc *raftNode) hasLease() bool {
 }
 
 func (rc *raftNode) Process(ctx context.Context, m raftpb.Message) error {
-	return rc.node.Step(ctx, m)
+	err := rc.node.Step(ctx, m)
+	if nil == err {
+		rc.onLeaderMessage(m)
+	}
+	return err
+}
+
+func (rc *raftNode) IsIDRemoved(id uint64) bool {
+	rc.muMember.Lock()
+	defer rc.muMember.Unlock()
+	return rc.removed[id]
 }
 
-func (rc *raftNode) IsIDRemoved(id uint64) bool { return false }
 func (rc *raftNode) ReportUnreachable(id uint64) {
 	rc.node.ReportUnreachable(id)
 }
@@ -1,343 +1,444 @@
 package kvnode
 
-/*
 import (
-	"database/sql"
 	"fmt"
-	pb "github.com/golang/protobuf/proto"
 	"github.com/jmoiron/sqlx"
-	codec "github.com/sniperHW/flyfish/codec"
-	"github.com/sniperHW/flyfish/conf"
+	"github.com/sniperHW/flyfish/dbmeta"
 	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
 	"github.com/sniperHW/flyfish/proto"
-	"github.com/sniperHW/kendynet"
+	"github.com/sniperHW/kendynet/util"
+	"sort"
 	"strings"
-	"sync/atomic"
 	"time"
 )
 
-type scaner struct {
-	db             *sqlx.DB
-	meta           *table_meta
-	session        kendynet.StreamSession
-	closed         int32
-	table          string
-	fields         []string
-	field_receiver []interface{}
-	field_convter  []func(interface{}) interface{}
-	rows           *sql.Rows
-	node           *KVNode
-	index          int64
+/*
+ * scan不保存服务端状态，客户端每次携带上次返回的cursor(最后一个__key__)继续遍历。
+ * 结果以数据库为基础，合并本节点缓存中的kv(包括尚未回写到数据库的修改)，保证不会读到过期数据。
+ * 尚未回写的修改只在leader上是最新的，本节点需要是所有store的leader(waitReadIndex),否则返回ERR_NOT_LEADER。
+ * 与缓存合并时按字节序比较__key__,所以__key__列需要使用字节序的排序规则(pgsql:COLLATE "C",mysql:utf8mb4_bin或varbinary),
+ * 查询直接比较__key__列以使用主键索引。表格第一次scan时检查__key__列的排序规则，不是字节序时返回ERR_INVAILD_TABLE,
+ * 迁移方法见README。数据库返回的顺序仍然不是字节序时scan返回ERR_SQLERROR。
+ */
+
+const (
+	defaultScanCount = 50
+	maxScanCount     = 1000
+)
+
+const scanTemplate string = "SELECT %s FROM %s where __key__ > ? order by __key__ limit %d;"
+
+//__key__列的数据类型及排序规则
+const (
+	mysqlKeyCollation = "SELECT DATA_TYPE,COALESCE(COLLATION_NAME,'') FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = '__key__';"
+	pgsqlKeyCollation = "SELECT c.data_type::text,COALESCE(c.collation_name::text,d.datcollate::text) FROM information_schema.columns c,pg_database d WHERE d.datname = current_database() AND c.table_schema = current_schema() AND c.table_name = ? AND c.column_name = '__key__';"
+)
+
+type scanRow struct {
+	version int64
+	fields  map[string]*proto.Field
+	missing bool //缓存中记录已删除
 }
 
-func (this *scaner) close() {
-	Infoln("scaner close")
-	if atomic.CompareAndSwapInt32(&this.closed, 0, 1) {
+type cmdScan struct {
+	replyer  *replyer
+	meta     *dbmeta.TableMeta
+	fields   []string
+	count    int
+	cursor   string
+	deadline time.Time
+	resp     *proto.ScanResp
+}
 
-		Infoln("scaner close ok")
+func (this *cmdScan) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	pbdata := this.resp
+	if errcode.ERR_OK != errCode || nil == pbdata {
+		pbdata = &proto.ScanResp{}
+	}
+	return net.NewMessage(net.CommonHead{
+		Seqno:   this.replyer.seqno,
+		ErrCode: errCode,
+	}, pbdata)
+}
 
-		if nil != this.rows {
-			this.rows.Close()
-		}
+func (this *cmdScan) reply(errCode int32) {
+	this.replyer.reply(this, errCode, nil, 0)
+}
 
-		if nil != this.db {
-			this.db.Close()
+func (this *cmdScan) packRow(key string, row *scanRow) *proto.Row {
+	r := &proto.Row{
+		Key:     key,
+		Version: row.version,
+	}
+	for _, name := range this.fields {
+		v := row.fields[name]
+		if nil != v {
+			r.Fields = append(r.Fields, v)
+		} else if vv := this.meta.GetDefaultV(name); nil != vv {
+			r.Fields = append(r.Fields, proto.PackField(name, vv))
 		}
+	}
+	return r
+}
 
+type sqlScaner struct {
+	db       *sqlx.DB
+	sqlType  string
+	queue    *util.BlockQueue
+	storeMgr *storeMgr
+	ordered  map[string]bool //__key__列按字节序排序的表格
+}
+
+func newSqlScaner(db *sqlx.DB, sqlType string, name string) *sqlScaner {
+	return &sqlScaner{
+		db:      db,
+		sqlType: sqlType,
+		queue:   util.NewBlockQueueWithName(name),
+		ordered: map[string]bool{},
 	}
 }
 
-const selectTemplate string = "select %s from %s order by __key__;"
+//检查__key__列是否按字节序排序,只缓存检查通过的结果，修改排序规则后不需要重启
+func (this *sqlScaner) checkKeyOrder(table string) (bool, error) {
+	if this.ordered[table] {
+		return true, nil
+	}
 
-func (this *scaner) next(req *proto.ScanReq) {
-	head := req.GetHead()
+	s := pgsqlKeyCollation
+	if this.sqlType == "mysql" {
+		s = mysqlKeyCollation
+	}
 
-	resp := &proto.ScanResp{
-		Head: &proto.RespCommon{
-			Seqno:   pb.Int64(head.GetSeqno()),
-			ErrCode: pb.Int32(errcode.ERR_OK),
-		},
+	r, err := this.db.Query(this.db.Rebind(s), table)
+	if nil != err {
+		return false, err
 	}
 
-	if this.server.isStoped() {
-		resp.Head.ErrCode = pb.Int32(errcode.ERR_SERVER_STOPED)
-		this.session.Send(resp)
-		this.session.Close("", 1)
-		return
+	defer r.Close()
+
+	if !r.Next() {
+		if err = r.Err(); nil != err {
+			return false, err
+		}
+		//不在当前schema中,由查询结果的顺序检查
+		logger.Infoln("scan", table, "__key__ collation not found")
+		this.ordered[table] = true
+		return true, nil
 	}
 
-	deadline := time.Now().Add(time.Duration(head.GetRespTimeout()))
+	var dataType, collation string
+	if err = r.Scan(&dataType, &collation); nil != err {
+		return false, err
+	}
 
-	count := req.GetCount()
+	ordered := false
+	if this.sqlType == "mysql" {
+		dataType = strings.ToLower(dataType)
+		ordered = strings.HasSuffix(strings.ToLower(collation), "_bin") || strings.Contains(dataType, "binary") || strings.Contains(dataType, "blob")
+	} else {
+		switch collation {
+		case "C", "POSIX", "ucs_basic", "pg_c_utf8":
+			ordered = true
+		}
+	}
 
-	if 0 == count {
-		count = 50
+	if ordered {
+		this.ordered[table] = true
+	} else {
+		logger.Errorln("scan", table, "__key__ is not in byte order, data type:", dataType, "collation:", collation)
 	}
 
-	if nil == this.rows {
-		selectStr := fmt.Sprintf(selectTemplate, strings.Join(this.fields, ","), this.table)
+	return ordered, nil
+}
+
+//从数据库读取(cursor,...]范围内的count条记录
+func (this *sqlScaner) query(cmd *cmdScan, keys *[]string, rows map[string]*scanRow) error {
+	queryMeta := cmd.meta.GetQueryMeta()
+	fieldNames := queryMeta.GetFieldNames()
 
-		rows, err := this.db.Query(selectStr)
+	s := fmt.Sprintf(scanTemplate, strings.Join(fieldNames, ","), cmd.meta.GetTable(), cmd.count)
 
-		if nil != err {
-			Errorln(selectStr, err)
-			resp.Head.ErrCode = pb.Int32(errcode.ERR_SQLERROR)
-			this.session.Send(resp)
-			this.session.Close("", 1)
-			return
-		}
+	r, err := this.db.Query(this.db.Rebind(s), cmd.cursor)
+	if nil != err {
+		logger.Errorln("scan exec error:", s, err)
+		return err
+	}
 
-		this.rows = rows
+	defer r.Close()
 
-		if time.Now().After(deadline) {
-			//已经超时
-			return
+	receiver := queryMeta.GetReceivers()
+	defer queryMeta.PutReceivers(receiver)
+	convter := queryMeta.GetFieldConvter()
+
+	for r.Next() {
+		if err := r.Scan(receiver...); nil != err {
+			logger.Errorln("scan rows.Scan err", err)
+			return err
 		}
-	}
 
-	for this.rows.Next() {
-		err := this.rows.Scan(this.field_receiver...)
-		if err != nil {
-			resp.Head.ErrCode = pb.Int32(errcode.ERR_SQLERROR)
-			this.session.Send(resp)
-			this.session.Close("scan error", 1)
-			Errorln("rows.Scan err", err)
-			return
+		row := &scanRow{
+			fields: map[string]*proto.Field{},
 		}
 
-		if nil == resp.Rows {
-			resp.Rows = []*proto.Row{}
+		key := convter[0](receiver[0]).(string)
+
+		if n := len(*keys); (n > 0 && key <= (*keys)[n-1]) || key <= cmd.cursor {
+			err := fmt.Errorf("table %s __key__ is not in byte order", cmd.meta.GetTable())
+			logger.Errorln("scan error:", err)
+			return err
 		}
 
-		var (
-			key     string
-			version int64
-		)
-
-		fields := []*proto.Field{}
-		for i := 0; i < len(this.fields); i++ {
-			if this.fields[i] == "__key__" {
-				key = this.field_convter[i](this.field_receiver[i]).(string)
-			} else if this.fields[i] == "__version__" {
-				version = this.field_convter[i](this.field_receiver[i]).(int64)
+		for i := 1; i < len(receiver); i++ {
+			name := fieldNames[i]
+			if name == "__version__" {
+				row.version = convter[i](receiver[i]).(int64)
 			} else {
-				fields = append(fields, proto.PackField(this.fields[i], this.field_convter[i](this.field_receiver[i])))
+				row.fields[name] = proto.PackField(name, convter[i](receiver[i]))
 			}
 		}
 
-		resp.Rows = append(resp.Rows, &proto.Row{
-			Key:     pb.String(key),
-			Version: pb.Int64(version),
-			Fields:  fields,
-		})
+		*keys = append(*keys, key)
+		rows[key] = row
+	}
 
-		count--
+	return r.Err()
+}
 
-		if 0 == count {
-			resp.Head.ErrCode = pb.Int32(errcode.ERR_OK)
-			break
+/*
+ * 用缓存中的kv覆盖数据库结果。
+ * 调用前已经确认本节点是所有store的leader,缓存中的kv总是不旧于数据库(尚未回写的修改只存在于缓存)。
+ * finish为false时只合并(cursor,upper]范围内的key,超出范围的由后续请求处理
+ * 通过store中按表有序的key只访问范围内的kv
+ */
+func (this *sqlScaner) mergeCache(cmd *cmdScan, upper string, finish bool, keys *[]string, rows map[string]*scanRow) {
+	table := cmd.meta.GetTable()
+	this.storeMgr.RLock()
+	defer this.storeMgr.RUnlock()
+	for _, store := range this.storeMgr.stores {
+		store.Lock()
+		for _, v := range store.keys.between(table, cmd.cursor, upper, finish) {
+			v.Lock()
+			status := v.getReadStatus()
+			if status == cache_ok {
+				row := &scanRow{
+					version: v.version,
+					fields:  map[string]*proto.Field{},
+				}
+				for kk, vv := range v.fields {
+					row.fields[kk] = vv
+				}
+				if _, ok := rows[v.key]; !ok {
+					*keys = append(*keys, v.key)
+				}
+				rows[v.key] = row
+			} else if status == cache_missing {
+				if row, ok := rows[v.key]; ok {
+					row.missing = true
+				}
+			}
+			v.Unlock()
 		}
+		store.Unlock()
 	}
+}
 
-	if time.Now().After(deadline) {
-		//已经超时
+func (this *sqlScaner) exec(cmd *cmdScan) {
+
+	if cmd.replyer.isCancel() || time.Now().After(cmd.deadline) {
+		cmd.replyer.dontReply()
 		return
 	}
 
-	this.session.Send(resp)
+	if ordered, err := this.checkKeyOrder(cmd.meta.GetTable()); nil != err {
+		logger.Errorln("scan check key order error:", err)
+		cmd.reply(errcode.ERR_SQLERROR)
+		return
+	} else if !ordered {
+		cmd.reply(errcode.ERR_INVAILD_TABLE)
+		return
+	}
 
-	if resp.Head.GetErrCode() != errcode.ERR_OK {
-		this.session.Close("scan finish", 1)
+	//数据库查询之前确认，之后合并的缓存不旧于查询时已经返回的写入
+	if errno := this.storeMgr.waitReadIndex(cmd.deadline); errcode.ERR_OK != errno {
+		cmd.reply(errno)
+		return
 	}
 
-}
-*/
+	keys := []string{}
+	rows := map[string]*scanRow{}
 
-/*
-func scan(server *Server, session kendynet.StreamSession, msg *codec.Message) {
+	if err := this.query(cmd, &keys, rows); nil != err {
+		cmd.reply(errcode.ERR_SQLERROR)
+		return
+	}
 
-	u := session.GetUserData()
+	finish := len(keys) < cmd.count
+	upper := ""
+	if !finish {
+		upper = keys[len(keys)-1]
+	}
 
-	req := msg.GetData().(*proto.ScanReq)
+	this.mergeCache(cmd, upper, finish, &keys, rows)
 
-	Debugln("scan")
+	sort.Strings(keys)
 
-	var s *scaner
+	resp := &proto.ScanResp{
+		Finish: finish,
+		Cursor: upper,
+	}
 
-	if u == nil {
+	for _, k := range keys {
+		if row := rows[k]; !row.missing {
+			resp.Rows = append(resp.Rows, cmd.packRow(k, row))
+		}
+	}
 
-		req := msg.GetData().(*proto.ScanReq)
+	cmd.resp = resp
+	cmd.reply(errcode.ERR_OK)
+}
 
-		head := req.GetHead()
+//按索引查询(cursor,...]范围内的count条记录
+func (this *sqlScaner) queryIndex(cmd *cmdGetByIndex, keys *[]string, rows map[string]int64) error {
+	s, value := cmd.sqlStr()
 
-		resp := &proto.ScanResp{
-			Head: &proto.RespCommon{
-				Seqno:   pb.Int64(head.GetSeqno()),
-				ErrCode: pb.Int32(errcode.ERR_OK),
-			},
-		}
+	r, err := this.db.Query(this.db.Rebind(s), value, cmd.cursor)
+	if nil != err {
+		logger.Errorln("queryIndex exec error:", s, err)
+		return err
+	}
 
-		if session.GetUserData() != nil {
-			session.Send(resp)
-			return
-		}
+	defer r.Close()
 
-		if "" == head.GetTable() {
-			resp.Head.ErrCode = pb.Int32(errcode.ERR_MISSING_TABLE)
-			session.Send(resp)
-			session.Close("", 1)
-			return
+	for r.Next() {
+		var key string
+		var version int64
+		if err := r.Scan(&key, &version); nil != err {
+			logger.Errorln("queryIndex rows.Scan err", err)
+			return err
 		}
+		*keys = append(*keys, key)
+		rows[key] = version
+	}
+
+	return r.Err()
+}
+
+func (this *sqlScaner) execIndex(cmd *cmdGetByIndex) {
 
-		meta := getMetaByTable(head.GetTable())
-		if nil == meta {
-			resp.Head.ErrCode = pb.Int32(errcode.ERR_INVAILD_TABLE)
-			session.Send(resp)
-			session.Close("", 1)
+	if cmd.isTimeout() {
+		cmd.replyer.dontReply()
+		return
+	}
+
+	//只存在于缓存中的表格只查询缓存
+	cacheOnly := cmd.meta.GetWriteMode() == dbmeta.WriteCacheOnly
+
+	if !cacheOnly {
+		if ordered, err := this.checkKeyOrder(cmd.meta.GetTable()); nil != err {
+			logger.Errorln("queryIndex check key order error:", err)
+			cmd.reply(errcode.ERR_SQLERROR)
+			return
+		} else if !ordered {
+			cmd.reply(errcode.ERR_INVAILD_TABLE)
 			return
 		}
+	}
 
-		if nil != req.GetFields() {
-			for _, name := range req.GetFields() {
-				_, ok := meta.fieldMetas[name]
-				if name == "__key__" || name == "__version__" || !ok {
-					resp.Head.ErrCode = pb.Int32(errcode.ERR_INVAILD_FIELD)
-					session.Send(resp)
-					session.Close("", 1)
-					return
-				}
-			}
-		}
+	if errno := this.storeMgr.waitReadIndex(cmd.deadline); errcode.ERR_OK != errno {
+		cmd.reply(errno)
+		return
+	}
 
-		s = &scaner{
-			table:  head.GetTable(),
-			server: server,
-		}
+	keys := []string{}
+	rows := map[string]int64{}
 
-		if req.GetAll() {
-			s.fields = meta.queryMeta.field_names
-			s.field_convter = meta.queryMeta.field_convter
-			s.field_receiver = []interface{}{}
-			for i := 0; i < len(s.fields); i++ {
-				s.field_receiver = append(s.field_receiver, meta.queryMeta.getReceiverByName(s.fields[i]))
-			}
-		} else {
-			s.fields = req.GetFields()
-			s.fields = append(s.fields, "__key__")
-			s.fields = append(s.fields, "__version__")
-			s.field_convter = []func(interface{}) interface{}{}
-			s.field_receiver = []interface{}{}
-			for i := 0; i < len(s.fields); i++ {
-				s.field_receiver = append(s.field_receiver, meta.queryMeta.getReceiverByName(s.fields[i]))
-				s.field_convter = append(s.field_convter, meta.queryMeta.getConvetorByName(s.fields[i]))
-			}
+	if !cacheOnly {
+		if err := this.queryIndex(cmd, &keys, rows); nil != err {
+			cmd.reply(errcode.ERR_SQLERROR)
+			return
 		}
+	}
 
-		var err error
-
-		dbConfig := conf.GetConfig().DBConfig
+	finish := len(keys) < cmd.count
+	upper := ""
+	if !finish {
+		upper = keys[len(keys)-1]
+	}
 
-		s.db, err = sqlOpen(dbConfig.SqlType, dbConfig.ConfDbHost, dbConfig.ConfDbPort, dbConfig.ConfDataBase, dbConfig.ConfDbUser, dbConfig.ConfDbPassword)
+	cmd.onQueryResult(upper, finish, rows)
+}
 
-		if nil != err {
-			resp.Head.ErrCode = pb.Int32(errcode.ERR_SQLERROR)
-			session.Send(resp)
-			session.Close("", 1)
+func (this *sqlScaner) run() {
+	for {
+		closed, localList := this.queue.Get()
+		for _, v := range localList {
+			switch v.(type) {
+			case *cmdScan:
+				this.exec(v.(*cmdScan))
+			case *cmdGetByIndex:
+				this.execIndex(v.(*cmdGetByIndex))
+			}
+		}
+		if closed {
 			return
 		}
+	}
+}
 
-		s.session = session
-		session.SetUserData(s)
+func scan(n *KVNode, cli *cliConn, msg *net.Message) {
 
-	} else {
-		s = u.(*scaner)
-	}
+	req := msg.GetData().(*proto.ScanReq)
 
-	s.next(req)
+	head := msg.GetHead()
 
-}*/
+	processDeadline, respDeadline := getDeadline(head.Timeout)
 
-/*
-const selectTemplate string = "select %s from %s order by __key__ limit %d offset %d;"
-func (this *scaner) next(req *proto.ScanReq) {
-	head := req.GetHead()
-	resp := &proto.ScanResp{
-		Head: &proto.RespCommon{
-			Seqno:   pb.Int64(head.GetSeqno()),
-			ErrCode: pb.Int32(errcode.ERR_OK),
-		},
-	}
-	if isStop() {
-		resp.Head.ErrCode = pb.Int32(errcode.ERR_SERVER_STOPED)
-		this.session.Send(resp)
-		this.session.Close("", 1)
-		return
+	cmd := &cmdScan{
+		replyer:  newReplyer(cli, head.Seqno, respDeadline),
+		count:    int(req.GetCount()),
+		cursor:   req.GetCursor(),
+		deadline: processDeadline,
 	}
-	deadline := time.Now().Add(time.Duration(head.GetTimeout()))
-	count := req.GetCount()
-	if 0 == count {
-		count = 50
+
+	if cmd.count <= 0 {
+		cmd.count = defaultScanCount
+	} else if cmd.count > maxScanCount {
+		cmd.count = maxScanCount
 	}
-	selectStr := fmt.Sprintf(selectTemplate, strings.Join(this.fields, ","), this.table, count, this.offset)
-	rows, err := this.db.Query(selectStr)
-	if time.Now().After(deadline) {
-		//已经超时
+
+	if "" == req.GetTable() {
+		cmd.reply(errcode.ERR_MISSING_TABLE)
 		return
 	}
-	Debugln("query ok")
-	if nil != err {
-		Errorln(selectStr, err)
-		resp.Head.ErrCode = pb.Int32(errcode.ERR_SQLERROR)
-		this.session.Send(resp)
-		this.session.Close("", 1)
+
+	cmd.meta = n.storeMgr.dbmeta.GetTableMeta(req.GetTable())
+
+	if nil == cmd.meta || cmd.meta.GetWriteMode() == dbmeta.WriteCacheOnly {
+		//只存在于缓存中的表格无法从数据库遍历
+		cmd.reply(errcode.ERR_INVAILD_TABLE)
 		return
 	}
-	defer rows.Close()
-	for rows.Next() {
-		err := rows.Scan(this.field_receiver...)
-		if err != nil {
-			resp.Head.ErrCode = pb.Int32(errcode.ERR_SQLERROR)
-			this.session.Send(resp)
-			this.session.Close("scan error", 1)
-			Errorln("rows.Scan err", err)
-			return
-		}
-		this.offset++
-		if nil == resp.Rows {
-			resp.Rows = []*proto.Row{}
-		}
-		var (
-			key     string
-			version int64
-		)
-		fields := []*proto.Field{}
-		for i := 0; i < len(this.fields); i++ {
-			if this.fields[i] == "__key__" {
-				key = this.field_convter[i](this.field_receiver[i]).(string)
-			} else if this.fields[i] == "__version__" {
-				version = this.field_convter[i](this.field_receiver[i]).(int64)
-			} else {
-				fields = append(fields, proto.PackField(this.fields[i], this.field_convter[i](this.field_receiver[i])))
+
+	if req.GetAll() {
+		for _, name := range cmd.meta.GetQueryMeta().GetFieldNames() {
+			if name != "__key__" && name != "__version__" {
+				cmd.fields = append(cmd.fields, name)
 			}
 		}
-		resp.Rows = append(resp.Rows, &proto.Row{
-			Key:     pb.String(key),
-			Version: pb.Int64(version),
-			Fields:  fields,
-		})
-		count--
-		if 0 == count {
-			resp.Head.ErrCode = pb.Int32(errcode.ERR_OK)
-			break
+	} else {
+		fields := map[string]*proto.Field{}
+		for _, name := range req.GetFields() {
+			fields[name] = proto.PackField(name, nil)
+			cmd.fields = append(cmd.fields, name)
+		}
+		if !cmd.meta.CheckGet(fields) {
+			cmd.reply(errcode.ERR_INVAILD_FIELD)
+			return
 		}
 	}
-	if time.Now().After(deadline) {
-		//已经超时
-		return
-	}
-	this.session.Send(resp)
-	if resp.Head.GetErrCode() != errcode.ERR_OK {
-		this.session.Close("scan finish", 1)
+
+	if err := n.sqlMgr.scaner.queue.AddNoWait(cmd); nil != err {
+		cmd.reply(errcode.ERR_SERVER_STOPED)
 	}
 }
-*/
@@ -0,0 +1,291 @@
+package kvnode
+
+import (
+	"context"
+	"fmt"
+	"github.com/sniperHW/flyfish/dbmeta"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/kendynet/util"
+	"github.com/yuin/gopher-lua"
+	"github.com/yuin/gopher-lua/parse"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+ * 服务端脚本
+ * 启动时编译Script.Dir下的所有.lua文件，文件名(不含扩展名)为脚本名，所有节点需要部署相同的脚本。
+ * 脚本通过全局变量record(exists,version,fields)读取记录，args为请求参数，
+ * 返回要写入的字段(nil表示不写入)及返回给客户端的结果。
+ * 每次执行使用独立的LState,只开放base,table,string,math库，并移除了加载代码及访问文件的函数。
+ * 脚本在scriptWorkerCount个工作goroutine中执行，不占用kv锁、apply及sqlLoader的goroutine。
+ *
+ * lua的number是float64,int字段绝对值不超过2^53时以number传入，超出时以十进制字符串传入以免丢失精度。
+ * 写入int字段时接受整数值的number(绝对值不超过2^53)或十进制字符串，带小数或超出范围的值返回错误。
+ */
+
+var defaultScriptTimeout = time.Millisecond * 100
+
+//float64能精确表示的最大整数
+const maxScriptInt = int64(1) << 53
+
+//沙箱中移除的函数
+var scriptForbidden = []string{"dofile", "loadfile", "load", "loadstring", "collectgarbage", "getfenv", "setfenv", "print"}
+
+const scriptWorkerCount = 4
+
+type scriptMgr struct {
+	scripts map[string]*lua.FunctionProto
+	timeout time.Duration
+	queue   *util.BlockQueue
+}
+
+func compileScript(name string, src string) (*lua.FunctionProto, error) {
+	chunk, err := parse.Parse(strings.NewReader(src), name)
+	if nil != err {
+		return nil, err
+	}
+	return lua.Compile(chunk, name)
+}
+
+func newScriptMgr(dir string, timeout int) (*scriptMgr, error) {
+	mgr := &scriptMgr{
+		scripts: map[string]*lua.FunctionProto{},
+		timeout: time.Duration(timeout) * time.Millisecond,
+		queue:   util.NewBlockQueueWithName("scriptMgr"),
+	}
+
+	if mgr.timeout <= 0 {
+		mgr.timeout = defaultScriptTimeout
+	}
+
+	for i := 0; i < scriptWorkerCount; i++ {
+		go mgr.work()
+	}
+
+	if "" == dir {
+		return mgr, nil
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, v := range files {
+		if v.IsDir() || filepath.Ext(v.Name()) != ".lua" {
+			continue
+		}
+
+		src, err := ioutil.ReadFile(filepath.Join(dir, v.Name()))
+		if nil != err {
+			return nil, err
+		}
+
+		name := strings.TrimSuffix(v.Name(), ".lua")
+
+		p, err := compileScript(name, string(src))
+		if nil != err {
+			return nil, fmt.Errorf("compile script %s error:%s", name, err.Error())
+		}
+
+		mgr.scripts[name] = p
+
+		logger.Infoln("load script", name)
+	}
+
+	return mgr, nil
+}
+
+func (this *scriptMgr) get(name string) *lua.FunctionProto {
+	return this.scripts[name]
+}
+
+func (this *scriptMgr) work() {
+	for {
+		closed, localList := this.queue.Get()
+		for _, v := range localList {
+			v.(*asynCmdTaskEval).runScript()
+		}
+		if closed {
+			return
+		}
+	}
+}
+
+//投递到工作goroutine执行
+func (this *scriptMgr) push(task *asynCmdTaskEval) {
+	if nil != this.queue.AddNoWait(task) {
+		task.reply(errcode.ERR_SERVER_STOPED)
+		task.getKV().processCmd(nil)
+	}
+}
+
+func newSandbox() *lua.LState {
+	L := lua.NewState(lua.Options{
+		SkipOpenLibs:    true,
+		CallStackSize:   128,
+		RegistryMaxSize: 1024 * 64,
+	})
+
+	for _, v := range []struct {
+		name string
+		f    lua.LGFunction
+	}{
+		{lua.BaseLibName, lua.OpenBase},
+		{lua.TabLibName, lua.OpenTable},
+		{lua.StringLibName, lua.OpenString},
+		{lua.MathLibName, lua.OpenMath},
+	} {
+		L.Push(L.NewFunction(v.f))
+		L.Push(lua.LString(v.name))
+		L.Call(1, 0)
+	}
+
+	for _, v := range scriptForbidden {
+		L.SetGlobal(v, lua.LNil)
+	}
+
+	return L
+}
+
+func field2LValue(v *proto.Field) lua.LValue {
+	switch v.GetType() {
+	case proto.ValueType_int:
+		i := v.GetInt()
+		if i > maxScriptInt || i < -maxScriptInt {
+			return lua.LString(strconv.FormatInt(i, 10))
+		}
+		return lua.LNumber(i)
+	case proto.ValueType_float:
+		return lua.LNumber(v.GetFloat())
+	case proto.ValueType_string:
+		return lua.LString(v.GetString())
+	case proto.ValueType_blob:
+		return lua.LString(string(v.GetBlob()))
+	default:
+		return lua.LNil
+	}
+}
+
+//按字段类型转换脚本写入的值
+func lvalue2Field(meta *dbmeta.TableMeta, name string, v lua.LValue) (*proto.Field, error) {
+	m, ok := meta.GetFieldMetas()[name]
+	if !ok {
+		return nil, fmt.Errorf("invaild field:%s", name)
+	}
+
+	switch m.GetType() {
+	case proto.ValueType_int:
+		switch v.(type) {
+		case lua.LNumber:
+			n := float64(v.(lua.LNumber))
+			if n != math.Trunc(n) || n > float64(maxScriptInt) || n < -float64(maxScriptInt) {
+				return nil, fmt.Errorf("invaild int value %s for field:%s", v.String(), name)
+			}
+			return proto.PackField(name, int64(n)), nil
+		case lua.LString:
+			i, err := strconv.ParseInt(string(v.(lua.LString)), 10, 64)
+			if nil != err {
+				return nil, fmt.Errorf("invaild int value %s for field:%s", v.String(), name)
+			}
+			return proto.PackField(name, i), nil
+		}
+	case proto.ValueType_float:
+		if n, ok := v.(lua.LNumber); ok {
+			return proto.PackField(name, float64(n)), nil
+		}
+	case proto.ValueType_string:
+		if s, ok := v.(lua.LString); ok {
+			return proto.PackField(name, string(s)), nil
+		}
+	case proto.ValueType_blob:
+		if s, ok := v.(lua.LString); ok {
+			return proto.PackField(name, []byte(s)), nil
+		}
+	}
+
+	return nil, fmt.Errorf("invaild value type for field:%s", name)
+}
+
+/*
+ * 执行脚本，返回要写入的字段及结果
+ * fields为记录当前的字段(记录不存在时为nil),表格新增加的列使用默认值
+ */
+func (this *scriptMgr) run(p *lua.FunctionProto, meta *dbmeta.TableMeta, exists bool, version int64, fields map[string]*proto.Field, args []string) (map[string]*proto.Field, string, error) {
+
+	L := newSandbox()
+	defer L.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), this.timeout)
+	defer cancel()
+	L.SetContext(ctx)
+
+	record := L.NewTable()
+	record.RawSetString("exists", lua.LBool(exists))
+	record.RawSetString("version", lua.LNumber(version))
+
+	f := L.NewTable()
+	if exists {
+		for name, m := range meta.GetFieldMetas() {
+			if v, ok := fields[name]; ok {
+				f.RawSetString(name, field2LValue(v))
+			} else {
+				f.RawSetString(name, field2LValue(proto.PackField(name, m.GetDefaultV())))
+			}
+		}
+	}
+	record.RawSetString("fields", f)
+
+	a := L.NewTable()
+	for _, v := range args {
+		a.Append(lua.LString(v))
+	}
+
+	L.SetGlobal("record", record)
+	L.SetGlobal("args", a)
+
+	L.Push(L.NewFunctionFromProto(p))
+	if err := L.PCall(0, 2, nil); nil != err {
+		return nil, "", err
+	}
+
+	ret := L.Get(-2)
+	result := lua.LVAsString(L.Get(-1))
+	L.Pop(2)
+
+	var writes map[string]*proto.Field
+
+	switch ret.(type) {
+	case *lua.LTable:
+		var err error
+		writes = map[string]*proto.Field{}
+		ret.(*lua.LTable).ForEach(func(k lua.LValue, v lua.LValue) {
+			if nil != err {
+				return
+			}
+			name, ok := k.(lua.LString)
+			if !ok {
+				err = fmt.Errorf("invaild field:%s", k.String())
+				return
+			}
+			var field *proto.Field
+			if field, err = lvalue2Field(meta, string(name), v); nil == err {
+				writes[string(name)] = field
+			}
+		})
+		if nil != err {
+			return nil, "", err
+		}
+	case *lua.LNilType:
+	default:
+		return nil, "", fmt.Errorf("script must return table or nil")
+	}
+
+	return writes, result, nil
+}
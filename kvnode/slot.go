@@ -0,0 +1,686 @@
+package kvnode
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/sniperHW/flyfish/conf"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/net/pb"
+	"github.com/sniperHW/flyfish/partition"
+	"github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/flyfish/util/str"
+	"github.com/sniperHW/kendynet"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ * slot
+ * key通过partition.Hash(uniKey) % SlotCount映射到slot,每个slot由一个region负责。
+ * 初始时slot % SlotRegions + 1的region负责该slot,SlotRegions整除SlotCount时与原来的StringHash % mask + 1一致。
+ * 节点的slot表(partition.Table)由各store apply的slot归属汇总而成，slot每迁入一次epoch加1。
+ * SlotCount与SlotRegions在首次启动时保存到kv-节点-slot,之后增加CacheGroupSize只会添加不负责任何slot的新region,
+ * 再通过MoveSlot把slot迁移过去。
+ *
+ * 每个region的slot归属作为raft日志及快照的一部分复制到所有副本。迁移slot的步骤(由源region leader执行):
+ * 1.提交slot_migrating,所有副本拒绝该slot的新命令(ERR_RETRY),源region不再回写该slot(获得租约时跳过)。
+ * 2.等待slot中所有kv的命令队列清空、已经生成的回写批次及写直达的回写完成，清除其余kv的回写标记，
+ *   尚未回写的变更随kv一起交给目标region,不需要等待源region回写。
+ * 3.目标region先提交slot_out清除上一次中断的迁移留下的kv,再分批提交slot_kvs(每批slotChunkSize个kv),
+ *   最后提交slot_in,apply后目标region负责该slot,目标leader对slot中的kv整体回写一次。
+ * 4.源region提交slot_out,删除slot中的kv。
+ * 源region与目标region的leader必须在同一节点，目标region的leader不在本节点时先请求其转移到本节点(通过kvpd获得节点地址)。
+ * 迁移期间源region与目标region拒绝TransferLeader(ERR_BUSY),避免kvpd均衡leader时打断迁移。
+ * 中途失败(例如leader切换)slot保持迁出状态，向新leader再次发起相同的MoveSlot继续迁移;
+ * 目标尚未迁入时以源region为目标发起可以撤销，撤销后源leader对slot中的kv整体回写一次。
+ * 步骤2之后slot_in之前失败时自动撤销;slot_in结果未知时不撤销，源leader先对slot中的kv整体回写一次。
+ * region数量由CacheGroupSize决定，增加region需要修改配置并重启所有节点，不支持在线拆分region,在线迁移的单位是slot。
+ *
+ * 请求head携带的slot表版本小于本节点的版本时返回ERR_SLOT_VERSION,请求方通过GetSlotTable更新后重试。
+ */
+
+const (
+	slot_migrating = 1
+	slot_abort     = 2
+	slot_in        = 3
+	slot_out       = 4
+	slot_kvs       = 5 //迁入slot的一批kv,目标region尚未负责该slot
+)
+
+const (
+	defaultSlotPerRegion = 1024
+	slotDrainTimeout     = 5 * time.Second
+	slotProposeTimeout   = 10 * time.Second
+	slotChunkSize        = 100
+)
+
+type slotInfo struct {
+	SlotCount   int
+	SlotRegions int
+}
+
+func slotFile(nodeID int) string {
+	return fmt.Sprintf("kv-%d-slot", nodeID)
+}
+
+//读取首次启动时保存的slot配置
+func loadSlotInfo(nodeID int, mask int) slotInfo {
+	info := slotInfo{}
+
+	if b, err := ioutil.ReadFile(slotFile(nodeID)); nil == err {
+		if err = json.Unmarshal(b, &info); nil != err {
+			logger.Fatalln("load slot info error", err)
+		}
+		return info
+	} else if !os.IsNotExist(err) {
+		logger.Fatalln("load slot info error", err)
+	}
+
+	config := conf.GetConfig()
+
+	info.SlotRegions = config.SlotRegions
+	if info.SlotRegions <= 0 || info.SlotRegions > mask {
+		info.SlotRegions = mask
+	}
+
+	info.SlotCount = config.SlotCount
+	if info.SlotCount <= 0 {
+		info.SlotCount = info.SlotRegions * defaultSlotPerRegion
+	}
+
+	if info.SlotCount > partition.MaxSlotCount {
+		info.SlotCount = partition.MaxSlotCount / info.SlotRegions * info.SlotRegions
+	}
+
+	if info.SlotCount%info.SlotRegions != 0 {
+		logger.Fatalln("SlotCount must be a multiple of SlotRegions")
+	}
+
+	b, _ := json.Marshal(&info)
+
+	if err := ioutil.WriteFile(slotFile(nodeID), b, 0644); nil != err {
+		logger.Fatalln("save slot info error", err)
+	}
+
+	return info
+}
+
+func (this *storeMgr) initSlots(nodeID int) {
+	this.slotInfo = loadSlotInfo(nodeID, this.mask)
+	this.slotTable = partition.New(this.slotInfo.SlotCount, this.slotInfo.SlotRegions)
+	logger.Infoln("slot count", this.slotInfo.SlotCount, "slot regions", this.slotInfo.SlotRegions)
+}
+
+func (this *storeMgr) getSlotTable() *partition.Table {
+	this.muSlot.RLock()
+	defer this.muSlot.RUnlock()
+	return this.slotTable
+}
+
+func (this *storeMgr) getSlot(uniKey string) int {
+	return this.getSlotTable().GetSlot(uniKey)
+}
+
+func (this *storeMgr) updateSlots(owners ...partition.SlotOwner) {
+	this.muSlot.Lock()
+	defer this.muSlot.Unlock()
+	this.slotTable = this.slotTable.Update(owners...)
+}
+
+func (this *storeMgr) getStoreBySlot(slot int) *kvstore {
+	return this.getStoreByIndex(this.getSlotTable().GetSlotRegion(slot))
+}
+
+//恢复初始分配
+func (this *kvstore) resetSlots() {
+	info := this.storeMgr.slotInfo
+	this.muSlot.Lock()
+	defer this.muSlot.Unlock()
+	this.slots = map[int]int64{}
+	this.migrating = map[int]int{}
+	if this.rn.region <= info.SlotRegions {
+		for i := this.rn.region - 1; i < info.SlotCount; i += info.SlotRegions {
+			this.slots[i] = 0
+		}
+	}
+}
+
+func (this *kvstore) ownSlot(slot int) bool {
+	this.muSlot.RLock()
+	defer this.muSlot.RUnlock()
+	_, ok := this.slots[slot]
+	return ok
+}
+
+func (this *kvstore) getSlotEpoch(slot int) int64 {
+	this.muSlot.RLock()
+	defer this.muSlot.RUnlock()
+	return this.slots[slot]
+}
+
+func (this *kvstore) isSlotMigrating(slot int) bool {
+	this.muSlot.RLock()
+	defer this.muSlot.RUnlock()
+	_, ok := this.migrating[slot]
+	return ok
+}
+
+//slot由本region负责且不在迁出中
+func (this *kvstore) serveSlot(slot int) bool {
+	this.muSlot.RLock()
+	defer this.muSlot.RUnlock()
+	_, own := this.slots[slot]
+	_, migrating := this.migrating[slot]
+	return own && !migrating
+}
+
+func (this *kvstore) getMigrating(slot int) (int, bool) {
+	this.muSlot.RLock()
+	defer this.muSlot.RUnlock()
+	region, ok := this.migrating[slot]
+	return region, ok
+}
+
+type slotsnap struct {
+	slots     map[int]int64
+	migrating map[int]int
+}
+
+func (this *slotsnap) append2Str(s *str.Str) {
+	appendProposal2Str(s, proposal_slots, this.slots, this.migrating)
+}
+
+func (this *kvstore) getSlotSnap() *slotsnap {
+	this.muSlot.RLock()
+	defer this.muSlot.RUnlock()
+	snap := &slotsnap{
+		slots:     map[int]int64{},
+		migrating: map[int]int{},
+	}
+	for k, v := range this.slots {
+		snap.slots[k] = v
+	}
+	for k, v := range this.migrating {
+		snap.migrating[k] = v
+	}
+	return snap
+}
+
+//从快照恢复slot状态,调用方需持有store的锁
+func (this *kvstore) applySlots(slots map[int]int64, migrating map[int]int) {
+	this.muSlot.Lock()
+	this.slots = slots
+	this.migrating = migrating
+	this.muSlot.Unlock()
+
+	owners := make([]partition.SlotOwner, 0, len(slots))
+	for k, v := range slots {
+		owners = append(owners, partition.SlotOwner{Slot: k, Region: this.rn.region, Epoch: v})
+	}
+	this.storeMgr.updateSlots(owners...)
+}
+
+//应用slot变更,调用方需持有store的锁
+func (this *kvstore) applySlotOp(op int, slot int, region int, epoch int64, kvs []*proposal) {
+	logger.Infoln("applySlotOp", this.rn.region, op, slot, region, epoch, len(kvs))
+	switch op {
+	case slot_migrating:
+		this.muSlot.Lock()
+		this.migrating[slot] = region
+		this.muSlot.Unlock()
+		//迁出的slot不再产生变更通知
+		this.kvNode.watchMgr.invalidate(this, slot, errcode.ERR_RETRY)
+	case slot_abort:
+		this.muSlot.Lock()
+		delete(this.migrating, slot)
+		this.muSlot.Unlock()
+		//迁出时清除了回写标记
+		this.writeBackSlot(slot)
+	case slot_kvs:
+		this.applySlotKvs(kvs)
+	case slot_in:
+		//旧版本在slot_in中携带slot的所有kv
+		this.applySlotKvs(kvs)
+		this.muSlot.Lock()
+		this.slots[slot] = epoch
+		this.muSlot.Unlock()
+		this.storeMgr.updateSlots(partition.SlotOwner{Slot: slot, Region: this.rn.region, Epoch: epoch})
+		//接管源region尚未回写的变更
+		this.writeBackSlot(slot)
+	case slot_out:
+		this.muSlot.Lock()
+		delete(this.slots, slot)
+		delete(this.migrating, slot)
+		this.muSlot.Unlock()
+		for k, v := range this.elements {
+			if v.slot == slot {
+				v.Lock()
+				v.setStatus(cache_remove)
+				v.Unlock()
+				this.removeLRU(v)
+				this.index.remove(v)
+				this.expires.remove(v)
+				this.keys.remove(v)
+				delete(this.elements, k)
+			}
+		}
+	}
+}
+
+func (this *kvstore) applySlotKvs(kvs []*proposal) {
+	for _, p := range kvs {
+		var fields []*proto.Field
+		if len(p.values) > 3 {
+			fields = p.values[3].([]*proto.Field)
+		}
+		if !this.applyKv(p.tt, p.values[0].(string), p.values[1].(int64), p.values[2].(int64), fields) {
+			logger.Errorln("slot apply kv failed", p.values[0].(string))
+		}
+	}
+}
+
+//持有租约时对slot中的kv整体回写一次,没有租约时由获得租约后的回写处理(调用方需持有store的锁)
+func (this *kvstore) writeBackSlot(slot int) {
+	if !this.rn.hasLease() {
+		return
+	}
+	for _, v := range this.elements {
+		if v.slot == slot {
+			v.Lock()
+			this.forceWriteBack(v)
+			v.Unlock()
+		}
+	}
+}
+
+type asynTaskSlot struct {
+	store  *kvstore
+	op     int
+	slot   int
+	region int
+	epoch  int64
+	kvs    []*kvsnap
+	cb     func(errno int32)
+}
+
+func (this *asynTaskSlot) done() {
+	var kvs []*proposal
+	for _, v := range this.kvs {
+		p := &proposal{
+			tt:     proposal_snapshot,
+			values: []interface{}{v.uniKey, v.version, v.expire},
+		}
+		if len(v.fields) > 0 {
+			fields := make([]*proto.Field, 0, len(v.fields))
+			for k, f := range v.fields {
+				if k != "__version__" {
+					fields = append(fields, f)
+				}
+			}
+			p.values = append(p.values, fields)
+		}
+		kvs = append(kvs, p)
+	}
+
+	this.store.Lock()
+	this.store.applySlotOp(this.op, this.slot, this.region, this.epoch, kvs)
+	this.store.Unlock()
+	this.cb(errcode.ERR_OK)
+}
+
+func (this *asynTaskSlot) onError(errno int32) {
+	this.cb(errno)
+}
+
+func (this *asynTaskSlot) append2Str(s *str.Str) {
+	appendProposal2Str(s, proposal_slot, this.op, this.slot, this.region, this.epoch, this.kvs)
+}
+
+func (this *asynTaskSlot) onPorposeTimeout() {
+	this.cb(errcode.ERR_TIMEOUT)
+}
+
+//提交slot变更并等待apply
+func (this *kvstore) proposeSlotOp(op int, slot int, region int, epoch int64, kvs []*kvsnap) int32 {
+	ch := make(chan int32, 1)
+
+	task := &asynTaskSlot{
+		store:  this,
+		op:     op,
+		slot:   slot,
+		region: region,
+		epoch:  epoch,
+		kvs:    kvs,
+		cb: func(errno int32) {
+			select {
+			case ch <- errno:
+			default:
+			}
+		},
+	}
+
+	if err := this.proposeC.AddNoWait(task); nil != err {
+		return errcode.ERR_SERVER_STOPED
+	}
+
+	this.flushPropose()
+
+	select {
+	case errno := <-ch:
+		return errno
+	case <-time.After(slotProposeTimeout):
+		return errcode.ERR_TIMEOUT
+	}
+}
+
+/*
+ * 等待slot中所有kv空闲，返回需要迁移的kv
+ * 空闲:命令队列为空且未锁定，没有kick,已经生成的回写批次执行完成，写直达的写入已经回写
+ * 空闲的kv清除回写标记，队列中的回写不再执行，由目标region回写
+ */
+func (this *kvstore) drainSlot(slot int) ([]*kvsnap, bool) {
+	deadline := time.Now().Add(slotDrainTimeout)
+	for {
+		kvs := []*kvsnap{}
+		idle := true
+
+		this.Lock()
+		for _, v := range this.elements {
+			if v.slot != slot {
+				continue
+			}
+			v.Lock()
+			status := v.getStatus()
+			if v.cmdQueue.isLocked() || !v.cmdQueue.empty() || v.isKicking() || atomic.LoadInt32(&v.sqlBatch) > 0 || len(v.sqlWaiters) > 0 {
+				idle = false
+			} else if status == cache_ok || status == cache_missing {
+				v.setSqlFlag(sql_none)
+				if len(v.modifyFields) > 0 {
+					v.modifyFields = map[string]*proto.Field{}
+				}
+				snap := &kvsnap{
+					uniKey:  v.uniKey,
+					version: v.version,
+					expire:  v.getExpire(),
+				}
+				if v.fields != nil {
+					snap.fields = map[string]*proto.Field{}
+					for kk, vv := range v.fields {
+						snap.fields[kk] = vv
+					}
+				}
+				kvs = append(kvs, snap)
+			}
+			v.Unlock()
+			if !idle {
+				break
+			}
+		}
+		this.Unlock()
+
+		if idle {
+			return kvs, true
+		}
+
+		if time.Now().After(deadline) {
+			return nil, false
+		}
+
+		time.Sleep(time.Millisecond * 10)
+	}
+}
+
+//请求目标region的leader转移到本节点
+func (this *kvstore) acquireLeader() (int32, string) {
+	if this.rn.isLeader() {
+		return errcode.ERR_OK, ""
+	}
+
+	leader := this.rn.getLeaderNode()
+	if 0 == leader {
+		return errcode.ERR_NOT_LEADER, "target region has no leader"
+	}
+
+	c := this.kvNode.getPeerClient(leader)
+	if nil == c {
+		return errcode.ERR_OTHER, "target region leader not on this node"
+	}
+
+	logger.Infoln("moveSlot transfer region", this.rn.region, "leader", leader, "->", this.kvNode.id)
+
+	r := c.TransferLeader(this.rn.region, this.kvNode.id).Exec()
+	if errcode.ERR_OK != r.ErrCode {
+		return r.ErrCode, "transfer target region leader failed:" + r.ErrStr
+	}
+
+	deadline := time.Now().Add(transferTimeout)
+	for !this.rn.isLeader() {
+		if time.Now().After(deadline) {
+			return errcode.ERR_TIMEOUT, "wait target region leader timeout"
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	return errcode.ERR_OK, ""
+}
+
+func (this *kvstore) moveSlot(slot int, to *kvstore) (int32, string) {
+
+	if !atomic.CompareAndSwapInt32(&this.moving, 0, 1) {
+		return errcode.ERR_BUSY, ""
+	}
+
+	defer atomic.StoreInt32(&this.moving, 0)
+
+	if !atomic.CompareAndSwapInt32(&to.moving, 0, 1) {
+		return errcode.ERR_BUSY, ""
+	}
+
+	defer atomic.StoreInt32(&to.moving, 0)
+
+	if errno, errStr := to.acquireLeader(); errcode.ERR_OK != errno {
+		return errno, errStr
+	}
+
+	if region, ok := this.getMigrating(slot); ok {
+		if region != to.rn.region {
+			return errcode.ERR_OTHER, fmt.Sprintf("slot is migrating to region %d", region)
+		}
+	} else if !this.ownSlot(slot) {
+		return errcode.ERR_OTHER, "slot not in region"
+	} else if errno := this.proposeSlotOp(slot_migrating, slot, to.rn.region, 0, nil); errcode.ERR_OK != errno {
+		return errno, ""
+	}
+
+	//目标已经迁入(上一次迁移在slot_out之前中断)
+	if !to.ownSlot(slot) {
+		kvs, ok := this.drainSlot(slot)
+		if !ok {
+			this.abortDrained(slot)
+			return errcode.ERR_TIMEOUT, "wait slot idle timeout"
+		}
+
+		logger.Infoln("moveSlot", slot, this.rn.region, "->", to.rn.region, "kv count", len(kvs))
+
+		//清除上一次中断的迁移留在目标region的kv
+		if errno := to.proposeSlotOp(slot_out, slot, 0, 0, nil); errcode.ERR_OK != errno {
+			this.abortDrained(slot)
+			return errno, "clear target slot failed"
+		}
+
+		for i := 0; i < len(kvs); i += slotChunkSize {
+			end := i + slotChunkSize
+			if end > len(kvs) {
+				end = len(kvs)
+			}
+			if errno := to.proposeSlotOp(slot_kvs, slot, to.rn.region, 0, kvs[i:end]); errcode.ERR_OK != errno {
+				this.abortDrained(slot)
+				return errno, "slot_kvs failed"
+			}
+		}
+
+		if errno := to.proposeSlotOp(slot_in, slot, to.rn.region, this.getSlotEpoch(slot)+1, nil); errcode.ERR_OK != errno {
+			//slot_in可能在超时之后才被apply,不能撤销(否则两个region同时负责该slot),只恢复源region的回写
+			//目标region接管后的回写在本节点同一个sqlUpdater中排在其后
+			this.Lock()
+			this.writeBackSlot(slot)
+			this.Unlock()
+			return errno, "slot_in failed"
+		}
+	}
+
+	if errno := this.proposeSlotOp(slot_out, slot, 0, 0, nil); errcode.ERR_OK != errno {
+		return errno, "slot_out failed"
+	}
+
+	return errcode.ERR_OK, ""
+}
+
+//slot_in之前失败,撤销迁移,由slot_abort恢复drainSlot清除的回写,提交失败时直接在本地恢复
+func (this *kvstore) abortDrained(slot int) {
+	if errcode.ERR_OK != this.proposeSlotOp(slot_abort, slot, 0, 0, nil) {
+		this.Lock()
+		this.writeBackSlot(slot)
+		this.Unlock()
+	}
+}
+
+//撤销尚未迁入目标region的迁移
+func (this *kvstore) abortMoveSlot(slot int, to *kvstore) (int32, string) {
+	if !atomic.CompareAndSwapInt32(&this.moving, 0, 1) {
+		return errcode.ERR_BUSY, ""
+	}
+
+	defer atomic.StoreInt32(&this.moving, 0)
+
+	if nil != to && to.ownSlot(slot) {
+		return errcode.ERR_OTHER, "slot already moved in"
+	}
+
+	return this.proposeSlotOp(slot_abort, slot, 0, 0, nil), ""
+}
+
+type cmdMoveSlot struct {
+	replyer *replyer
+	errStr  string
+}
+
+func (this *cmdMoveSlot) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	resp := &proto.MoveSlotResp{}
+	if errcode.ERR_OK != errCode {
+		if "" != this.errStr {
+			resp.Err = this.errStr
+		} else {
+			resp.Err = errcode.GetErrorStr(errCode)
+		}
+	}
+	return net.NewMessage(net.CommonHead{
+		Seqno:   this.replyer.seqno,
+		ErrCode: errCode,
+	}, resp)
+}
+
+func (this *cmdMoveSlot) reply(errCode int32, errStr string) {
+	this.errStr = errStr
+	this.replyer.reply(this, errCode, nil, 0)
+}
+
+//查找迁出中或负责slot的store
+func (this *storeMgr) getSlotSource(slot int) *kvstore {
+	this.RLock()
+	for _, v := range this.stores {
+		if v.isSlotMigrating(slot) {
+			this.RUnlock()
+			return v
+		}
+	}
+	this.RUnlock()
+	return this.getStoreBySlot(slot)
+}
+
+func moveSlot(n *KVNode, cli *cliConn, msg *net.Message) {
+
+	req := msg.GetData().(*proto.MoveSlotReq)
+
+	head := msg.GetHead()
+
+	_, respDeadline := getDeadline(head.Timeout)
+
+	cmd := &cmdMoveSlot{
+		replyer: newReplyer(cli, head.Seqno, respDeadline),
+	}
+
+	slot := int(req.GetSlot())
+
+	if slot < 0 || slot >= n.storeMgr.slotInfo.SlotCount {
+		cmd.reply(errcode.ERR_OTHER, "invaild slot")
+		return
+	}
+
+	to := n.storeMgr.getStoreByIndex(int(req.GetRegion()))
+
+	if nil == to {
+		cmd.reply(errcode.ERR_OTHER, "invaild region")
+		return
+	}
+
+	from := n.storeMgr.getSlotSource(slot)
+
+	if nil == from || !from.rn.isLeader() {
+		cmd.reply(errcode.ERR_NOT_LEADER, "")
+		return
+	}
+
+	if from == to {
+		if region, ok := from.getMigrating(slot); ok {
+			go func() {
+				cmd.reply(from.abortMoveSlot(slot, n.storeMgr.getStoreByIndex(region)))
+			}()
+		} else {
+			cmd.reply(errcode.ERR_OK, "")
+		}
+		return
+	}
+
+	go func() {
+		cmd.reply(from.moveSlot(slot, to))
+	}()
+}
+
+//请求携带的slot表版本落后时直接返回ERR_SLOT_VERSION,返回false表示不再处理请求
+func (this *KVNode) checkSlotVersion(session kendynet.StreamSession, cmd uint16, msg *net.Message) bool {
+	head := msg.GetHead()
+	version := head.SlotVersion
+	if version <= 0 || version >= this.storeMgr.getSlotTable().Version() {
+		return true
+	}
+
+	if resp, err := pb.GetNamespace("response").Unmarshal(uint32(cmd), nil); nil == err {
+		session.Send(net.NewMessage(net.CommonHead{
+			Seqno:   head.Seqno,
+			ErrCode: errcode.ERR_SLOT_VERSION,
+		}, resp))
+	}
+
+	return false
+}
+
+func getSlotTable(n *KVNode, cli *cliConn, msg *net.Message) {
+	table := n.storeMgr.getSlotTable()
+
+	resp := &proto.GetSlotTableResp{
+		Version: table.Version(),
+		Regions: make([]int32, 0, table.SlotCount()),
+	}
+
+	for _, v := range table.Regions() {
+		resp.Regions = append(resp.Regions, int32(v))
+	}
+
+	if err := cli.send(net.NewMessage(net.CommonHead{Seqno: msg.GetHead().Seqno}, resp)); nil != err {
+		logger.Errorln("send resp error", err.Error())
+	}
+}
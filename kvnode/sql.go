@@ -40,6 +40,9 @@ type sqlMgr struct {
 	sqlUpdateWg         sync.WaitGroup
 	sqlLoaders          []*sqlLoader
 	sqlUpdaters         []*sqlUpdater
+	scaner              *sqlScaner
+	indexScaner         *sqlScaner //按索引查询
+	preloadDB           *sqlx.DB
 	stoped              int32
 	totalUpdateSqlCount int64
 
@@ -57,11 +60,23 @@ func (this *sqlMgr) pushLoadReq(task asynCmdTaskI, fullReturn ...bool) bool {
 	}
 }
 
+func (this *sqlMgr) pushIndexReq(cmd *cmdGetByIndex) bool {
+	return nil == this.indexScaner.queue.AddNoWait(cmd, true)
+}
+
 func (this *sqlMgr) pushUpdateReq(kv *kv) {
 	u := this.sqlUpdaters[futil.StringHash(kv.uniKey)%len(this.sqlUpdaters)]
 	u.queue.AddNoWait(kv)
 }
 
+//事务的回写由第一个kv对应的sqlUpdater执行,其它sqlUpdater中kv的回写顺序由execTxn保证
+func (this *sqlMgr) pushTxnUpdateReq(t *sqlTxnUpdate) {
+	u := this.sqlUpdaters[futil.StringHash(t.kvs[0].uniKey)%len(this.sqlUpdaters)]
+	if nil != u.queue.AddNoWait(t) && nil != t.onFinish {
+		t.onFinish(errServerStop)
+	}
+}
+
 func (this *sqlMgr) stop() {
 
 	if atomic.CompareAndSwapInt32(&this.stoped, 0, 1) {
@@ -72,6 +87,8 @@ func (this *sqlMgr) stop() {
 		for _, v := range this.sqlUpdaters {
 			v.queue.Close()
 		}
+		this.scaner.queue.Close()
+		this.indexScaner.queue.Close()
 		this.sqlUpdateWg.Wait()
 	}
 }
@@ -138,8 +155,26 @@ func newSqlMgr() (*sqlMgr, error) {
 		}, nil)
 	}
 
+	scanDB, err := sqlOpen(dbConfig.SqlType, dbConfig.DbHost, dbConfig.DbPort, dbConfig.DbDataBase, dbConfig.DbUser, dbConfig.DbPassword)
+	if nil != err {
+		return nil, err
+	}
+
+	indexDB, err := sqlOpen(dbConfig.SqlType, dbConfig.DbHost, dbConfig.DbPort, dbConfig.DbDataBase, dbConfig.DbUser, dbConfig.DbPassword)
+	if nil != err {
+		return nil, err
+	}
+
+	preloadDB, err := sqlOpen(dbConfig.SqlType, dbConfig.DbHost, dbConfig.DbPort, dbConfig.DbDataBase, dbConfig.DbUser, dbConfig.DbPassword)
+	if nil != err {
+		return nil, err
+	}
+
 	sqlMgr.sqlUpdaters = sqlUpdaters
 	sqlMgr.sqlLoaders = sqlLoaders
+	sqlMgr.scaner = newSqlScaner(scanDB, dbConfig.SqlType, "sqlScaner")
+	sqlMgr.indexScaner = newSqlScaner(indexDB, dbConfig.SqlType, "indexScaner")
+	sqlMgr.preloadDB = preloadDB
 
 	return sqlMgr, nil
 }
@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"github.com/jmoiron/sqlx"
+	"github.com/sniperHW/flyfish/errcode"
 	"github.com/sniperHW/flyfish/proto"
 	"github.com/sniperHW/flyfish/util/fixedarray"
 	"github.com/sniperHW/flyfish/util/str"
@@ -19,9 +20,10 @@ var (
 )
 
 type updatePending struct {
-	sqlStr *str.Str
-	kvs    *fixedarray.FixedArray
-	rn     *raftNode
+	sqlStr  *str.Str
+	kvs     *fixedarray.FixedArray
+	rn      *raftNode
+	waiters []asynCmdTaskI //本次回写完成后返回的写入(写直达的表格)
 }
 
 type sqlUpdater struct {
@@ -65,9 +67,46 @@ func isRetryError(err error) bool {
 }
 
 func (this *sqlUpdater) reset() {
+	//批次执行完成,重新提交等待该批次的事务回写
+	var txns []*sqlTxnUpdate
+	this.pending.kvs.ForEach(func(v interface{}) {
+		kv := v.(*kv)
+		kv.Lock()
+		if 0 == atomic.AddInt32(&kv.sqlBatch, -1) && len(kv.batchWaiters) > 0 {
+			txns = append(txns, kv.batchWaiters...)
+			kv.batchWaiters = nil
+		}
+		kv.Unlock()
+	})
+	for _, t := range txns {
+		this.sqlMgr.pushTxnUpdateReq(t)
+	}
 	this.pending.sqlStr.Reset()
 	this.pending.kvs.Reset()
 	this.pending.rn = nil
+	this.pending.waiters = nil
+}
+
+//回写结果对应的错误码
+func writeBackErrCode(err error) int32 {
+	switch err {
+	case nil:
+		return errcode.ERR_OK
+	case errServerStop:
+		return errcode.ERR_SERVER_STOPED
+	case errLoseLease:
+		//写入已经提交，由新leader继续回写，不能让客户端当作未执行而重试
+		return errcode.ERR_WRITEBACK_PENDING
+	default:
+		return errcode.ERR_SQLERROR
+	}
+}
+
+func notifyWriteBack(waiters []asynCmdTaskI, err error) {
+	errno := writeBackErrCode(err)
+	for _, v := range waiters {
+		v.onWriteBack(errno)
+	}
 }
 
 func (this *sqlUpdater) run() {
@@ -129,7 +168,9 @@ func (this *sqlUpdater) append(v interface{}) {
 		if !rn.hasLease() {
 			kv.Lock()
 			kv.setWriteBack(false)
+			waiters := kv.takeSqlWaiters()
 			kv.Unlock()
+			notifyWriteBack(waiters, errLoseLease)
 			return
 		}
 
@@ -143,6 +184,9 @@ func (this *sqlUpdater) append(v interface{}) {
 
 		kv.Lock()
 
+		//在kv锁内计数，事务回写据此等待包含kv旧状态的批次执行完成
+		atomic.AddInt32(&kv.sqlBatch, 1)
+
 		tt := kv.getSqlFlag()
 		if tt == sql_insert_update {
 			this.sqlMgr.buildInsertUpdateString(this.pending.sqlStr, kv)
@@ -158,7 +202,15 @@ func (this *sqlUpdater) append(v interface{}) {
 			kv.modifyFields = map[string]*proto.Field{}
 		}
 
+		this.pending.waiters = append(this.pending.waiters, kv.takeSqlWaiters()...)
+
 		kv.Unlock()
+	case *sqlTxnUpdate:
+		//先执行之前累积的回写,保证回写顺序
+		if !this.pending.kvs.Empty() {
+			this.exec()
+		}
+		this.execTxn(v.(*sqlTxnUpdate))
 	}
 
 	if this.pending.kvs.Full() {
@@ -174,6 +226,7 @@ func (this *sqlUpdater) onSqlResult(kv *kv, err error) {
 		defer kv.Unlock()
 		if err == errLoseLease {
 			kv.setWriteBack(false)
+			notifyWriteBack(kv.takeSqlWaiters(), err)
 		} else {
 			if sql_none == kv.getSqlFlag() {
 				kv.setWriteBack(false)
@@ -192,23 +245,48 @@ func (this *sqlUpdater) exec() {
 	rn := this.pending.rn
 	this.pending.rn = nil
 
+	//先计数再检查租约，转移leader时放弃租约后等待计数归零
+	atomic.AddInt32(&rn.sqlInflight, 1)
+	defer atomic.AddInt32(&rn.sqlInflight, -1)
+
 	if !rn.hasLease() {
 		this.pending.kvs.ForEach(func(v interface{}) {
 			kv := v.(*kv)
 			kv.Lock()
 			kv.setWriteBack(false)
+			notifyWriteBack(kv.takeSqlWaiters(), errLoseLease)
 			kv.Unlock()
 		})
+		notifyWriteBack(this.pending.waiters, errLoseLease)
 		return
 	}
 
 	var err error
-	str := this.pending.sqlStr.ToString()
 
 	atomic.AddInt64(&this.sqlMgr.totalUpdateSqlCount, int64(this.pending.kvs.Len()))
 
+	//kv的变更可能已经被事务回写
+	if this.pending.sqlStr.Len() > 0 {
+		err = this.execWithRetry(rn, this.pending.sqlStr.ToString(), func(str string) error {
+			_, err := this.db.Exec(str)
+			return err
+		})
+	}
+
+	//logger.Debugln("onSqlResult", err)
+
+	notifyWriteBack(this.pending.waiters, err)
+
+	this.pending.kvs.ForEach(func(v interface{}) {
+		kv := v.(*kv)
+		this.onSqlResult(kv, err)
+	})
+}
+
+func (this *sqlUpdater) execWithRetry(rn *raftNode, str string, fn func(string) error) error {
+	var err error
 	for {
-		_, err = this.db.Exec(str)
+		err = fn(str)
 		if nil == err {
 			break
 		} else {
@@ -234,13 +312,111 @@ func (this *sqlUpdater) exec() {
 			}
 		}
 	}
+	return err
+}
 
-	//logger.Debugln("onSqlResult", err)
+/*
+ * 事务的回写，所有kv的sql在同一个数据库事务中执行
+ * owned为true的kv由本次回写设置了writeback标记,其余kv已经在其它sqlUpdater的队列中,
+ * 它们的变更在这里一起回写后，队列中的回写会因为sql_none而跳过。
+ * 如果其它sqlUpdater已经为kv生成了回写语句(sqlBatch > 0),需要等待该批次执行完成，
+ * 否则旧状态可能在事务之后写入数据库。此时事务挂在kv上，由执行该批次的sqlUpdater在reset时重新提交，
+ * 不阻塞本sqlUpdater的其它回写。检查之后新生成的批次包含事务的变更，不需要等待。
+ */
+type sqlTxnUpdate struct {
+	kvs      []*kv
+	owned    []bool
+	onFinish func(error) //回写完成后调用(写直达的表格)
+}
 
-	this.pending.kvs.ForEach(func(v interface{}) {
-		kv := v.(*kv)
-		this.onSqlResult(kv, err)
-	})
+func (this *sqlUpdater) execTxn(t *sqlTxnUpdate) {
+
+	defer this.reset()
+
+	rn := t.kvs[0].store.getRaftNode()
+
+	atomic.AddInt32(&rn.sqlInflight, 1)
+	defer atomic.AddInt32(&rn.sqlInflight, -1)
+
+	if !rn.hasLease() {
+		for i, kv := range t.kvs {
+			if t.owned[i] {
+				kv.Lock()
+				kv.setWriteBack(false)
+				notifyWriteBack(kv.takeSqlWaiters(), errLoseLease)
+				kv.Unlock()
+			}
+		}
+		if nil != t.onFinish {
+			t.onFinish(errLoseLease)
+		}
+		return
+	}
+
+	for i, kv := range t.kvs {
+		if !t.owned[i] {
+			kv.Lock()
+			if atomic.LoadInt32(&kv.sqlBatch) > 0 {
+				kv.batchWaiters = append(kv.batchWaiters, t)
+				kv.Unlock()
+				return
+			}
+			kv.Unlock()
+		}
+	}
+
+	for _, kv := range t.kvs {
+		kv.Lock()
+
+		tt := kv.getSqlFlag()
+		if tt == sql_insert_update {
+			this.sqlMgr.buildInsertUpdateString(this.pending.sqlStr, kv)
+		} else if tt == sql_update {
+			this.sqlMgr.buildUpdateString(this.pending.sqlStr, kv)
+		} else if tt == sql_delete {
+			this.sqlMgr.buildDeleteString(this.pending.sqlStr, kv)
+		}
+
+		kv.setSqlFlag(sql_none)
+
+		if len(kv.modifyFields) > 0 {
+			kv.modifyFields = map[string]*proto.Field{}
+		}
+
+		this.pending.waiters = append(this.pending.waiters, kv.takeSqlWaiters()...)
+
+		kv.Unlock()
+	}
+
+	var err error
+
+	atomic.AddInt64(&this.sqlMgr.totalUpdateSqlCount, int64(len(t.kvs)))
+
+	if this.pending.sqlStr.Len() > 0 {
+		err = this.execWithRetry(rn, this.pending.sqlStr.ToString(), func(str string) error {
+			tx, err := this.db.Begin()
+			if nil != err {
+				return err
+			}
+			if _, err = tx.Exec(str); nil != err {
+				tx.Rollback()
+				return err
+			}
+			return tx.Commit()
+		})
+	}
+
+	notifyWriteBack(this.pending.waiters, err)
+
+	if nil != t.onFinish {
+		t.onFinish(err)
+	}
+
+	for i, kv := range t.kvs {
+		if t.owned[i] {
+			this.onSqlResult(kv, err)
+		}
+	}
 }
 
 /*
@@ -0,0 +1,13 @@
+-- 将age增加args[1],args[2]存在时要求age与之相等,返回增加后的age
+local age = 0
+if record.exists then
+    age = record.fields.age
+end
+
+if args[2] ~= nil and tonumber(args[2]) ~= age then
+    error("age not equal")
+end
+
+age = age + tonumber(args[1])
+
+return {age = age}, age
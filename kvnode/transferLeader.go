@@ -0,0 +1,179 @@
+package kvnode
+
+import (
+	"context"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/proto"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ * 转移leader
+ * 1.停止续约，提交放弃租约的proposal,apply后本节点不再发起新的回写。
+ * 2.等待已经开始的回写完成。
+ * 3.调用raft的TransferLeadership,新leader当选后租约持有者为0,立即续约并接管回写。
+ * 开始转移时作废读租约，转移成功后本term不再续约(新leader通过MsgTimeoutNow当选，不受CheckQuorum约束)。
+ * 任一步骤失败恢复续约。
+ */
+
+const (
+	transferWaitSqlTimeout = 2 * time.Second
+	transferTimeout        = electionTick * tickInterval * 3
+)
+
+func (rc *raftNode) onTransferFinish(ok bool) {
+	if ok {
+		rc.muLeader.Lock()
+		rc.transferedTerm = rc.term
+		rc.readLeaseExpire = time.Time{}
+		rc.muLeader.Unlock()
+	} else {
+		rc.lease.setReleasing(false)
+	}
+	atomic.StoreInt32(&rc.transferring, 0)
+}
+
+func (rc *raftNode) transferLeader(transferee uint64, cb func(errno int32)) {
+	if !rc.isLeader() {
+		cb(errcode.ERR_NOT_LEADER)
+		return
+	}
+
+	if transferee == uint64(rc.id) {
+		cb(errcode.ERR_OK)
+		return
+	}
+
+	if _, ok := rc.node.Status().Progress[transferee]; !ok {
+		//不是集群成员
+		cb(errcode.ERR_OTHER)
+		return
+	}
+
+	if !atomic.CompareAndSwapInt32(&rc.transferring, 0, 1) {
+		cb(errcode.ERR_BUSY)
+		return
+	}
+
+	//作废读租约，transferring已经设置，之后的续约会被拒绝
+	rc.muLeader.Lock()
+	rc.readLeaseExpire = time.Time{}
+	rc.muLeader.Unlock()
+
+	rc.lease.setReleasing(true)
+
+	task := &asynTaskLeaseRelease{
+		rn:   rc,
+		term: rc.getTerm(),
+		doneCB: func() {
+			go rc.doTransfer(transferee, cb)
+		},
+		errorCB: func(errno int32) {
+			rc.onTransferFinish(false)
+			cb(errno)
+		},
+	}
+
+	if err := rc.proposePipeline.AddNoWait(task); nil != err {
+		rc.onTransferFinish(false)
+		cb(errcode.ERR_SERVER_STOPED)
+	} else {
+		rc.proposePipeline.AddNoWait(nil)
+	}
+}
+
+func (rc *raftNode) doTransfer(transferee uint64, cb func(errno int32)) {
+
+	deadline := time.Now().Add(transferWaitSqlTimeout)
+	for atomic.LoadInt32(&rc.sqlInflight) > 0 {
+		if time.Now().After(deadline) {
+			rc.onTransferFinish(false)
+			cb(errcode.ERR_TIMEOUT)
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	logger.Infoln("transferLeader", rc.id, "->", transferee)
+
+	rc.node.TransferLeadership(context.Background(), uint64(rc.id), transferee)
+
+	deadline = time.Now().Add(transferTimeout)
+	for time.Now().Before(deadline) {
+		if lead := rc.node.Status().Lead; lead == transferee {
+			rc.onTransferFinish(true)
+			cb(errcode.ERR_OK)
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	logger.Infoln("transferLeader timeout", rc.id, "->", transferee)
+
+	rc.onTransferFinish(false)
+	cb(errcode.ERR_TIMEOUT)
+}
+
+type cmdTransferLeader struct {
+	replyer *replyer
+	errStr  string
+}
+
+func (this *cmdTransferLeader) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	resp := &proto.TransferLeaderResp{}
+	if errcode.ERR_OK != errCode {
+		if "" != this.errStr {
+			resp.Err = this.errStr
+		} else {
+			resp.Err = errcode.GetErrorStr(errCode)
+		}
+	}
+	return net.NewMessage(net.CommonHead{
+		Seqno:   this.replyer.seqno,
+		ErrCode: errCode,
+	}, resp)
+}
+
+func (this *cmdTransferLeader) reply(errCode int32) {
+	this.replyer.reply(this, errCode, nil, 0)
+}
+
+func transferLeader(n *KVNode, cli *cliConn, msg *net.Message) {
+
+	req := msg.GetData().(*proto.TransferLeaderReq)
+
+	head := msg.GetHead()
+
+	_, respDeadline := getDeadline(head.Timeout)
+
+	cmd := &cmdTransferLeader{
+		replyer: newReplyer(cli, head.Seqno, respDeadline),
+	}
+
+	store := n.storeMgr.getStoreByIndex(int(req.GetRegion()))
+
+	if nil == store {
+		cmd.errStr = "invaild region"
+		cmd.reply(errcode.ERR_OTHER)
+		return
+	}
+
+	nodeID := int(req.GetNodeId())
+
+	if nodeID <= 0 || nodeID > 0xFFFF {
+		cmd.errStr = "invaild node id"
+		cmd.reply(errcode.ERR_OTHER)
+		return
+	}
+
+	//迁移slot期间保持源及目标region的leader在本节点
+	if atomic.LoadInt32(&store.moving) == 1 {
+		cmd.errStr = "region is moving slot"
+		cmd.reply(errcode.ERR_BUSY)
+		return
+	}
+
+	store.rn.transferLeader(uint64(nodeID<<16+int(req.GetRegion())), cmd.reply)
+}
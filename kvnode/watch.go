@@ -0,0 +1,239 @@
+package kvnode
+
+import (
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/proto"
+	"sync"
+)
+
+/*
+ * 记录变更关注
+ * leader在commitedBatchProposal.apply中提交变更后,向关注该记录的连接推送watch_notify。
+ * 关注只在当前leader上有效，失去leadership(包括TransferLeader)或slot开始迁出时，
+ * 向store(slot)上的所有关注推送ErrCode != ERR_OK的watch_notify并移除，客户端需要重新发起watch。
+ */
+
+type watcher struct {
+	cli    *cliConn
+	seqno  int64 //watch请求的seqno,推送时使用
+	uniKey string
+	store  *kvstore
+	slot   int
+}
+
+type watchMgr struct {
+	sync.Mutex
+	watchers map[string]map[*watcher]bool
+}
+
+func newWatchMgr() *watchMgr {
+	return &watchMgr{
+		watchers: map[string]map[*watcher]bool{},
+	}
+}
+
+func (this *watchMgr) add(w *watcher) {
+	this.Lock()
+	defer this.Unlock()
+	m, ok := this.watchers[w.uniKey]
+	if !ok {
+		m = map[*watcher]bool{}
+		this.watchers[w.uniKey] = m
+	}
+	m[w] = true
+}
+
+func (this *watchMgr) remove(w *watcher) {
+	this.Lock()
+	defer this.Unlock()
+	if m, ok := this.watchers[w.uniKey]; ok {
+		delete(m, w)
+		if len(m) == 0 {
+			delete(this.watchers, w.uniKey)
+		}
+	}
+}
+
+//连接断开，移除连接上的所有关注
+func (this *watchMgr) removeConn(cli *cliConn) {
+	for _, w := range cli.clearWatcher() {
+		this.remove(w)
+	}
+}
+
+//移除store上的关注并通知客户端,slot < 0时移除store上的所有关注
+func (this *watchMgr) invalidate(store *kvstore, slot int, errCode int32) {
+	this.Lock()
+	watchers := []*watcher{}
+	for uniKey, m := range this.watchers {
+		for w := range m {
+			if w.store == store && (slot < 0 || w.slot == slot) {
+				watchers = append(watchers, w)
+				delete(m, w)
+			}
+		}
+		if len(m) == 0 {
+			delete(this.watchers, uniKey)
+		}
+	}
+	this.Unlock()
+
+	for _, v := range watchers {
+		if v.cli.removeWatcher(v.seqno) != v {
+			//已经unwatch
+			continue
+		}
+		err := v.cli.send(net.NewMessage(net.CommonHead{
+			Seqno:   v.seqno,
+			UniKey:  v.uniKey,
+			ErrCode: errCode,
+		}, &proto.WatchNotify{}))
+		if nil != err {
+			logger.Errorln("send watch notify error", err.Error())
+		}
+	}
+}
+
+func (this *watchMgr) notify(uniKey string, version int64, fields map[string]*proto.Field) {
+	this.Lock()
+	m, ok := this.watchers[uniKey]
+	if !ok {
+		this.Unlock()
+		return
+	}
+	watchers := make([]*watcher, 0, len(m))
+	for k := range m {
+		watchers = append(watchers, k)
+	}
+	this.Unlock()
+
+	pbdata := &proto.WatchNotify{
+		Version: version,
+		Del:     version == 0,
+	}
+
+	if version > 0 {
+		for k, v := range fields {
+			if !(k == "__version__" || k == "__key__") {
+				pbdata.Fields = append(pbdata.Fields, v)
+			}
+		}
+	}
+
+	for _, v := range watchers {
+		err := v.cli.send(net.NewMessage(net.CommonHead{
+			Seqno:  v.seqno,
+			UniKey: uniKey,
+		}, pbdata))
+		if nil != err {
+			logger.Errorln("send watch notify error", err.Error())
+		}
+	}
+}
+
+type cmdWatch struct {
+	replyer *replyer
+	watch   bool
+}
+
+func (this *cmdWatch) makeResponse(errCode int32, fields map[string]*proto.Field, version int64) *net.Message {
+	head := net.CommonHead{
+		Seqno:   this.replyer.seqno,
+		ErrCode: errCode,
+	}
+	if this.watch {
+		return net.NewMessage(head, &proto.WatchResp{})
+	} else {
+		return net.NewMessage(head, &proto.UnwatchResp{})
+	}
+}
+
+func (this *cmdWatch) reply(errCode int32) {
+	this.replyer.reply(this, errCode, nil, 0)
+}
+
+func watch(n *KVNode, cli *cliConn, msg *net.Message) {
+
+	head := msg.GetHead()
+
+	_, respDeadline := getDeadline(head.Timeout)
+
+	cmd := &cmdWatch{
+		replyer: newReplyer(cli, head.Seqno, respDeadline),
+		watch:   true,
+	}
+
+	table, key := head.SplitUniKey()
+
+	if "" == table {
+		cmd.reply(errcode.ERR_MISSING_TABLE)
+		return
+	}
+
+	if "" == key {
+		cmd.reply(errcode.ERR_MISSING_KEY)
+		return
+	}
+
+	if nil == n.storeMgr.dbmeta.GetTableMeta(table) {
+		cmd.reply(errcode.ERR_INVAILD_TABLE)
+		return
+	}
+
+	slot := n.storeMgr.getSlot(head.UniKey)
+
+	store := n.storeMgr.getStoreBySlot(slot)
+
+	if nil == store || !store.rn.isLeader() {
+		cmd.reply(errcode.ERR_NOT_LEADER)
+		return
+	}
+
+	if !store.serveSlot(slot) {
+		//slot正在迁移
+		cmd.reply(errcode.ERR_RETRY)
+		return
+	}
+
+	w := &watcher{
+		cli:    cli,
+		seqno:  head.Seqno,
+		uniKey: head.UniKey,
+		store:  store,
+		slot:   slot,
+	}
+
+	if cli.addWatcher(w) {
+		n.watchMgr.add(w)
+	}
+
+	//添加期间失去leadership或slot开始迁出，invalidate可能已经执行
+	if !store.rn.isLeader() || !store.serveSlot(slot) {
+		cli.removeWatcher(w.seqno)
+		n.watchMgr.remove(w)
+		cmd.reply(errcode.ERR_NOT_LEADER)
+		return
+	}
+
+	cmd.reply(errcode.ERR_OK)
+}
+
+func unWatch(n *KVNode, cli *cliConn, msg *net.Message) {
+
+	req := msg.GetData().(*proto.UnwatchReq)
+
+	head := msg.GetHead()
+
+	_, respDeadline := getDeadline(head.Timeout)
+
+	cmd := &cmdWatch{
+		replyer: newReplyer(cli, head.Seqno, respDeadline),
+	}
+
+	if w := cli.removeWatcher(req.GetWatchSeqno()); nil != w {
+		n.watchMgr.remove(w)
+	}
+
+	cmd.reply(errcode.ERR_OK)
+}
@@ -0,0 +1,152 @@
+package kvpd
+
+import (
+	"github.com/sniperHW/flyfish/client"
+	"github.com/sniperHW/flyfish/errcode"
+	"time"
+)
+
+/*
+ * 每轮最多执行一个操作:
+ * 1.以-join启动且所有region都没有leader的kvnode,向各region的leader所在节点发起AddMember。
+ * 2.在线节点之间leader数量相差超过1时，把leader最多的节点上的一个region转移到leader最少的节点。
+ * 正在迁移slot的region拒绝TransferLeader(ERR_BUSY),下一轮再选择。
+ * 刚成为leader时还没有收到全部上报，等待NodeTimeout后才开始检查。
+ * kvpd不会自动删除离线节点，需要通过RemoveMember处理。
+ */
+
+const (
+	defaultBalanceInterval = 5 * time.Second
+	defaultNodeTimeout     = 5 * time.Second
+)
+
+type balancer struct {
+	pd      *KVPD
+	clients map[string]*client.Client //服务地址 -> 管理命令连接
+}
+
+func newBalancer(pd *KVPD) *balancer {
+	return &balancer{
+		pd:      pd,
+		clients: map[string]*client.Client{},
+	}
+}
+
+func (this *balancer) getClient(service string) *client.Client {
+	c, ok := this.clients[service]
+	if !ok {
+		c = client.OpenClient(service, false)
+		this.clients[service] = c
+	}
+	return c
+}
+
+func (this *balancer) run() {
+	for !this.pd.isStoped() {
+		interval := time.Duration(GetConfig().BalanceInterval) * time.Second
+		if interval <= 0 {
+			interval = defaultBalanceInterval
+		}
+		time.Sleep(interval)
+		this.balance()
+	}
+}
+
+func (this *balancer) balance() {
+	nodeTimeout := time.Duration(GetConfig().NodeTimeout) * time.Second
+	if nodeTimeout <= 0 {
+		nodeTimeout = defaultNodeTimeout
+	}
+
+	if this.pd.rn.leaderDuration() < nodeTimeout {
+		return
+	}
+
+	nodes := this.pd.getAliveNodes()
+
+	if this.join(nodes) {
+		return
+	}
+
+	this.balanceLeader(nodes)
+}
+
+func isJoining(status *nodeStatus) bool {
+	if !status.join {
+		return false
+	}
+	for _, leader := range status.regions {
+		if 0 != leader {
+			return false
+		}
+	}
+	return true
+}
+
+//将新节点加入所有region
+func (this *balancer) join(nodes map[int]*nodeStatus) bool {
+	for id, status := range nodes {
+		if !isJoining(status) || "" == status.raftUrl {
+			continue
+		}
+
+		//每个region由其leader所在节点执行，其余region返回ERR_NOT_LEADER
+		leaderNodes := map[int]bool{}
+		for _, leader := range this.pd.state.getLeaders() {
+			leaderNodes[leader] = true
+		}
+
+		for leader := range leaderNodes {
+			if n, ok := nodes[leader]; ok {
+				r := this.getClient(n.service).AddMember(id, status.raftUrl).Exec()
+				logger.Infoln("kvpd: AddMember", id, "on", leader, errcode.GetErrorStr(r.ErrCode), r.ErrStr)
+			}
+		}
+
+		return true
+	}
+	return false
+}
+
+//在线且已经加入集群的节点之间均衡leader数量
+func (this *balancer) balanceLeader(nodes map[int]*nodeStatus) {
+	count := map[int][]int{} //节点 -> 作为leader的region
+	for id, status := range nodes {
+		if !isJoining(status) {
+			count[id] = []int{}
+		}
+	}
+
+	if len(count) < 2 {
+		return
+	}
+
+	for region, leader := range this.pd.state.getLeaders() {
+		if regions, ok := count[leader]; ok {
+			count[leader] = append(regions, region)
+		}
+	}
+
+	maxNode, minNode := 0, 0
+	for id, regions := range count {
+		if 0 == maxNode || len(regions) > len(count[maxNode]) {
+			maxNode = id
+		}
+		if 0 == minNode || len(regions) < len(count[minNode]) {
+			minNode = id
+		}
+	}
+
+	if len(count[maxNode])-len(count[minNode]) <= 1 {
+		return
+	}
+
+	for _, region := range count[maxNode] {
+		//目标节点必须已经跟随该region的leader
+		if leader, ok := nodes[minNode].regions[region]; ok && leader == maxNode {
+			r := this.getClient(nodes[maxNode].service).TransferLeader(region, minNode).Exec()
+			logger.Infoln("kvpd: TransferLeader region", region, maxNode, "->", minNode, errcode.GetErrorStr(r.ErrCode), r.ErrStr)
+			return
+		}
+	}
+}
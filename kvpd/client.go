@@ -0,0 +1,181 @@
+package kvpd
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/net/pb"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/kendynet"
+	"sync"
+	"time"
+)
+
+/*
+ * kvnode及kvproxy访问kvpd的客户端
+ * 请求失败或返回ERR_NOT_LEADER时切换到下一个kvpd地址，由调用方决定何时重试。
+ */
+
+const callTimeout = 3 * time.Second
+
+type Client struct {
+	sync.Mutex
+	addrs   []string
+	cur     int
+	session kendynet.StreamSession
+	seqno   int64
+	pending map[int64]chan *net.Message
+	closed  bool
+}
+
+func NewClient(addrs []string) *Client {
+	return &Client{
+		addrs:   addrs,
+		pending: map[int64]chan *net.Message{},
+	}
+}
+
+func (this *Client) nextAddr() {
+	this.Lock()
+	this.cur = (this.cur + 1) % len(this.addrs)
+	session := this.session
+	this.session = nil
+	this.Unlock()
+	if nil != session {
+		session.Close("", 0)
+	}
+}
+
+//关闭后不再发起请求
+func (this *Client) Close() {
+	this.Lock()
+	this.closed = true
+	session := this.session
+	this.session = nil
+	this.Unlock()
+	if nil != session {
+		session.Close("", 0)
+	}
+}
+
+func (this *Client) onMessage(msg *net.Message) {
+	this.Lock()
+	ch, ok := this.pending[msg.GetHead().Seqno]
+	if ok {
+		delete(this.pending, msg.GetHead().Seqno)
+	}
+	this.Unlock()
+	if ok {
+		ch <- msg
+	}
+}
+
+func (this *Client) getSession() (kendynet.StreamSession, error) {
+	this.Lock()
+	defer this.Unlock()
+
+	if this.closed {
+		return nil, fmt.Errorf("client closed")
+	}
+
+	if nil != this.session {
+		return this.session, nil
+	}
+
+	session, compress, err := net.NewConnector("tcp", this.addrs[this.cur], false).Dial(time.Second)
+	if nil != err {
+		this.cur = (this.cur + 1) % len(this.addrs)
+		return nil, err
+	}
+
+	this.session = session
+	session.SetReceiver(net.NewReceiver(pb.GetNamespace("response"), compress))
+	session.SetEncoder(net.NewEncoder(pb.GetNamespace("request"), compress))
+	session.SetCloseCallBack(func(sess kendynet.StreamSession, reason string) {
+		this.Lock()
+		if this.session == sess {
+			this.session = nil
+		}
+		this.Unlock()
+	})
+	session.Start(func(event *kendynet.Event) {
+		if event.EventType == kendynet.EventTypeError {
+			event.Session.Close(event.Data.(error).Error(), 0)
+		} else {
+			this.onMessage(event.Data.(*net.Message))
+		}
+	})
+
+	return session, nil
+}
+
+func (this *Client) call(req proto.Message) (*net.Message, error) {
+	session, err := this.getSession()
+	if nil != err {
+		return nil, err
+	}
+
+	ch := make(chan *net.Message, 1)
+
+	this.Lock()
+	this.seqno++
+	seqno := this.seqno
+	this.pending[seqno] = ch
+	this.Unlock()
+
+	err = session.Send(net.NewMessage(net.CommonHead{
+		Seqno:   seqno,
+		Timeout: uint32(callTimeout / time.Millisecond),
+	}, req))
+
+	if nil == err {
+		select {
+		case resp := <-ch:
+			if errcode.ERR_NOT_LEADER == resp.GetHead().ErrCode {
+				this.nextAddr()
+			}
+			return resp, nil
+		case <-time.After(callTimeout):
+			err = fmt.Errorf("timeout")
+		}
+	}
+
+	this.Lock()
+	delete(this.pending, seqno)
+	this.Unlock()
+
+	this.nextAddr()
+
+	return nil, err
+}
+
+//上报必须发给kvpd leader
+func (this *Client) Report(req *protocol.ReportRegionReq) error {
+	resp, err := this.call(req)
+	if nil != err {
+		return err
+	}
+
+	if errCode := resp.GetHead().ErrCode; errcode.ERR_OK != errCode {
+		if s := resp.GetData().(*protocol.ReportRegionResp).GetErr(); "" != s {
+			return fmt.Errorf("%s", s)
+		}
+		return fmt.Errorf("%s", errcode.GetErrorStr(errCode))
+	}
+
+	return nil
+}
+
+func (this *Client) QueryRoute() (*protocol.QueryRouteResp, error) {
+	resp, err := this.call(&protocol.QueryRouteReq{})
+	if nil != err {
+		return nil, err
+	}
+
+	if errCode := resp.GetHead().ErrCode; errcode.ERR_OK != errCode {
+		return nil, fmt.Errorf("%s", errcode.GetErrorStr(errCode))
+	}
+
+	return resp.GetData().(*protocol.QueryRouteResp), nil
+}
@@ -0,0 +1,40 @@
+package kvpd
+
+import (
+	"github.com/BurntSushi/toml"
+	"sync/atomic"
+	"unsafe"
+)
+
+var (
+	defConfig *Config
+)
+
+func LoadConfig(path string) error {
+	config := &Config{}
+	_, err := toml.DecodeFile(path, config)
+	if nil != err {
+		return err
+	} else {
+		atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&defConfig)), unsafe.Pointer(config))
+		return nil
+	}
+}
+
+func GetConfig() *Config {
+	return (*Config)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&defConfig))))
+}
+
+type Config struct {
+	Host            string //kvnode上报及kvproxy查询的服务地址
+	BalanceInterval int    //检查成员及leader分布的间隔(秒),默认5秒
+	NodeTimeout     int    //超过该时间(秒)没有上报的kvnode视为离线,默认5秒
+
+	Log struct {
+		MaxLogfileSize  int
+		LogDir          string
+		LogPrefix       string
+		LogLevel        string
+		EnableLogStdout bool
+	}
+}
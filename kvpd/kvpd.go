@@ -0,0 +1,257 @@
+package kvpd
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/net/pb"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/kendynet"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ * placement driver
+ * kvnode定期向kvpd leader上报服务地址及所见的region leader,kvpd将变化通过raft复制。
+ * kvproxy可以向任意kvpd副本查询路由，follower返回已应用的状态。
+ * kvpd leader根据上报将以-join启动的kvnode加入各region,并在leader分布不均时发起leader转移。
+ */
+
+//leader上记录的kvnode上报，不复制
+type nodeStatus struct {
+	lastReport time.Time
+	join       bool
+	service    string
+	raftUrl    string
+	regions    map[int]int //region -> 所见leader,0表示未知
+}
+
+type KVPD struct {
+	id       int
+	rn       *raftNode
+	state    *pdState
+	listener *net.Listener
+	stoped   int32
+
+	muStatus sync.Mutex
+	status   map[int]*nodeStatus
+
+	balancer *balancer
+}
+
+func verifyLogin(loginReq *protocol.LoginReq) bool {
+	return true
+}
+
+func (this *KVPD) isStoped() bool {
+	return atomic.LoadInt32(&this.stoped) == 1
+}
+
+func (this *KVPD) reply(session kendynet.StreamSession, seqno int64, errCode int32, resp proto.Message) {
+	session.Send(net.NewMessage(net.CommonHead{
+		Seqno:   seqno,
+		ErrCode: errCode,
+	}, resp))
+}
+
+func (this *KVPD) onReport(session kendynet.StreamSession, msg *net.Message) {
+	req := msg.GetData().(*protocol.ReportRegionReq)
+	head := msg.GetHead()
+
+	if !this.rn.isLeader() {
+		this.reply(session, head.Seqno, errcode.ERR_NOT_LEADER, &protocol.ReportRegionResp{})
+		return
+	}
+
+	nodeID := int(req.GetNodeId())
+	regionCount := int(req.GetRegionCount())
+
+	//region数量即slot的初始分配(SlotRegions),只会增加，小于已记录数量的上报说明节点的slot配置不一致
+	if count := this.state.getRegionCount(); count < regionCount {
+		this.rn.propose(encodeOperation(&operation{
+			Op:    op_region_count,
+			Count: regionCount,
+		}))
+	} else if count > regionCount {
+		this.reply(session, head.Seqno, errcode.ERR_OTHER, &protocol.ReportRegionResp{
+			Err: fmt.Sprintf("region count mismatch %d != %d", regionCount, count),
+		})
+		return
+	}
+
+	status := &nodeStatus{
+		lastReport: time.Now(),
+		join:       req.GetJoin(),
+		service:    req.GetService(),
+		raftUrl:    req.GetRaftUrl(),
+		regions:    map[int]int{},
+	}
+
+	for _, v := range req.GetRegions() {
+		status.regions[int(v.GetRegion())] = int(v.GetLeader())
+	}
+
+	this.muStatus.Lock()
+	this.status[nodeID] = status
+	this.muStatus.Unlock()
+
+	if n := this.state.getNode(nodeID); nil == n || n.Service != req.GetService() || n.RaftUrl != req.GetRaftUrl() {
+		this.rn.propose(encodeOperation(&operation{
+			Op: op_node,
+			Node: &nodeInfo{
+				ID:      nodeID,
+				Service: req.GetService(),
+				RaftUrl: req.GetRaftUrl(),
+			},
+		}))
+	}
+
+	for _, v := range req.GetRegions() {
+		if 0 == v.GetLeader() {
+			continue
+		}
+		r := this.state.getRoute(int(v.GetRegion()))
+		if nil == r || v.GetTerm() > r.Term || (v.GetTerm() == r.Term && int(v.GetLeader()) != r.Leader) {
+			this.rn.propose(encodeOperation(&operation{
+				Op:     op_leader,
+				Region: int(v.GetRegion()),
+				Leader: int(v.GetLeader()),
+				Term:   v.GetTerm(),
+			}))
+		}
+	}
+
+	this.reply(session, head.Seqno, errcode.ERR_OK, &protocol.ReportRegionResp{})
+}
+
+func (this *KVPD) onQueryRoute(session kendynet.StreamSession, msg *net.Message) {
+	this.reply(session, msg.GetHead().Seqno, errcode.ERR_OK, this.state.makeRouteResp())
+}
+
+//上报时间在NodeTimeout以内的kvnode
+func (this *KVPD) getAliveNodes() map[int]*nodeStatus {
+	timeout := time.Duration(GetConfig().NodeTimeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultNodeTimeout
+	}
+
+	now := time.Now()
+	nodes := map[int]*nodeStatus{}
+
+	this.muStatus.Lock()
+	defer this.muStatus.Unlock()
+
+	for k, v := range this.status {
+		if now.Sub(v.lastReport) < timeout {
+			nodes[k] = v
+		}
+	}
+
+	return nodes
+}
+
+func (this *KVPD) onMessage(session kendynet.StreamSession, msg *net.Message) {
+	switch msg.GetCmd() {
+	case uint16(protocol.CmdType_Ping):
+		session.Send(net.NewMessage(net.CommonHead{}, &protocol.PingResp{
+			Timestamp: time.Now().UnixNano(),
+		}))
+	case uint16(protocol.CmdType_ReportRegion):
+		this.onReport(session, msg)
+	case uint16(protocol.CmdType_QueryRoute):
+		this.onQueryRoute(session, msg)
+	default:
+		logger.Infoln("kvpd: unknown cmd", msg.GetCmd())
+	}
+}
+
+func (this *KVPD) startListener() error {
+	return this.listener.Serve(func(session kendynet.StreamSession, compress bool) {
+		go func() {
+			session.SetRecvTimeout(protocol.PingTime * 2)
+			session.SetReceiver(net.NewReceiver(pb.GetNamespace("request"), compress))
+			session.SetEncoder(net.NewEncoder(pb.GetNamespace("response"), compress))
+			session.Start(func(event *kendynet.Event) {
+				if event.EventType == kendynet.EventTypeError {
+					event.Session.Close(event.Data.(error).Error(), 0)
+				} else {
+					this.onMessage(session, event.Data.(*net.Message))
+				}
+			})
+		}()
+	})
+}
+
+func (this *KVPD) Start(id *int, cluster *string) error {
+
+	var err error
+
+	this.id = *id
+
+	peers := map[int]string{}
+
+	for _, v := range strings.Split(*cluster, ",") {
+		t := strings.Split(v, "@")
+		if len(t) != 2 {
+			return fmt.Errorf("invaild peer %s", v)
+		}
+		i, err := strconv.Atoi(t[0])
+		if nil != err {
+			return err
+		}
+		peers[i] = t[1]
+	}
+
+	if _, ok := peers[this.id]; !ok {
+		return fmt.Errorf("self not in cluster")
+	}
+
+	this.listener, err = net.NewListener("tcp", GetConfig().Host, verifyLogin)
+
+	if nil != err {
+		return err
+	}
+
+	this.rn = newRaftNode(this.id, peers, this.state.apply, this.state.getSnapshot, this.state.recoverFromSnapshot)
+
+	if err = this.rn.startRaft(); nil != err {
+		this.listener.Close()
+		return err
+	}
+
+	go func() {
+		err := this.startListener()
+		if nil != err {
+			logger.Errorf("kvpd.Start() error:%s\n", err.Error())
+		}
+		logger.Infoln("kvpd listener stop")
+	}()
+
+	go this.balancer.run()
+
+	logger.Infoln("kvpd start:", GetConfig().Host)
+
+	return nil
+}
+
+func (this *KVPD) Stop() {
+	if atomic.CompareAndSwapInt32(&this.stoped, 0, 1) {
+		this.listener.Close()
+		this.rn.stop()
+		logger.Infoln("kvpd stop ok")
+	}
+}
+
+func NewKvpd() *KVPD {
+	pd := &KVPD{
+		state:  newPdState(),
+		status: map[int]*nodeStatus{},
+	}
+	pd.balancer = newBalancer(pd)
+	return pd
+}
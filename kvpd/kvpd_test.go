@@ -0,0 +1,133 @@
+package kvpd
+
+import (
+	"github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/kendynet/golog"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+	"time"
+)
+
+func init() {
+	defConfig = &Config{Host: "127.0.0.1:20110"}
+	logger = golog.New("kvpd", golog.NewOutputLogger(os.TempDir(), "kvpd", 1024*1024*50))
+}
+
+func TestState(t *testing.T) {
+	s := newPdState()
+
+	s.apply(encodeOperation(&operation{Op: op_region_count, Count: 3}))
+	s.apply(encodeOperation(&operation{Op: op_region_count, Count: 4}))
+	assert.Equal(t, 4, s.getRegionCount())
+	//不会减少
+	s.apply(encodeOperation(&operation{Op: op_region_count, Count: 3}))
+	assert.Equal(t, 4, s.getRegionCount())
+
+	s.apply(encodeOperation(&operation{Op: op_node, Node: &nodeInfo{ID: 1, Service: "127.0.0.1:10018"}}))
+	s.apply(encodeOperation(&operation{Op: op_leader, Region: 1, Leader: 1, Term: 2}))
+
+	//迟到的上报不能覆盖
+	s.apply(encodeOperation(&operation{Op: op_leader, Region: 1, Leader: 2, Term: 1}))
+	assert.Equal(t, 1, s.getRoute(1).Leader)
+
+	s.apply(encodeOperation(&operation{Op: op_leader, Region: 1, Leader: 2, Term: 3}))
+	assert.Equal(t, 2, s.getRoute(1).Leader)
+
+	//相同term下不同的leader被拒绝
+	s.apply(encodeOperation(&operation{Op: op_leader, Region: 1, Leader: 3, Term: 3}))
+	assert.Equal(t, 2, s.getRoute(1).Leader)
+
+	s.apply(encodeOperation(&operation{Op: op_leader, Region: 2, Leader: 1, Term: 1}))
+
+	resp := s.makeRouteResp()
+	assert.Equal(t, int32(4), resp.GetRegionCount())
+	assert.Equal(t, 2, len(resp.GetRoutes()))
+	assert.Equal(t, int32(2), resp.GetRoutes()[0].GetLeader())
+	assert.Equal(t, "", resp.GetRoutes()[0].GetService())
+	assert.Equal(t, "127.0.0.1:10018", resp.GetRoutes()[1].GetService())
+}
+
+func TestStateSnapshot(t *testing.T) {
+	s := newPdState()
+	s.apply(encodeOperation(&operation{Op: op_region_count, Count: 3}))
+	s.apply(encodeOperation(&operation{Op: op_node, Node: &nodeInfo{ID: 1, Service: "127.0.0.1:10018"}}))
+	s.apply(encodeOperation(&operation{Op: op_leader, Region: 1, Leader: 1, Term: 2}))
+
+	ss := newPdState()
+	assert.Nil(t, ss.recoverFromSnapshot(s.getSnapshot()))
+	assert.Equal(t, 3, ss.getRegionCount())
+	assert.Equal(t, "127.0.0.1:10018", ss.getNode(1).Service)
+	assert.Equal(t, uint64(2), ss.getRoute(1).Term)
+	assert.Equal(t, s.makeRouteResp(), ss.makeRouteResp())
+
+	assert.NotNil(t, ss.recoverFromSnapshot([]byte("invaild")))
+}
+
+func TestKvpd(t *testing.T) {
+	os.RemoveAll("kvpd-1")
+	os.RemoveAll("kvpd-1-snap")
+	defer os.RemoveAll("kvpd-1")
+	defer os.RemoveAll("kvpd-1-snap")
+
+	id := 1
+	cluster := "1@http://127.0.0.1:22379"
+
+	pd := NewKvpd()
+	assert.Nil(t, pd.Start(&id, &cluster))
+
+	c := NewClient([]string{"127.0.0.1:20110"})
+
+	req := &proto.ReportRegionReq{
+		NodeId:      1,
+		Service:     "127.0.0.1:10018",
+		RaftUrl:     "http://127.0.0.1:12379",
+		RegionCount: 2,
+		Regions: []*proto.RegionInfo{
+			&proto.RegionInfo{Region: 1, Leader: 1, Term: 2},
+			&proto.RegionInfo{Region: 2, Leader: 0},
+		},
+	}
+
+	//等待kvpd选出leader
+	var err error
+	for i := 0; i < 50; i++ {
+		if err = c.Report(req); nil == err {
+			break
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+	assert.Nil(t, err)
+
+	var resp *proto.QueryRouteResp
+	for i := 0; i < 50; i++ {
+		resp, err = c.QueryRoute()
+		if nil == err && len(resp.GetRoutes()) > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), resp.GetRegionCount())
+	assert.Equal(t, 1, len(resp.GetRoutes()))
+	assert.Equal(t, "127.0.0.1:10018", resp.GetRoutes()[0].GetService())
+
+	//更大的region数量被接受
+	req.RegionCount = 3
+	assert.Nil(t, c.Report(req))
+	for i := 0; i < 50; i++ {
+		resp, err = c.QueryRoute()
+		if nil == err && 3 == resp.GetRegionCount() {
+			break
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+	assert.Equal(t, int32(3), resp.GetRegionCount())
+
+	//更小的region数量被拒绝
+	req.RegionCount = 2
+	assert.NotNil(t, c.Report(req))
+
+	pd.Stop()
+}
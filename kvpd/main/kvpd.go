@@ -0,0 +1,36 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/sniperHW/flyfish/kvpd"
+	futil "github.com/sniperHW/flyfish/util"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+func main() {
+
+	cluster := flag.String("cluster", "1@http://127.0.0.1:22379", "comma separated cluster peers")
+	id := flag.Int("id", 1, "kvpd ID")
+	config := flag.String("config", "kvpd.toml", "config")
+
+	flag.Parse()
+
+	futil.Must(nil, kvpd.LoadConfig(*config))
+
+	kvpd.InitLogger()
+
+	pd := kvpd.NewKvpd()
+
+	if err := pd.Start(id, cluster); nil == err {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGINT) //监听指定信号
+		_ = <-c                          //阻塞直至有信号传入
+		pd.Stop()
+		fmt.Println("kvpd stop")
+	} else {
+		fmt.Println(err)
+	}
+}
@@ -0,0 +1,432 @@
+package kvpd
+
+import (
+	"context"
+	"fmt"
+	"github.com/sniperHW/flyfish/rafthttp"
+	"github.com/xiang90/probing"
+	"go.etcd.io/etcd/etcdserver/api/snap"
+	stats "go.etcd.io/etcd/etcdserver/api/v2stats"
+	"go.etcd.io/etcd/pkg/fileutil"
+	"go.etcd.io/etcd/pkg/types"
+	"go.etcd.io/etcd/raft"
+	"go.etcd.io/etcd/raft/raftpb"
+	"go.etcd.io/etcd/wal"
+	"go.etcd.io/etcd/wal/walpb"
+	"go.uber.org/zap"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+/*
+ * kvpd的raft副本
+ * kvpd的状态只在路由发生变化时才产生日志，每apply snapCount条日志对整个状态做一次快照并压缩日志,
+ * 重启时从最近的快照恢复后重放WAL中之后的日志。
+ * kvpd集群成员由启动参数指定，不支持成员变更。
+ */
+
+const (
+	tickInterval = 100 * time.Millisecond
+	electionTick = 10
+	maxSnapFiles = 5 //保留的快照及WAL文件数量
+)
+
+var defaultSnapshotCount uint64 = 1000
+var snapshotCatchUpEntriesN uint64 = 1000
+
+type raftNode struct {
+	id      int
+	peers   map[int]string
+	waldir  string
+	snapdir string
+
+	node        raft.Node
+	raftStorage *raft.MemoryStorage
+	wal         *wal.WAL
+	snapshotter *snap.Snapshotter
+	transport   *rafthttp.Transport
+	httpServer  *http.Server
+
+	confState     raftpb.ConfState
+	appliedIndex  uint64
+	snapshotIndex uint64
+	snapCount     uint64
+
+	proposeC    chan []byte
+	apply       func([]byte)
+	getSnapshot func() []byte
+	recover     func([]byte) error
+	stopc       chan struct{}
+	stopOnce    sync.Once
+
+	muLeader    sync.Mutex
+	leader      int
+	leaderSince time.Time //成为leader的时间
+}
+
+func newRaftNode(id int, peers map[int]string, apply func([]byte), getSnapshot func() []byte, recover func([]byte) error) *raftNode {
+	return &raftNode{
+		id:          id,
+		peers:       peers,
+		waldir:      fmt.Sprintf("kvpd-%d", id),
+		snapdir:     fmt.Sprintf("kvpd-%d-snap", id),
+		snapCount:   defaultSnapshotCount,
+		proposeC:    make(chan []byte, 1024),
+		apply:       apply,
+		getSnapshot: getSnapshot,
+		recover:     recover,
+		stopc:       make(chan struct{}),
+	}
+}
+
+func (rc *raftNode) isLeader() bool {
+	rc.muLeader.Lock()
+	defer rc.muLeader.Unlock()
+	return rc.leader == rc.id
+}
+
+//成为leader的时长,不是leader返回0
+func (rc *raftNode) leaderDuration() time.Duration {
+	rc.muLeader.Lock()
+	defer rc.muLeader.Unlock()
+	if rc.leader != rc.id {
+		return 0
+	}
+	return time.Now().Sub(rc.leaderSince)
+}
+
+//队列满时丢弃，kvnode会再次上报
+func (rc *raftNode) propose(data []byte) bool {
+	select {
+	case rc.proposeC <- data:
+		return true
+	default:
+		return false
+	}
+}
+
+func (rc *raftNode) loadSnapshot() *raftpb.Snapshot {
+	snapshot, err := rc.snapshotter.Load()
+	if err != nil && err != snap.ErrNoSnapshot {
+		logger.Fatalf("kvpd: error loading snapshot (%v)", err)
+	}
+	return snapshot
+}
+
+func (rc *raftNode) openWAL(snapshot *raftpb.Snapshot) *wal.WAL {
+	if !wal.Exist(rc.waldir) {
+		if err := os.Mkdir(rc.waldir, 0750); err != nil {
+			logger.Fatalf("kvpd: cannot create dir for wal (%v)", err)
+		}
+
+		w, err := wal.Create(zap.NewExample(), rc.waldir, nil)
+		if err != nil {
+			logger.Fatalf("kvpd: create wal error (%v)", err)
+		}
+		w.Close()
+	}
+
+	walsnap := walpb.Snapshot{}
+	if snapshot != nil {
+		walsnap.Index, walsnap.Term = snapshot.Metadata.Index, snapshot.Metadata.Term
+	}
+
+	w, err := wal.Open(zap.NewExample(), rc.waldir, walsnap)
+	if err != nil {
+		logger.Fatalf("kvpd: error loading wal (%v)", err)
+	}
+
+	return w
+}
+
+//从快照恢复状态后重放WAL,快照之后已提交的日志在启动后由raft重新交给apply
+func (rc *raftNode) replayWAL() *wal.WAL {
+	snapshot := rc.loadSnapshot()
+	w := rc.openWAL(snapshot)
+	_, st, ents, err := w.ReadAll()
+	if err != nil {
+		logger.Fatalf("kvpd: failed to read WAL (%v)", err)
+	}
+	logger.Infof("replaying WAL of member %d ents:%d", rc.id, len(ents))
+	rc.raftStorage = raft.NewMemoryStorage()
+	if snapshot != nil {
+		rc.raftStorage.ApplySnapshot(*snapshot)
+		if err := rc.recover(snapshot.Data); nil != err {
+			logger.Fatalf("kvpd: failed to recover from snapshot (%v)", err)
+		}
+	}
+	rc.raftStorage.SetHardState(st)
+	rc.raftStorage.Append(ents)
+	return w
+}
+
+func (rc *raftNode) startRaft() error {
+	if !fileutil.Exist(rc.snapdir) {
+		if err := os.Mkdir(rc.snapdir, 0750); err != nil {
+			return err
+		}
+	}
+	rc.snapshotter = snap.New(zap.NewExample(), rc.snapdir)
+
+	oldwal := wal.Exist(rc.waldir)
+	rc.wal = rc.replayWAL()
+
+	c := &raft.Config{
+		ID:                        uint64(rc.id),
+		ElectionTick:              electionTick,
+		HeartbeatTick:             1,
+		Storage:                   rc.raftStorage,
+		MaxSizePerMsg:             math.MaxUint64,
+		MaxInflightMsgs:           256,
+		MaxUncommittedEntriesSize: 1 << 30,
+	}
+
+	if oldwal {
+		rc.node = raft.RestartNode(c)
+	} else {
+		rpeers := []raft.Peer{}
+		for k, _ := range rc.peers {
+			rpeers = append(rpeers, raft.Peer{ID: uint64(k)})
+		}
+		rc.node = raft.StartNode(c, rpeers)
+	}
+
+	rc.transport = &rafthttp.Transport{
+		Logger:      zap.NewExample(),
+		ID:          types.ID(rc.id),
+		ClusterID:   0x20000,
+		Raft:        rc,
+		Snapshotter: rc.snapshotter,
+		ServerStats: stats.NewServerStats("", ""),
+		LeaderStats: stats.NewLeaderStats(strconv.Itoa(rc.id)),
+		ErrorC:      make(chan error),
+	}
+
+	rc.transport.Start()
+
+	for k, v := range rc.peers {
+		if k != rc.id {
+			rc.transport.AddPeer(types.ID(k), []string{v})
+		}
+	}
+
+	if err := rc.serveRaft(); nil != err {
+		rc.transport.Stop()
+		rc.node.Stop()
+		rc.wal.Close()
+		return err
+	}
+
+	go rc.serveChannels()
+
+	//快照之前的文件只保留最近的几个
+	fileutil.PurgeFile(zap.NewExample(), rc.snapdir, "snap", maxSnapFiles, time.Minute, rc.stopc)
+	fileutil.PurgeFile(zap.NewExample(), rc.waldir, "wal", maxSnapFiles, time.Minute, rc.stopc)
+
+	return nil
+}
+
+func (rc *raftNode) serveRaft() error {
+	u, err := url.Parse(rc.peers[rc.id])
+	if err != nil {
+		return err
+	}
+
+	ln, err := net.Listen("tcp", u.Host)
+	if err != nil {
+		return err
+	}
+
+	t := rc.transport
+	mux := http.NewServeMux()
+	mux.Handle(rafthttp.RaftPrefix, rafthttp.NewPipelineHandler(t, rc, t.ClusterID))
+	mux.Handle(rafthttp.RaftStreamPrefix+"/", rafthttp.NewStreamHandler(t, t, rc, t.ID, t.ClusterID))
+	mux.Handle(rafthttp.RaftSnapshotPrefix, rafthttp.NewSnapshotHandler(t, rc, rc.snapshotter, t.ClusterID))
+	mux.Handle(rafthttp.ProbingPrefix+"/", probing.NewHandler())
+
+	rc.httpServer = &http.Server{Handler: mux}
+
+	go func() {
+		if err := rc.httpServer.Serve(ln); nil != err && err != http.ErrServerClosed {
+			logger.Errorln("kvpd: serve rafthttp error", err)
+		}
+	}()
+
+	logger.Infoln("serve", rc.peers[rc.id])
+
+	return nil
+}
+
+func (rc *raftNode) publishEntries(ents []raftpb.Entry) {
+	for i := range ents {
+		if ents[i].Index <= rc.appliedIndex {
+			continue
+		}
+		switch ents[i].Type {
+		case raftpb.EntryNormal:
+			if len(ents[i].Data) > 0 {
+				rc.apply(ents[i].Data)
+			}
+		case raftpb.EntryConfChange:
+			var cc raftpb.ConfChange
+			cc.Unmarshal(ents[i].Data)
+			rc.confState = *rc.node.ApplyConfChange(cc)
+		}
+		rc.appliedIndex = ents[i].Index
+	}
+}
+
+func (rc *raftNode) saveSnap(snap raftpb.Snapshot) error {
+	//先在WAL中记录快照位置，保证只从已经保存的快照位置打开WAL
+	walSnap := walpb.Snapshot{
+		Index: snap.Metadata.Index,
+		Term:  snap.Metadata.Term,
+	}
+	if err := rc.wal.SaveSnapshot(walSnap); err != nil {
+		return err
+	}
+	if err := rc.snapshotter.SaveSnap(snap); err != nil {
+		return err
+	}
+	return rc.wal.ReleaseLockTo(snap.Metadata.Index)
+}
+
+//应用从leader收到的快照
+func (rc *raftNode) publishSnapshot(snapshot raftpb.Snapshot) {
+	if snapshot.Metadata.Index <= rc.appliedIndex {
+		logger.Fatalf("kvpd: snapshot index [%d] should > appliedIndex [%d]", snapshot.Metadata.Index, rc.appliedIndex)
+	}
+
+	if err := rc.recover(snapshot.Data); nil != err {
+		logger.Fatalf("kvpd: failed to recover from snapshot (%v)", err)
+	}
+
+	rc.confState = snapshot.Metadata.ConfState
+	rc.snapshotIndex = snapshot.Metadata.Index
+	rc.appliedIndex = snapshot.Metadata.Index
+}
+
+//状态很小，直接在当前goroutine中序列化
+func (rc *raftNode) maybeTriggerSnapshot() {
+	if rc.appliedIndex-rc.snapshotIndex <= rc.snapCount {
+		return
+	}
+
+	logger.Infof("kvpd: start snapshot [applied index: %d | last snapshot index: %d]", rc.appliedIndex, rc.snapshotIndex)
+
+	snap, err := rc.raftStorage.CreateSnapshot(rc.appliedIndex, &rc.confState, rc.getSnapshot())
+	if err != nil {
+		logger.Fatalf("kvpd: create snapshot error (%v)", err)
+	}
+
+	if err := rc.saveSnap(snap); err != nil {
+		logger.Fatalf("kvpd: save snapshot error (%v)", err)
+	}
+
+	compactIndex := uint64(1)
+	if rc.appliedIndex > snapshotCatchUpEntriesN {
+		compactIndex = rc.appliedIndex - snapshotCatchUpEntriesN
+	}
+
+	if err := rc.raftStorage.Compact(compactIndex); err != nil && err != raft.ErrCompacted {
+		logger.Fatalf("kvpd: compact error (%v)", err)
+	}
+
+	rc.snapshotIndex = rc.appliedIndex
+}
+
+func (rc *raftNode) serveChannels() {
+	snap, err := rc.raftStorage.Snapshot()
+	if err != nil {
+		panic(err)
+	}
+	rc.confState = snap.Metadata.ConfState
+	rc.snapshotIndex = snap.Metadata.Index
+	rc.appliedIndex = snap.Metadata.Index
+
+	defer rc.wal.Close()
+
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	go func() {
+		for {
+			select {
+			case data := <-rc.proposeC:
+				rc.node.Propose(context.TODO(), data)
+			case <-rc.stopc:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ticker.C:
+			rc.node.Tick()
+
+		case rd := <-rc.node.Ready():
+			if rd.SoftState != nil {
+				rc.muLeader.Lock()
+				if int(rd.SoftState.Lead) == rc.id && rc.leader != rc.id {
+					rc.leaderSince = time.Now()
+					logger.Infoln("becomeLeader id:", rc.id)
+				}
+				rc.leader = int(rd.SoftState.Lead)
+				rc.muLeader.Unlock()
+			}
+
+			rc.wal.Save(rd.HardState, rd.Entries)
+
+			if !raft.IsEmptySnap(rd.Snapshot) {
+				rc.saveSnap(rd.Snapshot)
+				rc.raftStorage.ApplySnapshot(rd.Snapshot)
+				rc.publishSnapshot(rd.Snapshot)
+			}
+
+			rc.raftStorage.Append(rd.Entries)
+			rc.transport.Send(rd.Messages)
+			rc.publishEntries(rd.CommittedEntries)
+			rc.maybeTriggerSnapshot()
+			rc.node.Advance()
+
+		case err := <-rc.transport.ErrorC:
+			logger.Errorln("kvpd: transport error", err)
+			rc.stop()
+
+		case <-rc.stopc:
+			rc.httpServer.Close()
+			rc.transport.Stop()
+			rc.node.Stop()
+			return
+		}
+	}
+}
+
+func (rc *raftNode) stop() {
+	rc.stopOnce.Do(func() {
+		close(rc.stopc)
+	})
+}
+
+func (rc *raftNode) Process(ctx context.Context, m raftpb.Message) error {
+	return rc.node.Step(ctx, m)
+}
+
+func (rc *raftNode) IsIDRemoved(id uint64) bool {
+	return false
+}
+
+func (rc *raftNode) ReportUnreachable(id uint64) {
+	rc.node.ReportUnreachable(id)
+}
+
+func (rc *raftNode) ReportSnapshot(id uint64, status raft.SnapshotStatus) {
+	rc.node.ReportSnapshot(id, status)
+}
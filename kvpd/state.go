@@ -0,0 +1,194 @@
+package kvpd
+
+import (
+	"encoding/json"
+	"github.com/sniperHW/flyfish/proto"
+	"sort"
+	"sync"
+)
+
+/*
+ * 通过raft复制的路由状态:region数量,kvnode地址,每个region的leader。
+ * 同一region的leader只会被term更大的上报覆盖，避免迟到的上报使路由回退。
+ * raft保证同一term最多只有一个leader,相同term下不同leader的上报是错误的，拒绝并记录日志。
+ */
+
+const (
+	op_node         = 1 //更新kvnode地址
+	op_leader       = 2 //更新region的leader
+	op_region_count = 3 //设置region数量,只增加
+)
+
+type nodeInfo struct {
+	ID      int
+	Service string
+	RaftUrl string
+}
+
+type routeInfo struct {
+	Leader int
+	Term   uint64
+}
+
+type operation struct {
+	Op     int
+	Node   *nodeInfo `json:",omitempty"`
+	Region int       `json:",omitempty"`
+	Leader int       `json:",omitempty"`
+	Term   uint64    `json:",omitempty"`
+	Count  int       `json:",omitempty"`
+}
+
+type pdState struct {
+	sync.RWMutex
+	regionCount int
+	nodes       map[int]*nodeInfo
+	routes      map[int]*routeInfo
+}
+
+func newPdState() *pdState {
+	return &pdState{
+		nodes:  map[int]*nodeInfo{},
+		routes: map[int]*routeInfo{},
+	}
+}
+
+func (this *pdState) apply(data []byte) {
+	op := operation{}
+	if err := json.Unmarshal(data, &op); nil != err {
+		logger.Errorln("kvpd: invaild operation", err)
+		return
+	}
+
+	this.Lock()
+	defer this.Unlock()
+
+	switch op.Op {
+	case op_node:
+		if nil != op.Node {
+			this.nodes[op.Node.ID] = op.Node
+		}
+	case op_leader:
+		r := this.routes[op.Region]
+		if nil == r || op.Term > r.Term {
+			this.routes[op.Region] = &routeInfo{
+				Leader: op.Leader,
+				Term:   op.Term,
+			}
+		} else if op.Term == r.Term && op.Leader != r.Leader {
+			logger.Errorln("kvpd: conflicting leader report region", op.Region, "term", op.Term, "leader", r.Leader, "reported", op.Leader)
+		}
+	case op_region_count:
+		if op.Count > this.regionCount {
+			this.regionCount = op.Count
+		}
+	}
+}
+
+type pdSnapshot struct {
+	RegionCount int
+	Nodes       map[int]*nodeInfo
+	Routes      map[int]*routeInfo
+}
+
+func (this *pdState) getSnapshot() []byte {
+	this.RLock()
+	defer this.RUnlock()
+	b, _ := json.Marshal(&pdSnapshot{
+		RegionCount: this.regionCount,
+		Nodes:       this.nodes,
+		Routes:      this.routes,
+	})
+	return b
+}
+
+func (this *pdState) recoverFromSnapshot(data []byte) error {
+	snap := pdSnapshot{}
+	if err := json.Unmarshal(data, &snap); nil != err {
+		return err
+	}
+
+	this.Lock()
+	defer this.Unlock()
+	this.regionCount = snap.RegionCount
+	this.nodes = snap.Nodes
+	this.routes = snap.Routes
+	if nil == this.nodes {
+		this.nodes = map[int]*nodeInfo{}
+	}
+	if nil == this.routes {
+		this.routes = map[int]*routeInfo{}
+	}
+	return nil
+}
+
+func (this *pdState) getRegionCount() int {
+	this.RLock()
+	defer this.RUnlock()
+	return this.regionCount
+}
+
+func (this *pdState) getNode(id int) *nodeInfo {
+	this.RLock()
+	defer this.RUnlock()
+	return this.nodes[id]
+}
+
+func (this *pdState) getRoute(region int) *routeInfo {
+	this.RLock()
+	defer this.RUnlock()
+	return this.routes[region]
+}
+
+//region -> leader节点
+func (this *pdState) getLeaders() map[int]int {
+	this.RLock()
+	defer this.RUnlock()
+	leaders := map[int]int{}
+	for k, v := range this.routes {
+		leaders[k] = v.Leader
+	}
+	return leaders
+}
+
+func (this *pdState) makeRouteResp() *proto.QueryRouteResp {
+	this.RLock()
+	defer this.RUnlock()
+
+	resp := &proto.QueryRouteResp{
+		RegionCount: int32(this.regionCount),
+	}
+
+	for k, v := range this.routes {
+		r := &proto.RouteInfo{
+			Region: int32(k),
+			Leader: int32(v.Leader),
+		}
+		if n := this.nodes[v.Leader]; nil != n {
+			r.Service = n.Service
+		}
+		resp.Routes = append(resp.Routes, r)
+	}
+
+	sort.Slice(resp.Routes, func(i, j int) bool {
+		return resp.Routes[i].GetRegion() < resp.Routes[j].GetRegion()
+	})
+
+	for _, v := range this.nodes {
+		resp.Nodes = append(resp.Nodes, &proto.NodeInfo{
+			NodeId:  int32(v.ID),
+			Service: v.Service,
+		})
+	}
+
+	sort.Slice(resp.Nodes, func(i, j int) bool {
+		return resp.Nodes[i].GetNodeId() < resp.Nodes[j].GetNodeId()
+	})
+
+	return resp
+}
+
+func encodeOperation(op *operation) []byte {
+	b, _ := json.Marshal(op)
+	return b
+}
@@ -0,0 +1,68 @@
+package kvproxy
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/net/pb"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/kendynet"
+	"time"
+)
+
+/*
+ * 客户端的cancel携带的是客户端的seqno,需要替换成转发时使用的seqno,
+ * 并发送到请求被转发到的kvnode连接上(kvnode按连接记录请求)。拆分转发的MGet/MSet取消所有子请求
+ */
+func onCancel(session kendynet.StreamSession, req *kendynet.ByteBuffer, offset uint64) {
+
+	b, err := getPayload(req, offset)
+	if nil != err {
+		return
+	}
+
+	msg := &protocol.Cancel{}
+	if err = proto.Unmarshal(b, msg); nil != err {
+		logger.Infoln("unmarshal cancel error", err)
+		return
+	}
+
+	cli := session.GetUserData().(*clientSession)
+
+	pendings := []*pendingReq{}
+	cli.Lock()
+	for _, v := range msg.GetSeqs() {
+		if p, ok := cli.pending[v]; ok {
+			delete(cli.pending, v)
+			pendings = append(pendings, p)
+		}
+		if m, ok := cli.mutis[v]; ok {
+			delete(cli.mutis, v)
+			pendings = append(pendings, m.pendings...)
+		}
+	}
+	cli.Unlock()
+
+	if len(pendings) == 0 {
+		return
+	}
+
+	cancels := map[*Conn][]int64{}
+
+	for _, v := range pendings {
+		v.processor.Lock()
+		if _, ok := v.processor.pendingReqs[v.seqno]; ok && v.deadlineTimer.Cancel() {
+			delete(v.processor.pendingReqs, v.seqno)
+			cancels[v.conn] = append(cancels[v.conn], v.seqno)
+		}
+		v.processor.Unlock()
+	}
+
+	encoder := net.NewEncoder(pb.GetNamespace("request"), false)
+
+	for conn, seqs := range cancels {
+		o, _ := encoder.EnCode(net.NewMessage(net.CommonHead{}, &protocol.Cancel{Seqs: seqs}))
+		if bytes := o.Bytes(); nil != bytes {
+			conn.SendReq(time.Now().Add(time.Second), kendynet.NewByteBuffer(bytes))
+		}
+	}
+}
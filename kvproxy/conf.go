@@ -7,27 +7,52 @@ import (
 )
 
 var (
-	defConfig *Config
+	defConfig  *Config
+	configPath atomic.Value //最近一次加载的配置文件路径,重新加载时使用
 )
 
-func LoadConfig(path string) error {
+func decodeConfig(path string) (*Config, error) {
 	config := &Config{}
-	_, err := toml.DecodeFile(path, config)
+	if _, err := toml.DecodeFile(path, config); nil != err {
+		return nil, err
+	}
+	return config, nil
+}
+
+func setConfig(path string, config *Config) {
+	configPath.Store(path)
+	atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&defConfig)), unsafe.Pointer(config))
+}
+
+func LoadConfig(path string) error {
+	config, err := decodeConfig(path)
 	if nil != err {
 		return err
 	} else {
-		atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&defConfig)), unsafe.Pointer(config))
+		setConfig(path, config)
 		return nil
 	}
 }
 
+func getConfigPath() string {
+	if path, ok := configPath.Load().(string); ok {
+		return path
+	}
+	return ""
+}
+
 func GetConfig() *Config {
 	return (*Config)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&defConfig))))
 }
 
 type Config struct {
-	Host    string
-	KVNodes string
+	Host                 string
+	KVNodes              string                 //节点id:host:port,逗号分隔
+	Kvpd                 string                 //kvpd服务地址，逗号分隔，为空时不从kvpd获取路由
+	RegionCount          int                    //kvnode的region数量(CacheGroupSize),配置了kvpd时以kvpd为准
+	RouteRefreshInterval int                    //从kvpd刷新路由的间隔(秒)
+	Retry                map[string]RetryPolicy //命令名 -> 重试策略
+	RateLimit            RateLimitConfig
 
 	Log struct {
 		MaxLogfileSize  int
@@ -1,6 +1,7 @@
 package kvproxy
 
 import (
+	"fmt"
 	"github.com/sniperHW/flyfish/net"
 	protocol "github.com/sniperHW/flyfish/proto"
 	"github.com/sniperHW/kendynet"
@@ -28,6 +29,7 @@ type Conn struct {
 	proxy       *kvproxy
 	timer       *timer.Timer
 	nextPing    time.Time
+	closed      bool //节点已经从配置中移除
 }
 
 func openConn(proxy *kvproxy, serverID int, addr string, compress bool) *Conn {
@@ -44,18 +46,30 @@ func openConn(proxy *kvproxy, serverID int, addr string, compress bool) *Conn {
 
 func (this *Conn) Close() {
 	this.Lock()
+	this.closed = true
 	this.pendingSend = []*pendingMsg{}
-	if nil != this.session {
+	if session := this.session; nil != session {
 		this.Unlock()
-		this.session.Close("", 0)
+		session.Close("", 0)
 	} else {
 		this.Unlock()
 	}
 }
 
+func (this *Conn) isClosed() bool {
+	this.Lock()
+	defer this.Unlock()
+	return this.closed
+}
+
 func (this *Conn) onConnected(session kendynet.StreamSession) {
 	this.Lock()
 	this.dialing = false
+	if this.closed {
+		this.Unlock()
+		session.Close("", 0)
+		return
+	}
 	this.session = session
 	session.SetRecvTimeout(protocol.PingTime * 2)
 	this.session.SetSendQueueSize(maxSendQueueSize)
@@ -63,12 +77,13 @@ func (this *Conn) onConnected(session kendynet.StreamSession) {
 
 	this.session.SetCloseCallBack(func(sess kendynet.StreamSession, reason string) {
 		this.Lock()
-		defer this.Unlock()
 		this.session = nil
 		if nil != this.timer {
 			this.timer.Cancel()
 			this.timer = nil
 		}
+		this.Unlock()
+		this.proxy.onKvnodeClose(this)
 	})
 
 	this.session.Start(func(event *kendynet.Event) {
@@ -121,6 +136,10 @@ func (this *Conn) dial() {
 				return
 			} else {
 				logger.Errorln("dial error", this.addr, err)
+				if this.isClosed() {
+					return
+				}
+				this.proxy.router.onConnectFailed(this.serverID)
 				time.Sleep(1 * time.Second)
 			}
 		}
@@ -131,7 +150,9 @@ func (this *Conn) dial() {
 func (this *Conn) SendReq(sendDeadline time.Time, req *kendynet.ByteBuffer) error {
 	this.Lock()
 	defer this.Unlock()
-	if nil != this.session {
+	if this.closed {
+		return fmt.Errorf("conn closed")
+	} else if nil != this.session {
 		return this.session.SendMessage(req)
 	} else {
 		var err error
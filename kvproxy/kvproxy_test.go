@@ -0,0 +1,301 @@
+package kvproxy
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/net/pb"
+	"github.com/sniperHW/flyfish/partition"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/kendynet"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func encode(space string, head net.CommonHead, data proto.Message, compress bool) *kendynet.ByteBuffer {
+	m, _ := net.NewEncoder(pb.GetNamespace(space), compress).EnCode(net.NewMessage(head, data))
+	return kendynet.NewByteBuffer(m.Bytes())
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(RateLimit{Rate: 10, Burst: 2})
+	b.last = now
+
+	ok, _ := b.take(now)
+	assert.True(t, ok)
+	ok, _ = b.take(now)
+	assert.True(t, ok)
+	assert.False(t, b.full(now))
+
+	ok, wait := b.take(now)
+	assert.False(t, ok)
+	assert.True(t, wait > 0 && wait <= 100*time.Millisecond)
+
+	//归还的令牌可以再次取得
+	b.giveBack()
+	ok, _ = b.take(now)
+	assert.True(t, ok)
+
+	//回满后归还不超过Burst
+	now = now.Add(time.Second)
+	assert.True(t, b.full(now))
+	ok, _ = b.take(now)
+	assert.True(t, ok)
+	b.giveBack()
+	b.giveBack()
+	ok, _ = b.take(now)
+	assert.True(t, ok)
+	ok, _ = b.take(now)
+	assert.True(t, ok)
+	ok, _ = b.take(now)
+	assert.False(t, ok)
+
+	assert.Nil(t, getBucket(nil, RateLimit{}))
+	b = getBucket(nil, RateLimit{Rate: 5})
+	assert.Equal(t, 5, b.limit.Burst)
+	assert.True(t, b == getBucket(b, RateLimit{Rate: 5, Burst: 5}))
+	assert.False(t, b == getBucket(b, RateLimit{Rate: 6}))
+}
+
+func TestTableBucket(t *testing.T) {
+	limiter := newRateLimiter()
+	limits := map[string]RateLimit{
+		"users1": RateLimit{Rate: 1},
+		"*":      RateLimit{Rate: 2},
+	}
+
+	b1 := limiter.getTableBucket("users1", limits)
+	b2 := limiter.getTableBucket("users2", limits)
+	b3 := limiter.getTableBucket("users3", limits)
+
+	//未单独配置的表各自使用一个桶
+	assert.Equal(t, 1, b1.limit.Rate)
+	assert.Equal(t, 2, b2.limit.Rate)
+	assert.False(t, b2 == b3)
+	assert.True(t, b2 == limiter.getTableBucket("users2", limits))
+	assert.Equal(t, "table:users2", b2.name)
+
+	now := time.Now()
+	b2.take(now)
+
+	//只保留没有回满的桶
+	limiter.sweepTables(now)
+	assert.Equal(t, 1, len(limiter.tables))
+	assert.True(t, b2 == limiter.tables["users2"])
+
+	assert.Nil(t, limiter.getTableBucket("users2", map[string]RateLimit{}))
+	assert.Equal(t, 0, len(limiter.tables))
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxRetry: 5, BackoffMin: 10, BackoffMax: 50}
+	for i, max := range []int{10, 20, 40, 50, 50} {
+		for j := 0; j < 100; j++ {
+			d := backoff(policy, i)
+			assert.True(t, d >= time.Duration(max/2)*time.Millisecond && d <= time.Duration(max)*time.Millisecond, d)
+		}
+	}
+	assert.Equal(t, time.Duration(0), backoff(RetryPolicy{}, 1))
+}
+
+func TestIsRetryError(t *testing.T) {
+	assert.True(t, isRetryError(errcode.ERR_NOT_LEADER))
+	assert.True(t, isRetryError(errcode.ERR_BUSY))
+	//写入已经提交，重试会得到版本不匹配
+	assert.False(t, isRetryError(errcode.ERR_WRITEBACK_PENDING))
+	assert.False(t, isRetryError(errcode.ERR_VERSION_MISMATCH))
+}
+
+func TestCanResend(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		for _, slotVersion := range []int64{0, 1} {
+			head := net.CommonHead{
+				Seqno:       1,
+				UniKey:      "users1:sniperHW",
+				SlotVersion: slotVersion,
+			}
+
+			//压缩时payload需要超过1024字节
+			fields := []*protocol.Field{protocol.PackField("name", strings.Repeat("a", 2048))}
+
+			get := encode("request", head, &protocol.GetReq{}, compress)
+			assert.True(t, canResend(uint16(protocol.CmdType_Get), get))
+
+			//没有携带version的写命令不能重试
+			set := encode("request", head, &protocol.SetReq{Fields: fields}, compress)
+			assert.False(t, hasVersion(uint16(protocol.CmdType_Set), set))
+			assert.False(t, canResend(uint16(protocol.CmdType_Set), set))
+
+			set = encode("request", head, &protocol.SetReq{Fields: fields, Version: proto.Int64(1)}, compress)
+			assert.True(t, hasVersion(uint16(protocol.CmdType_Set), set))
+			assert.True(t, canResend(uint16(protocol.CmdType_Set), set))
+
+			incr := encode("request", head, &protocol.IncrByReq{Field: protocol.PackField("age", 1)}, compress)
+			assert.False(t, canResend(uint16(protocol.CmdType_IncrBy), incr))
+
+			del := encode("request", head, &protocol.DelReq{}, compress)
+			assert.False(t, canResend(uint16(protocol.CmdType_Del), del))
+
+			del = encode("request", head, &protocol.DelReq{Version: proto.Int64(1)}, compress)
+			assert.True(t, canResend(uint16(protocol.CmdType_Del), del))
+
+			kick := encode("request", head, &protocol.KickReq{}, compress)
+			assert.False(t, canResend(uint16(protocol.CmdType_Kick), kick))
+		}
+	}
+}
+
+func TestStripLeaderHint(t *testing.T) {
+	resp := encode("response", net.CommonHead{
+		Seqno:   7,
+		UniKey:  net.MakeLeaderHint(2, "127.0.0.1:10018"),
+		ErrCode: errcode.ERR_NOT_LEADER,
+	}, &protocol.SetResp{}, false)
+
+	lenHint, _ := resp.GetInt16(21)
+	ret := stripLeaderHint(resp)
+
+	seqno, _ := ret.GetInt64(5)
+	assert.Equal(t, int64(7), seqno)
+	l, _ := ret.GetInt16(21)
+	assert.Equal(t, int16(0), l)
+	size, _ := ret.GetUint32(0)
+	assert.Equal(t, uint32(ret.Len()-net.SizeLen), size)
+	assert.Equal(t, resp.Bytes()[23+int(lenHint):], ret.Bytes()[23:])
+
+	//其它错误码不修改
+	resp = encode("response", net.CommonHead{
+		Seqno:   7,
+		UniKey:  "users1:sniperHW",
+		ErrCode: errcode.ERR_RETRY,
+	}, &protocol.SetResp{}, false)
+	assert.True(t, resp == stripLeaderHint(resp))
+}
+
+func TestStampSlotVersion(t *testing.T) {
+	router := &reqRouter{}
+
+	req := encode("request", net.CommonHead{
+		Seqno:  1,
+		UniKey: "users1:sniperHW",
+	}, &protocol.SetReq{Version: proto.Int64(1)}, false)
+	origin := append([]byte{}, req.Bytes()...)
+
+	//没有slot表时不携带版本
+	router.stampSlotVersion(req)
+	assert.Equal(t, origin, req.Bytes())
+
+	router.slotTable = partition.NewWithRegions(5, []int{1, 2})
+	router.stampSlotVersion(req)
+
+	flag, _ := req.GetByte(4)
+	assert.True(t, flag&net.FlagSlotVersion != 0)
+	size, _ := req.GetUint32(0)
+	assert.Equal(t, uint32(req.Len()-net.SizeLen), size)
+	version, _ := req.GetInt64(req.Len() - net.SizeSlotVersion)
+	assert.Equal(t, int64(5), version)
+	assert.True(t, hasVersion(uint16(protocol.CmdType_Set), req))
+
+	//已经携带版本时覆盖
+	l := req.Len()
+	router.slotTable = partition.NewWithRegions(6, []int{1, 2})
+	router.stampSlotVersion(req)
+	assert.Equal(t, l, req.Len())
+	version, _ = req.GetInt64(req.Len() - net.SizeSlotVersion)
+	assert.Equal(t, int64(6), version)
+}
+
+func TestParseKVNodes(t *testing.T) {
+	nodes, err := parseKVNodes("1:127.0.0.1:10018, 2:127.0.0.1:10019")
+	assert.Nil(t, err)
+	assert.Equal(t, []nodeAddr{
+		nodeAddr{id: 1, addr: "127.0.0.1:10018"},
+		nodeAddr{id: 2, addr: "127.0.0.1:10019"},
+	}, nodes)
+
+	for _, v := range []string{"", "1:127.0.0.1", "0:127.0.0.1:10018", "a:127.0.0.1:10018", "1:127.0.0.1:10018,1:127.0.0.1:10019"} {
+		_, err = parseKVNodes(v)
+		assert.NotNil(t, err, v)
+	}
+}
+
+func TestSplitMuti(t *testing.T) {
+	getRegion := func(unikey string) int {
+		return map[string]int{"users1:a": 1, "users1:b": 2, "users1:c": 1}[unikey]
+	}
+
+	keys, subs := splitMuti(uint16(protocol.CmdType_MGet), &protocol.MgetReq{
+		Table:  "users1",
+		Keys:   []string{"a", "b", "c"},
+		Fields: []string{"age"},
+	}, getRegion)
+
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, 2, len(subs))
+	assert.Equal(t, "users1:a", subs[0].unikey)
+	assert.Equal(t, []int{0, 2}, subs[0].indexes)
+	assert.Equal(t, []string{"a", "c"}, subs[0].msg.(*protocol.MgetReq).GetKeys())
+	assert.Equal(t, []string{"age"}, subs[0].msg.(*protocol.MgetReq).GetFields())
+	assert.Equal(t, []string{"b"}, subs[1].msg.(*protocol.MgetReq).GetKeys())
+
+	//只属于一个region时不拆分
+	_, subs = splitMuti(uint16(protocol.CmdType_MSet), &protocol.MsetReq{
+		Table: "users1",
+		Items: []*protocol.MsetItem{&protocol.MsetItem{Key: "a"}, &protocol.MsetItem{Key: "c"}},
+	}, getRegion)
+	assert.Nil(t, subs)
+
+	keys, subs = splitMuti(uint16(protocol.CmdType_MSet), &protocol.MsetReq{
+		Table: "users1",
+		Items: []*protocol.MsetItem{&protocol.MsetItem{Key: "b"}, &protocol.MsetItem{Key: "a"}, &protocol.MsetItem{Key: "c"}},
+	}, getRegion)
+	assert.Equal(t, 2, len(subs))
+	assert.Equal(t, "b", subs[0].msg.(*protocol.MsetReq).GetItems()[0].GetKey())
+	assert.Equal(t, 2, len(subs[1].msg.(*protocol.MsetReq).GetItems()))
+
+	//按原请求的顺序合并,失败的子请求每一行使用子请求的错误码
+	m := newMutiReq(nil, 9, uint16(protocol.CmdType_MSet), keys, subs)
+	assert.False(t, m.onSubResp(1, errcode.ERR_OK, []*protocol.Row{&protocol.Row{Key: "a", Version: 1}, &protocol.Row{Key: "c", Version: 2}}))
+	assert.True(t, m.onSubResp(0, errcode.ERR_TIMEOUT, nil))
+	assert.False(t, m.onSubResp(0, errcode.ERR_OK, nil))
+
+	resp := m.makeResponse()
+	assert.Equal(t, int64(9), resp.GetHead().Seqno)
+	assert.Equal(t, int32(errcode.ERR_OK), resp.GetHead().ErrCode)
+	rows := resp.GetData().(*protocol.MsetResp).GetRows()
+	assert.Equal(t, "b", rows[0].GetKey())
+	assert.Equal(t, int32(errcode.ERR_TIMEOUT), rows[0].GetErrCode())
+	assert.Equal(t, int64(1), rows[1].GetVersion())
+	assert.Equal(t, "c", rows[2].GetKey())
+
+	//所有子请求都失败时返回错误码
+	m = newMutiReq(nil, 9, uint16(protocol.CmdType_MSet), keys, subs)
+	m.onSubResp(0, errcode.ERR_NOT_LEADER, nil)
+	m.onSubResp(1, errcode.ERR_RETRY, nil)
+	resp = m.makeResponse()
+	assert.Equal(t, int32(errcode.ERR_NOT_LEADER), resp.GetHead().ErrCode)
+	assert.Equal(t, 0, len(resp.GetData().(*protocol.MsetResp).GetRows()))
+}
+
+func TestGetMutiRows(t *testing.T) {
+	resp := encode("response", net.CommonHead{
+		Seqno:  7,
+		UniKey: "users1:a",
+	}, &protocol.MgetResp{Rows: []*protocol.Row{&protocol.Row{Key: "a", Version: 3}}}, true)
+
+	errCode, rows := getMutiRows(uint16(protocol.CmdType_MGet), resp)
+	assert.Equal(t, int32(errcode.ERR_OK), errCode)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, int64(3), rows[0].GetVersion())
+
+	resp = encode("response", net.CommonHead{
+		Seqno:   7,
+		ErrCode: errcode.ERR_RETRY,
+	}, &protocol.MgetResp{}, false)
+	errCode, rows = getMutiRows(uint16(protocol.CmdType_MGet), resp)
+	assert.Equal(t, int32(errcode.ERR_RETRY), errCode)
+	assert.Nil(t, rows)
+}
@@ -21,9 +21,18 @@ func main() {
 
 	proxy := kvproxy.NewKVProxy()
 	if nil != proxy && nil == proxy.Start() {
-		c := make(chan os.Signal)
-		signal.Notify(c, syscall.SIGINT) //监听指定信号
-		_ = <-c                          //阻塞直至有信号传入
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGINT, syscall.SIGHUP) //监听指定信号
+		for {
+			//阻塞直至有信号传入
+			if sig := <-c; sig == syscall.SIGHUP {
+				if err := proxy.Reload(""); nil != err {
+					fmt.Println("reload config error", err)
+				}
+			} else {
+				break
+			}
+		}
 		fmt.Println("kvproxy stop")
 	}
 }
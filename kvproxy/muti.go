@@ -0,0 +1,291 @@
+package kvproxy
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/net/pb"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/kendynet"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ * MGet/MSet的key可能属于不同的region,按region拆分成多个子请求分别转发到各region的leader,
+ * 所有子请求返回(或超时)后按原请求中key的顺序合并Rows返回给客户端。
+ * 子请求失败时该子请求中的每一行使用子请求的错误码,所有子请求都失败时返回第一个子请求的错误码。
+ */
+
+type mutiSub struct {
+	unikey  string
+	msg     proto.Message
+	indexes []int //子请求中的key在原请求中的位置
+}
+
+type mutiReq struct {
+	sync.Mutex
+	session  kendynet.StreamSession
+	oriSeqno int64
+	cmd      uint16
+	keys     []string
+	subs     []*mutiSub
+	pendings []*pendingReq //子请求,用于转发cancel
+	rows     []*protocol.Row
+	errCode  int32 //第一个失败的子请求的错误码
+	failed   int   //失败的子请求数量
+	count    int   //尚未返回的子请求数量
+}
+
+//按region拆分,只属于一个region时返回nil
+func splitMuti(cmd uint16, msg proto.Message, getRegion func(string) int) (keys []string, subs []*mutiSub) {
+	var table string
+	switch cmd {
+	case uint16(protocol.CmdType_MGet):
+		req := msg.(*protocol.MgetReq)
+		table = req.GetTable()
+		keys = req.GetKeys()
+	case uint16(protocol.CmdType_MSet):
+		req := msg.(*protocol.MsetReq)
+		table = req.GetTable()
+		for _, v := range req.GetItems() {
+			keys = append(keys, v.GetKey())
+		}
+	default:
+		return nil, nil
+	}
+
+	regions := map[int]*mutiSub{}
+	for i, key := range keys {
+		unikey := table + ":" + key
+		region := getRegion(unikey)
+		sub, ok := regions[region]
+		if !ok {
+			sub = &mutiSub{unikey: unikey}
+			regions[region] = sub
+			subs = append(subs, sub)
+		}
+		sub.indexes = append(sub.indexes, i)
+	}
+
+	if len(subs) <= 1 {
+		return keys, nil
+	}
+
+	for _, sub := range subs {
+		switch cmd {
+		case uint16(protocol.CmdType_MGet):
+			req := msg.(*protocol.MgetReq)
+			m := &protocol.MgetReq{
+				Table:  req.Table,
+				Fields: req.Fields,
+				All:    req.All,
+			}
+			for _, i := range sub.indexes {
+				m.Keys = append(m.Keys, req.Keys[i])
+			}
+			sub.msg = m
+		case uint16(protocol.CmdType_MSet):
+			req := msg.(*protocol.MsetReq)
+			m := &protocol.MsetReq{
+				Table: req.Table,
+			}
+			for _, i := range sub.indexes {
+				m.Items = append(m.Items, req.Items[i])
+			}
+			sub.msg = m
+		}
+	}
+
+	return keys, subs
+}
+
+func newMutiReq(session kendynet.StreamSession, oriSeqno int64, cmd uint16, keys []string, subs []*mutiSub) *mutiReq {
+	return &mutiReq{
+		session:  session,
+		oriSeqno: oriSeqno,
+		cmd:      cmd,
+		keys:     keys,
+		subs:     subs,
+		rows:     make([]*protocol.Row, len(keys)),
+		count:    len(subs),
+	}
+}
+
+//第i个子请求返回,返回true表示所有子请求都已返回
+func (this *mutiReq) onSubResp(i int, errCode int32, rows []*protocol.Row) bool {
+	this.Lock()
+	defer this.Unlock()
+
+	if this.count == 0 {
+		return false
+	}
+
+	indexes := this.subs[i].indexes
+
+	if errCode == errcode.ERR_OK && len(rows) != len(indexes) {
+		errCode = errcode.ERR_OTHER
+	}
+
+	if errCode != errcode.ERR_OK {
+		if this.failed == 0 {
+			this.errCode = errCode
+		}
+		this.failed++
+	}
+
+	for j, idx := range indexes {
+		if errCode == errcode.ERR_OK {
+			this.rows[idx] = rows[j]
+		} else {
+			this.rows[idx] = &protocol.Row{
+				Key:     this.keys[idx],
+				ErrCode: errCode,
+			}
+		}
+	}
+
+	this.count--
+	return this.count == 0
+}
+
+func (this *mutiReq) makeResponse() *net.Message {
+	head := net.CommonHead{
+		Seqno: this.oriSeqno,
+	}
+
+	var rows []*protocol.Row
+	if this.failed == len(this.subs) {
+		head.ErrCode = this.errCode
+	} else {
+		rows = this.rows
+	}
+
+	if this.cmd == uint16(protocol.CmdType_MGet) {
+		return net.NewMessage(head, &protocol.MgetResp{Rows: rows})
+	} else {
+		return net.NewMessage(head, &protocol.MsetResp{Rows: rows})
+	}
+}
+
+func (this *mutiReq) onSubDone(i int, errCode int32, rows []*protocol.Row) {
+	if this.onSubResp(i, errCode, rows) {
+		this.session.GetUserData().(*clientSession).removeMuti(this)
+		if err := this.session.Send(this.makeResponse()); nil != err {
+			logger.Infoln("send resp to client error", err.Error())
+		}
+	}
+}
+
+//解析子请求的响应
+func getMutiRows(cmd uint16, resp *kendynet.ByteBuffer) (int32, []*protocol.Row) {
+	errCode, err := resp.GetInt32(13)
+	if nil != err {
+		return errcode.ERR_OTHER, nil
+	} else if errCode != errcode.ERR_OK {
+		return errCode, nil
+	}
+
+	lenUnikey, err := resp.GetInt16(21)
+	if nil != err {
+		return errcode.ERR_OTHER, nil
+	}
+
+	b, err := getPayload(resp, 23+uint64(lenUnikey)+net.SizeCmd)
+	if nil != err {
+		return errcode.ERR_OTHER, nil
+	}
+
+	msg, err := pb.GetNamespace("response").Unmarshal(uint32(cmd), b)
+	if nil != err {
+		logger.Infoln("unmarshal muti resp error", err)
+		return errcode.ERR_OTHER, nil
+	}
+
+	switch msg.(type) {
+	case *protocol.MgetResp:
+		return errCode, msg.(*protocol.MgetResp).GetRows()
+	case *protocol.MsetResp:
+		return errCode, msg.(*protocol.MsetResp).GetRows()
+	default:
+		return errcode.ERR_OTHER, nil
+	}
+}
+
+//key属于多个region时拆分转发,返回false表示按普通请求转发
+func (this *kvproxy) onMutiReq(session kendynet.StreamSession, oriSeqno int64, timeout uint32, cmd uint16, req *kendynet.ByteBuffer, offset uint64) bool {
+	b, err := getPayload(req, offset)
+	if nil != err {
+		return false
+	}
+
+	msg, err := pb.GetNamespace("request").Unmarshal(uint32(cmd), b)
+	if nil != err {
+		return false
+	}
+
+	this.router.RLock()
+	keys, subs := splitMuti(cmd, msg, this.router.getRegion)
+	this.router.RUnlock()
+
+	if nil == subs {
+		return false
+	}
+
+	cli := session.GetUserData().(*clientSession)
+	m := newMutiReq(session, oriSeqno, cmd, keys, subs)
+	encoder := net.NewEncoder(pb.GetNamespace("request"), cli.compress)
+
+	for i, sub := range subs {
+		seqno := atomic.AddInt64(&this.seqno, 1)
+		o, _ := encoder.EnCode(net.NewMessage(net.CommonHead{
+			Seqno:   seqno,
+			UniKey:  sub.unikey,
+			Timeout: timeout,
+		}, sub.msg))
+
+		pReq := &pendingReq{
+			seqno:     seqno,
+			oriSeqno:  oriSeqno,
+			session:   session,
+			processor: this.processors[seqno%int64(len(this.processors))],
+			compress:  cli.compress,
+			deadline:  time.Now().Add(time.Duration(timeout) * time.Millisecond),
+			cmd:       cmd,
+			muti:      m,
+			mutiIndex: i,
+		}
+
+		if bytes := o.Bytes(); nil != bytes {
+			pReq.req = kendynet.NewByteBuffer(bytes)
+		}
+
+		m.pendings = append(m.pendings, pReq)
+	}
+
+	cli.addMuti(m)
+
+	for i, pReq := range m.pendings {
+		if nil == pReq.req || !pReq.processor.forwardMuti(pReq, subs[i].unikey, timeout) {
+			m.onSubDone(i, errcode.ERR_OTHER, nil)
+		}
+	}
+
+	return true
+}
+
+func (this *reqProcessor) forwardMuti(pReq *pendingReq, unikey string, timeout uint32) bool {
+	this.Lock()
+	defer this.Unlock()
+	conn, region, err := this.router.forward2kvnode(unikey, time.Now().Add(time.Duration(timeout/2)*time.Millisecond), pReq.req, pReq.compress)
+	if nil != err {
+		logger.Infoln("send to kvnode error", err.Error())
+		return false
+	}
+	pReq.conn = conn
+	pReq.region = region
+	pReq.deadlineTimer = this.timerMgr.Once(time.Duration(timeout)*time.Millisecond, nil, pReq.onTimeout, nil)
+	this.pendingReqs[pReq.seqno] = pReq
+	return true
+}
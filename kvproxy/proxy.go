@@ -2,6 +2,7 @@ package kvproxy
 
 import (
 	"fmt"
+	"github.com/sniperHW/flyfish/errcode"
 	"github.com/sniperHW/flyfish/net"
 	"github.com/sniperHW/flyfish/net/pb"
 	protocol "github.com/sniperHW/flyfish/proto"
@@ -17,6 +18,7 @@ import (
 const (
 	minSize        uint64 = net.SizeLen
 	initBufferSize uint64 = 1024 * 256
+	maxLeaderHops  int    = 3 //按leader提示转发的最大次数
 )
 
 func isPow2(size uint64) bool {
@@ -42,6 +44,56 @@ type pendingReq struct {
 	session       kendynet.StreamSession
 	deadlineTimer *timer.Timer
 	processor     *reqProcessor
+	conn          *Conn     //请求被转发到的kvnode连接
+	watch         *watchReq //watch请求
+	req           *kendynet.ByteBuffer
+	compress      bool
+	deadline      time.Time
+	region        int //unikey所属region,0表示未知
+	hops          int //按leader提示转发的次数
+	cmd           uint16
+	retry         int //已经重试的次数
+
+	muti      *mutiReq //按region拆分的MGet/MSet
+	mutiIndex int
+}
+
+//客户端连接
+type clientSession struct {
+	sync.Mutex
+	compress bool
+	pending  map[int64]*pendingReq //oriSeqno -> pendingReq,用于转发cancel
+	mutis    map[int64]*mutiReq    //oriSeqno -> 拆分转发的MGet/MSet
+	watches  map[int64]*watchReq   //oriSeqno -> watchReq
+	limit    *tokenBucket          //连接的限流桶,不限制时为nil
+}
+
+func (this *clientSession) addPending(req *pendingReq) {
+	this.Lock()
+	defer this.Unlock()
+	this.pending[req.oriSeqno] = req
+}
+
+func (this *clientSession) removePending(req *pendingReq) {
+	this.Lock()
+	defer this.Unlock()
+	if this.pending[req.oriSeqno] == req {
+		delete(this.pending, req.oriSeqno)
+	}
+}
+
+func (this *clientSession) addMuti(req *mutiReq) {
+	this.Lock()
+	defer this.Unlock()
+	this.mutis[req.oriSeqno] = req
+}
+
+func (this *clientSession) removeMuti(req *mutiReq) {
+	this.Lock()
+	defer this.Unlock()
+	if this.mutis[req.oriSeqno] == req {
+		delete(this.mutis, req.oriSeqno)
+	}
 }
 
 type kvproxy struct {
@@ -50,6 +102,11 @@ type kvproxy struct {
 	listener   *net.Listener
 	seqno      int64
 	respChan   chan *kendynet.ByteBuffer
+	watchMtx   sync.Mutex
+	watches    map[int64]*watchReq //seqno -> watchReq
+	retryStats retryStats
+	limiter    *rateLimiter
+	muReload   sync.Mutex //SIGHUP与ReloadConfig命令串行执行
 }
 
 func (this *pendingReq) onTimeout(_ *timer.Timer, _ interface{}) {
@@ -57,6 +114,10 @@ func (this *pendingReq) onTimeout(_ *timer.Timer, _ interface{}) {
 	defer this.processor.Unlock()
 	logger.Infoln("remove timeout req", this.seqno)
 	delete(this.processor.pendingReqs, this.seqno)
+	this.session.GetUserData().(*clientSession).removePending(this)
+	if nil != this.muti {
+		this.muti.onSubDone(this.mutiIndex, errcode.ERR_TIMEOUT, nil)
+	}
 }
 
 type reqProcessor struct {
@@ -64,16 +125,42 @@ type reqProcessor struct {
 	pendingReqs map[int64]*pendingReq
 	timerMgr    *timer.TimerMgr
 	router      *reqRouter
+	proxy       *kvproxy
 }
 
-func newReqProcessor(router *reqRouter) *reqProcessor {
+func newReqProcessor(proxy *kvproxy) *reqProcessor {
 	return &reqProcessor{
 		pendingReqs: map[int64]*pendingReq{},
 		timerMgr:    timer.NewTimerMgr(1),
-		router:      router,
+		router:      proxy.router,
+		proxy:       proxy,
 	}
 }
 
+//从offset开始的pb数据,去掉包尾的slot表版本并解压
+func getPayload(req *kendynet.ByteBuffer, offset uint64) ([]byte, error) {
+	flag, err := req.GetByte(4)
+	if nil != err {
+		return nil, err
+	}
+
+	size := req.Len() - offset
+	if flag&net.FlagSlotVersion != 0 {
+		size -= net.SizeSlotVersion
+	}
+
+	b, err := req.GetBytes(offset, size)
+	if nil != err {
+		return nil, err
+	}
+
+	if flag&net.FlagCompress != 0 {
+		return (&net.ZipUnCompressor{}).UnCompress(b)
+	}
+
+	return b, nil
+}
+
 func (this *reqProcessor) onReq(seqno int64, session kendynet.StreamSession, req *kendynet.ByteBuffer) {
 
 	var err error
@@ -92,17 +179,6 @@ func (this *reqProcessor) onReq(seqno int64, session kendynet.StreamSession, req
 		return
 	}
 
-	if 0 == lenUnikey {
-		return
-	}
-
-	if b, err = req.GetBytes(23, uint64(lenUnikey)); nil != err {
-		return
-	}
-
-	//unikey不会在函数作用域以外被使用,unsafe强转是安全的
-	unikey = *(*string)(unsafe.Pointer(&b))
-
 	if timeout, err = req.GetUint32(17); nil != err {
 		return
 	}
@@ -120,22 +196,82 @@ func (this *reqProcessor) onReq(seqno int64, session kendynet.StreamSession, req
 		return
 	}
 
+	if cmd == uint16(protocol.CmdType_Cancel) {
+		onCancel(session, req, 23+uint64(lenUnikey)+net.SizeCmd)
+		return
+	}
+
+	if cmd == uint16(protocol.CmdType_ReloadConfig) {
+		this.proxy.onReloadConfig(session, req, 23+uint64(lenUnikey)+net.SizeCmd)
+		return
+	}
+
+	if 0 == lenUnikey {
+		return
+	}
+
+	if b, err = req.GetBytes(23, uint64(lenUnikey)); nil != err {
+		return
+	}
+
+	//unikey不会在函数作用域以外被使用,unsafe强转是安全的
+	unikey = *(*string)(unsafe.Pointer(&b))
+
+	cli := session.GetUserData().(*clientSession)
+
+	//unwatch用于释放kvnode上的关注，不限流
+	if cmd != uint16(protocol.CmdType_UnWatch) {
+		if ok, retryAfter := this.proxy.limiter.take(cli, splitTable(unikey), cmd); !ok {
+			replyBusy(session, oriSeqno, cmd, retryAfter)
+			return
+		}
+	}
+
+	if cmd == uint16(protocol.CmdType_UnWatch) {
+		if r := this.proxy.onUnwatch(session, seqno, unikey, timeout, req, 23+uint64(lenUnikey)+net.SizeCmd); nil != r {
+			req = r
+		}
+	}
+
+	if cmd == uint16(protocol.CmdType_MGet) || cmd == uint16(protocol.CmdType_MSet) {
+		if this.proxy.onMutiReq(session, oriSeqno, timeout, cmd, req, 23+uint64(lenUnikey)+net.SizeCmd) {
+			return
+		}
+	}
+
 	//用seqno替换oriSeqno
 	req.PutInt64(5, seqno)
 
 	err = func() error {
 		this.Lock()
 		defer this.Unlock()
-		err := this.router.forward2kvnode(unikey, time.Now().Add(time.Duration(timeout/2)*time.Millisecond), req, session.GetUserData().(bool))
+		conn, region, err := this.router.forward2kvnode(unikey, time.Now().Add(time.Duration(timeout/2)*time.Millisecond), req, cli.compress)
 		if nil == err {
 			pReq := &pendingReq{
 				seqno:     seqno,
 				oriSeqno:  oriSeqno,
 				session:   session,
 				processor: this,
+				conn:      conn,
+				req:       req,
+				compress:  cli.compress,
+				deadline:  time.Now().Add(time.Duration(timeout) * time.Millisecond),
+				region:    region,
+				cmd:       cmd,
+			}
+			if cmd == uint16(protocol.CmdType_Watch) {
+				pReq.watch = &watchReq{
+					seqno:    seqno,
+					oriSeqno: oriSeqno,
+					unikey:   unikey,
+					session:  session,
+					conn:     conn,
+				}
+				this.proxy.addWatch(pReq.watch)
 			}
 			pReq.deadlineTimer = this.timerMgr.Once(time.Duration(timeout)*time.Millisecond, nil, pReq.onTimeout, nil)
 			this.pendingReqs[seqno] = pReq
+			cli.addPending(pReq)
 		}
 		return err
 	}()
@@ -151,9 +287,27 @@ func (this *reqProcessor) onResp(seqno int64, resp *kendynet.ByteBuffer) {
 	defer this.Unlock()
 	req, ok := this.pendingReqs[seqno]
 	if ok {
+		if this.followLeader(req, resp) || this.tryRetry(req, resp) {
+			return
+		}
 		//先删除定时器
 		if req.deadlineTimer.Cancel() {
 			delete(this.pendingReqs, seqno)
+			req.session.GetUserData().(*clientSession).removePending(req)
+			errCode, err := resp.GetInt32(13)
+			if nil != req.watch {
+				if nil != err || errCode != errcode.ERR_OK {
+					this.proxy.removeWatch(req.watch)
+				}
+			}
+			this.proxy.onRetryDone(req, errCode)
+			if nil != req.muti {
+				code, rows := getMutiRows(req.cmd, resp)
+				req.muti.onSubDone(req.mutiIndex, code, rows)
+				return
+			}
+			//未能转发的ERR_NOT_LEADER去掉leader提示，避免客户端绕过proxy直接连接kvnode
+			resp = stripLeaderHint(resp)
 			//用oriSeqno替换seqno
 			resp.PutInt64(5, req.oriSeqno)
 			if err := req.session.SendMessage(resp); nil != err {
@@ -167,6 +321,66 @@ func (this *reqProcessor) onResp(seqno int64, resp *kendynet.ByteBuffer) {
 	}
 }
 
+/*
+ * 收到ERR_NOT_LEADER时更新路由表，并按响应中的leader提示把请求转发到leader所在的kvnode
+ * 只转发可以重试的命令(与重试的规则相同),失去leadership时尚未提交的写入仍然可能被新leader提交
+ * 调用方持有processor的锁
+ */
+func (this *reqProcessor) followLeader(req *pendingReq, resp *kendynet.ByteBuffer) bool {
+	if errCode, err := resp.GetInt32(13); nil != err || errCode != errcode.ERR_NOT_LEADER {
+		return false
+	}
+
+	var hint string
+	if lenHint, err := resp.GetInt16(21); nil == err && lenHint > 0 {
+		if b, err := resp.GetBytes(23, uint64(lenHint)); nil == err {
+			hint = string(b)
+		}
+	}
+
+	nodeID, service, ok := net.ParseLeaderHint(hint)
+	if !ok {
+		this.router.clearLeader(req.region)
+		return false
+	}
+
+	this.router.setLeader(req.region, nodeID, service)
+
+	if nil != req.watch || req.hops >= maxLeaderHops || !canResend(req.cmd, req.req) {
+		return false
+	}
+
+	conn, err := this.router.forward2leader(nodeID, req.deadline, req.req, req.compress)
+	if nil != err {
+		return false
+	}
+
+	logger.Debugln("follow leader", req.seqno, "->", nodeID, service)
+
+	req.conn = conn
+	req.hops++
+	return true
+}
+
+func stripLeaderHint(resp *kendynet.ByteBuffer) *kendynet.ByteBuffer {
+	if errCode, err := resp.GetInt32(13); nil != err || errCode != errcode.ERR_NOT_LEADER {
+		return resp
+	}
+
+	lenHint, err := resp.GetInt16(21)
+	if nil != err || lenHint <= 0 {
+		return resp
+	}
+
+	b := resp.Bytes()
+	ret := kendynet.NewByteBuffer(uint64(len(b) - int(lenHint)))
+	ret.AppendBytes(b[:21])
+	ret.AppendInt16(0)
+	ret.AppendBytes(b[23+int(lenHint):])
+	ret.PutUint32(0, uint32(ret.Len()-net.SizeLen))
+	return ret
+}
+
 func verifyLogin(loginReq *protocol.LoginReq) bool {
 	return true
 }
@@ -176,6 +390,11 @@ func NewKVProxy() *kvproxy {
 	var err error
 	proxy := &kvproxy{
 		respChan: make(chan *kendynet.ByteBuffer, 10000),
+		watches:  map[int64]*watchReq{},
+		retryStats: retryStats{
+			counters: map[protocol.CmdType]*RetryCounter{},
+		},
+		limiter: newRateLimiter(),
 	}
 
 	if proxy.listener, err = net.NewListener("tcp", GetConfig().Host, verifyLogin); nil != err {
@@ -185,7 +404,7 @@ func NewKVProxy() *kvproxy {
 	proxy.router = newReqRounter(proxy)
 	proxy.processors = []*reqProcessor{}
 	for i := 0; i < runtime.NumCPU()*2; i++ {
-		proxy.processors = append(proxy.processors, newReqProcessor(proxy.router))
+		proxy.processors = append(proxy.processors, newReqProcessor(proxy))
 	}
 
 	return proxy
@@ -196,6 +415,10 @@ func (this *kvproxy) Start() error {
 		return fmt.Errorf("invaild listener")
 	}
 
+	go this.router.run()
+	go this.logRetryStats()
+	go this.logRateLimitStats()
+
 	for i := 0; i < runtime.NumCPU()*2; i++ {
 		go func() {
 			for {
@@ -204,8 +427,12 @@ func (this *kvproxy) Start() error {
 					return
 				}
 				if seqno, err := v.GetInt64(5); nil == err {
-					processor := this.processors[seqno%int64(len(this.processors))]
-					processor.onResp(seqno, v)
+					if isWatchNotify(v) {
+						this.onWatchNotify(seqno, v)
+					} else {
+						processor := this.processors[seqno%int64(len(this.processors))]
+						processor.onResp(seqno, v)
+					}
 				} else {
 					logger.Infoln("onResp but get seqno failed")
 				}
@@ -216,7 +443,15 @@ func (this *kvproxy) Start() error {
 	return this.listener.Serve(func(session kendynet.StreamSession, compress bool) {
 		go func() {
 			session.SetRecvTimeout(protocol.PingTime * 2)
-			session.SetUserData(compress)
+			session.SetUserData(&clientSession{
+				compress: compress,
+				pending:  map[int64]*pendingReq{},
+				mutis:    map[int64]*mutiReq{},
+				watches:  map[int64]*watchReq{},
+			})
+			session.SetCloseCallBack(func(sess kendynet.StreamSession, reason string) {
+				this.onClientClose(sess)
+			})
 			session.SetReceiver(NewReceiver())
 			session.SetEncoder(net.NewEncoder(pb.GetNamespace("response"), compress))
 			session.Start(func(event *kendynet.Event) {
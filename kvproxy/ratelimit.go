@@ -0,0 +1,284 @@
+package kvproxy
+
+import (
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/net/pb"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/kendynet"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ * 限流
+ * 按客户端连接、表及命令类型分别使用令牌桶限流，请求需要同时从三个桶中取得令牌。
+ * 超出限制的请求不转发，直接返回ERR_BUSY,响应head的Timeout字段为建议的重试间隔(毫秒)。
+ * 表名配置为"*"时作为未单独配置的表的限制，每个表使用独立的桶。
+ * 表名由客户端提供，为避免桶无限增加，已经回满的桶(与新建的桶等价)定期及桶数量翻倍时被丢弃。
+ * 命令名配置为"*"时作为未单独配置的命令的限制，每个命令使用独立的桶。
+ * 配置重新加载后桶按新的限制重建。
+ */
+
+type RateLimit struct {
+	Rate  int //每秒请求数,0表示不限制
+	Burst int //桶容量,默认等于Rate
+}
+
+type RateLimitConfig struct {
+	Session RateLimit            //每个客户端连接
+	Table   map[string]RateLimit //表名 -> 限制
+	Cmd     map[string]RateLimit //命令名 -> 限制
+}
+
+type tokenBucket struct {
+	sync.Mutex
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+	name   string //统计被拒绝请求时使用的维度名
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	return &tokenBucket{
+		limit:  limit,
+		tokens: float64(limit.Burst),
+		last:   time.Now(),
+	}
+}
+
+//取得一个令牌，失败时返回需要等待的时间
+func (this *tokenBucket) take(now time.Time) (bool, time.Duration) {
+	this.Lock()
+	defer this.Unlock()
+
+	rate := float64(this.limit.Rate)
+
+	if elapsed := now.Sub(this.last); elapsed > 0 {
+		this.tokens += elapsed.Seconds() * rate
+		if this.tokens > float64(this.limit.Burst) {
+			this.tokens = float64(this.limit.Burst)
+		}
+		this.last = now
+	}
+
+	if this.tokens >= 1 {
+		this.tokens--
+		return true, 0
+	}
+
+	return false, time.Duration((1 - this.tokens) / rate * float64(time.Second))
+}
+
+//归还未使用的令牌
+func (this *tokenBucket) giveBack() {
+	this.Lock()
+	defer this.Unlock()
+	if this.tokens+1 <= float64(this.limit.Burst) {
+		this.tokens++
+	}
+}
+
+//按now计算桶是否已经回满，回满的桶与新建的桶等价
+func (this *tokenBucket) full(now time.Time) bool {
+	this.Lock()
+	defer this.Unlock()
+	return this.tokens+now.Sub(this.last).Seconds()*float64(this.limit.Rate) >= float64(this.limit.Burst)
+}
+
+//limit变化时重建桶,不限制时返回nil
+func getBucket(b *tokenBucket, limit RateLimit) *tokenBucket {
+	if limit.Rate <= 0 {
+		return nil
+	}
+
+	if limit.Burst <= 0 {
+		limit.Burst = limit.Rate
+	}
+
+	if nil != b && b.limit == limit {
+		return b
+	}
+
+	return newTokenBucket(limit)
+}
+
+func lookupLimit(limits map[string]RateLimit, name string) RateLimit {
+	if l, ok := limits[name]; ok {
+		return l
+	}
+	return limits["*"]
+}
+
+const minSweepTables = 1024 //表的桶数量达到该值后才开始按数量触发清理
+
+type rateLimiter struct {
+	sync.Mutex
+	tables   map[string]*tokenBucket
+	sweepAt  int //表的桶数量达到sweepAt时清理
+	cmds     map[uint16]*tokenBucket
+	rejected map[string]*int64 //限流维度 -> 被拒绝的请求数
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		tables:   map[string]*tokenBucket{},
+		sweepAt:  minSweepTables,
+		cmds:     map[uint16]*tokenBucket{},
+		rejected: map[string]*int64{},
+	}
+}
+
+func (this *rateLimiter) getTableBucket(table string, limits map[string]RateLimit) *tokenBucket {
+	this.Lock()
+	defer this.Unlock()
+
+	old, ok := this.tables[table]
+	b := getBucket(old, lookupLimit(limits, table))
+	if nil == b {
+		delete(this.tables, table)
+	} else if b != old {
+		if !ok {
+			//table引用请求的缓冲区，作为key保存前需要复制
+			table = string([]byte(table))
+			if len(this.tables) >= this.sweepAt {
+				this.sweepTables(time.Now())
+			}
+		}
+		b.name = "table:" + table
+		this.tables[table] = b
+	}
+	return b
+}
+
+//丢弃已经回满的表的桶,调用方持有锁
+func (this *rateLimiter) sweepTables(now time.Time) {
+	for k, v := range this.tables {
+		if v.full(now) {
+			delete(this.tables, k)
+		}
+	}
+	if this.sweepAt = len(this.tables) * 2; this.sweepAt < minSweepTables {
+		this.sweepAt = minSweepTables
+	}
+}
+
+func (this *rateLimiter) getCmdBucket(cmd uint16, limits map[string]RateLimit) *tokenBucket {
+	this.Lock()
+	defer this.Unlock()
+	cmdName := protocol.CmdType(cmd).String()
+	old := this.cmds[cmd]
+	b := getBucket(old, lookupLimit(limits, cmdName))
+	if nil == b {
+		delete(this.cmds, cmd)
+	} else if b != old {
+		b.name = "cmd:" + cmdName
+		this.cmds[cmd] = b
+	}
+	return b
+}
+
+func (this *rateLimiter) reject(key string) {
+	this.Lock()
+	c, ok := this.rejected[key]
+	if !ok {
+		c = new(int64)
+		this.rejected[key] = c
+	}
+	this.Unlock()
+	atomic.AddInt64(c, 1)
+}
+
+/*
+ * 依次从客户端连接、表及命令的桶中取得令牌，任意一个失败时归还已经取得的令牌
+ * 返回false时同时返回建议的重试间隔
+ */
+func (this *rateLimiter) take(cli *clientSession, table string, cmd uint16) (bool, time.Duration) {
+	config := GetConfig().RateLimit
+
+	cli.Lock()
+	if b := getBucket(cli.limit, config.Session); b != cli.limit {
+		if nil != b {
+			b.name = "session"
+		}
+		cli.limit = b
+	}
+	sessionBucket := cli.limit
+	cli.Unlock()
+
+	buckets := [3]*tokenBucket{
+		sessionBucket,
+		this.getTableBucket(table, config.Table),
+		this.getCmdBucket(cmd, config.Cmd),
+	}
+
+	now := time.Now()
+
+	for i, b := range buckets {
+		if nil == b {
+			continue
+		}
+
+		if ok, wait := b.take(now); !ok {
+			for j := 0; j < i; j++ {
+				if nil != buckets[j] {
+					buckets[j].giveBack()
+				}
+			}
+			this.reject(b.name)
+			return false, wait
+		}
+	}
+
+	return true, 0
+}
+
+//限流维度 -> 被拒绝的请求数
+func (this *kvproxy) GetRateLimitStats() map[string]int64 {
+	this.limiter.Lock()
+	defer this.limiter.Unlock()
+	ret := map[string]int64{}
+	for k, v := range this.limiter.rejected {
+		ret[k] = atomic.LoadInt64(v)
+	}
+	return ret
+}
+
+//定期输出限流计数并清理表的桶
+func (this *kvproxy) logRateLimitStats() {
+	for {
+		time.Sleep(time.Minute)
+		this.limiter.Lock()
+		this.limiter.sweepTables(time.Now())
+		this.limiter.Unlock()
+		for k, v := range this.GetRateLimitStats() {
+			logger.Infoln("rate limit stats", k, "rejected", v)
+		}
+	}
+}
+
+func splitTable(unikey string) string {
+	if i := strings.IndexByte(unikey, ':'); i >= 0 {
+		return unikey[:i]
+	}
+	return ""
+}
+
+//返回ERR_BUSY,Timeout为建议的重试间隔
+func replyBusy(session kendynet.StreamSession, oriSeqno int64, cmd uint16, retryAfter time.Duration) {
+	resp, err := pb.GetNamespace("response").Unmarshal(uint32(cmd), nil)
+	if nil != err {
+		return
+	}
+
+	head := net.CommonHead{
+		Seqno:   oriSeqno,
+		ErrCode: errcode.ERR_BUSY,
+	}
+
+	head.SetRetryAfter(retryAfter)
+
+	session.Send(net.NewMessage(head, resp))
+}
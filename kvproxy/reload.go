@@ -0,0 +1,112 @@
+package kvproxy
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/kendynet"
+	"time"
+)
+
+/*
+ * 配置热加载
+ * 收到SIGHUP或ReloadConfig命令时重新加载配置文件,Host不能变更。
+ * ReloadConfig命令只能重新加载启动时的配置文件，失败原因只记录到日志，不返回给客户端。
+ * 新配置中KVNodes解析失败时保留原配置。
+ * 被移除节点上尚未返回的请求照常等待响应，全部完成或超过drainTimeout后关闭连接，
+ * 连接关闭后该节点上的watch以ERR_CONNECTION通知客户端。
+ */
+
+//path为空时重新加载最近一次加载的配置文件
+func (this *kvproxy) Reload(path string) error {
+	this.muReload.Lock()
+	defer this.muReload.Unlock()
+
+	if "" == path {
+		path = getConfigPath()
+	}
+
+	config, err := decodeConfig(path)
+	if nil != err {
+		return err
+	}
+
+	if config.Host != GetConfig().Host {
+		return fmt.Errorf("Host can't change")
+	}
+
+	if err = this.router.reload(config); nil != err {
+		return err
+	}
+
+	setConfig(path, config)
+
+	logger.Infoln("reload config", path)
+
+	return nil
+}
+
+//转发到conn且尚未返回的请求数量
+func (this *kvproxy) pendingCount(conn *Conn) int {
+	count := 0
+	for _, p := range this.processors {
+		p.Lock()
+		for _, v := range p.pendingReqs {
+			if v.conn == conn {
+				count++
+			}
+		}
+		p.Unlock()
+	}
+	return count
+}
+
+//等待被移除节点上的请求完成后关闭连接
+func (this *kvproxy) drain(node *kvnode) {
+	deadline := time.Now().Add(drainTimeout)
+	for time.Now().Before(deadline) {
+		if 0 == this.pendingCount(node.conn)+this.pendingCount(node.compressConn) {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	logger.Infoln("close kvnode", node.serverID, node.addr)
+	node.conn.Close()
+	node.compressConn.Close()
+}
+
+func (this *kvproxy) onReloadConfig(session kendynet.StreamSession, req *kendynet.ByteBuffer, offset uint64) {
+
+	oriSeqno, err := req.GetInt64(5)
+	if nil != err {
+		return
+	}
+
+	b, err := getPayload(req, offset)
+	if nil != err {
+		return
+	}
+
+	msg := &protocol.ReloadConfigReq{}
+	if err = proto.Unmarshal(b, msg); nil != err {
+		logger.Infoln("unmarshal reload config error", err)
+		return
+	}
+
+	go func() {
+		head := net.CommonHead{Seqno: oriSeqno}
+		resp := &protocol.ReloadConfigResp{}
+		if path := msg.GetPath(); "" != path && path != getConfigPath() {
+			logger.Infoln("reload config reject path", path, session.RemoteAddr())
+			head.ErrCode = errcode.ERR_OTHER
+			resp.Err = "path not allowed"
+		} else if err := this.Reload(""); nil != err {
+			logger.Infoln("reload config error", err)
+			head.ErrCode = errcode.ERR_OTHER
+			resp.Err = "reload config failed"
+		}
+		session.Send(net.NewMessage(head, resp))
+	}()
+}
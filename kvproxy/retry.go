@@ -0,0 +1,253 @@
+package kvproxy
+
+import (
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/net/pb"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/kendynet"
+	"github.com/sniperHW/kendynet/timer"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ * 重试
+ * kvnode返回ERR_RETRY,ERR_BUSY或未能按提示转发的ERR_NOT_LEADER时，在请求head中Timeout的时限内按策略重试，不把错误返回给客户端。
+ * 只重试幂等的命令:Get,以及携带version的写命令(已经执行的写入重试时版本号不再匹配，不会重复执行)。
+ * ERR_SLOT_VERSION表示请求没有执行，除watch外的命令都可以重试。
+ * 第n次重试前等待min(BackoffMin * 2^n,BackoffMax)毫秒，并随机抖动到[1/2,1]倍。等待后超出时限则直接返回错误。
+ */
+
+type RetryPolicy struct {
+	MaxRetry   int //最大重试次数,0表示不重试
+	BackoffMin int //首次重试前的等待(毫秒)
+	BackoffMax int //等待上限(毫秒)
+}
+
+var defaultRetryPolicy = RetryPolicy{
+	MaxRetry:   3,
+	BackoffMin: 10,
+	BackoffMax: 200,
+}
+
+//可以重试的命令,value表示是否需要携带version
+var retryCmds = map[protocol.CmdType]bool{
+	protocol.CmdType_Get:             false,
+	protocol.CmdType_Set:             true,
+	protocol.CmdType_SetNx:           true,
+	protocol.CmdType_IncrBy:          true,
+	protocol.CmdType_DecrBy:          true,
+	protocol.CmdType_CompareAndSet:   true,
+	protocol.CmdType_CompareAndSetNx: true,
+	protocol.CmdType_Del:             true,
+	protocol.CmdType_Unset:           true,
+}
+
+//命令的重试策略，未配置时使用默认策略
+func getRetryPolicy(cmd protocol.CmdType) RetryPolicy {
+	if p, ok := GetConfig().Retry[cmd.String()]; ok {
+		return p
+	}
+	return defaultRetryPolicy
+}
+
+func isRetryError(errCode int32) bool {
+	return errCode == errcode.ERR_RETRY || errCode == errcode.ERR_BUSY || errCode == errcode.ERR_NOT_LEADER
+}
+
+//写命令是否携带version
+func hasVersion(cmd uint16, req *kendynet.ByteBuffer) bool {
+	lenUnikey, err := req.GetInt16(21)
+	if nil != err {
+		return false
+	}
+
+	payload, err := getPayload(req, 23+uint64(lenUnikey)+net.SizeCmd)
+	if nil != err {
+		return false
+	}
+
+	msg, err := pb.GetNamespace("request").Unmarshal(uint32(cmd), payload)
+	if nil != err {
+		return false
+	}
+
+	switch msg.(type) {
+	case *protocol.SetReq:
+		return nil != msg.(*protocol.SetReq).Version
+	case *protocol.SetNxReq:
+		return nil != msg.(*protocol.SetNxReq).Version
+	case *protocol.IncrByReq:
+		return nil != msg.(*protocol.IncrByReq).Version
+	case *protocol.DecrByReq:
+		return nil != msg.(*protocol.DecrByReq).Version
+	case *protocol.CompareAndSetReq:
+		return nil != msg.(*protocol.CompareAndSetReq).Version
+	case *protocol.CompareAndSetNxReq:
+		return nil != msg.(*protocol.CompareAndSetNxReq).Version
+	case *protocol.DelReq:
+		return nil != msg.(*protocol.DelReq).Version
+	case *protocol.UnsetReq:
+		return nil != msg.(*protocol.UnsetReq).Version
+	default:
+		return false
+	}
+}
+
+//请求重复执行时不会产生副作用
+func canResend(cmd uint16, req *kendynet.ByteBuffer) bool {
+	needVersion, ok := retryCmds[protocol.CmdType(cmd)]
+	if !ok {
+		return false
+	}
+	return !needVersion || hasVersion(cmd, req)
+}
+
+//第retry次重试前的等待
+func backoff(policy RetryPolicy, retry int) time.Duration {
+	d := policy.BackoffMin
+	for i := 0; i < retry && d < policy.BackoffMax; i++ {
+		d *= 2
+	}
+	if d > policy.BackoffMax {
+		d = policy.BackoffMax
+	}
+	if d <= 0 {
+		return 0
+	}
+	d = d/2 + rand.Intn(d/2+1)
+	return time.Duration(d) * time.Millisecond
+}
+
+type RetryCounter struct {
+	Retry   int64 //发起的重试次数
+	Success int64 //重试后成功返回的请求数
+	GiveUp  int64 //重试后仍返回错误的请求数
+}
+
+type retryStats struct {
+	sync.Mutex
+	counters map[protocol.CmdType]*RetryCounter
+}
+
+func (this *retryStats) get(cmd protocol.CmdType) *RetryCounter {
+	this.Lock()
+	defer this.Unlock()
+	c, ok := this.counters[cmd]
+	if !ok {
+		c = &RetryCounter{}
+		this.counters[cmd] = c
+	}
+	return c
+}
+
+//命令名 -> 重试计数
+func (this *kvproxy) GetRetryStats() map[string]RetryCounter {
+	this.retryStats.Lock()
+	defer this.retryStats.Unlock()
+	ret := map[string]RetryCounter{}
+	for k, v := range this.retryStats.counters {
+		ret[k.String()] = RetryCounter{
+			Retry:   atomic.LoadInt64(&v.Retry),
+			Success: atomic.LoadInt64(&v.Success),
+			GiveUp:  atomic.LoadInt64(&v.GiveUp),
+		}
+	}
+	return ret
+}
+
+//定期输出重试计数
+func (this *kvproxy) logRetryStats() {
+	for {
+		time.Sleep(time.Minute)
+		for k, v := range this.GetRetryStats() {
+			logger.Infoln("retry stats", k, "retry", v.Retry, "success", v.Success, "giveup", v.GiveUp)
+		}
+	}
+}
+
+//响应返回给客户端前记录重试结果
+func (this *kvproxy) onRetryDone(req *pendingReq, errCode int32) {
+	if req.retry > 0 {
+		c := this.retryStats.get(protocol.CmdType(req.cmd))
+		if errcode.ERR_OK == errCode {
+			atomic.AddInt64(&c.Success, 1)
+		} else {
+			atomic.AddInt64(&c.GiveUp, 1)
+		}
+	}
+}
+
+/*
+ * 按策略发起重试，返回false表示不重试
+ * 调用方持有processor的锁
+ */
+func (this *reqProcessor) tryRetry(req *pendingReq, resp *kendynet.ByteBuffer) bool {
+	errCode, err := resp.GetInt32(13)
+	if nil != err {
+		return false
+	}
+
+	policy := getRetryPolicy(protocol.CmdType(req.cmd))
+
+	if errCode == errcode.ERR_SLOT_VERSION {
+		this.router.refreshSlotTable(req.conn.addr)
+		if nil != req.watch {
+			return false
+		}
+	} else if !isRetryError(errCode) {
+		return false
+	} else if !canResend(req.cmd, req.req) {
+		return false
+	}
+
+	if req.retry >= policy.MaxRetry {
+		return false
+	}
+
+	delay := backoff(policy, req.retry)
+	if !time.Now().Add(delay).Before(req.deadline) {
+		return false
+	}
+
+	req.retry++
+	atomic.AddInt64(&this.proxy.retryStats.get(protocol.CmdType(req.cmd)).Retry, 1)
+
+	this.timerMgr.Once(delay, nil, func(_ *timer.Timer, _ interface{}) {
+		this.retry(req)
+	}, nil)
+
+	return true
+}
+
+func (this *reqProcessor) retry(req *pendingReq) {
+	this.Lock()
+	defer this.Unlock()
+
+	//等待期间已经超时
+	if this.pendingReqs[req.seqno] != req {
+		return
+	}
+
+	lenUnikey, err := req.req.GetInt16(21)
+	if nil != err {
+		return
+	}
+
+	b, err := req.req.GetBytes(23, uint64(lenUnikey))
+	if nil != err {
+		return
+	}
+
+	conn, region, err := this.router.forward2kvnode(string(b), req.deadline, req.req, req.compress)
+	if nil != err {
+		logger.Infoln("retry send to kvnode error", err.Error())
+		return
+	}
+
+	req.conn = conn
+	req.region = region
+}
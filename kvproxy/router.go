@@ -1,12 +1,38 @@
 package kvproxy
 
 import (
+	"fmt"
+	"github.com/sniperHW/flyfish/kvpd"
+	"github.com/sniperHW/flyfish/partition"
+	protocol "github.com/sniperHW/flyfish/proto"
 	"github.com/sniperHW/kendynet"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
+/*
+ * 请求路由
+ * 按从kvnode获取的slot表计算unikey所属region,请求发往region的leader。
+ * 获取到slot表之前unikey所属region = partition.GetRegion(unikey, region数量)。
+ * region数量及leader从kvpd获取，未配置kvpd时使用配置的RegionCount,leader只能从ERR_NOT_LEADER的提示中获知。
+ * 以下情况更新路由表:
+ * 1.每隔RouteRefreshInterval秒从kvpd刷新路由，从kvnode刷新slot表。
+ * 2.收到ERR_NOT_LEADER,按提示更新region的leader并从kvpd刷新。
+ * 3.连接kvnode失败，删除以该节点为leader的记录并从kvpd刷新。
+ * leader未知时按unikey在配置的KVNodes中选择。
+ *
+ * 重新加载配置时保留地址不变的节点，为新增或地址变更的节点建立连接。
+ * 被移除的节点不再接收新请求，已经转发的请求完成(或超过drainTimeout)后关闭连接。
+ */
+
+const (
+	defaultRouteRefreshInterval = 5 * time.Second
+	drainTimeout                = 30 * time.Second
+)
+
 type kvnode struct {
 	serverID     int
 	addr         string
@@ -14,68 +40,313 @@ type kvnode struct {
 	conn         *Conn
 }
 
+func newKvnode(proxy *kvproxy, id int, addr string) *kvnode {
+	return &kvnode{
+		serverID:     id,
+		addr:         addr,
+		compressConn: openConn(proxy, id, addr, true),
+		conn:         openConn(proxy, id, addr, false),
+	}
+}
+
+func (this *kvnode) getConn(compress bool) *Conn {
+	if compress {
+		return this.compressConn
+	} else {
+		return this.conn
+	}
+}
+
 type reqRouter struct {
-	kvnodes []*kvnode
+	sync.RWMutex
+	proxy       *kvproxy
+	kvnodes     []*kvnode       //配置的kvnode
+	nodes       map[int]*kvnode //节点id -> kvnode,包括从kvpd获知的节点
+	leaders     map[int]int     //region -> leader节点id
+	regionCount int
+	slotTable   *partition.Table //从kvnode获取的slot表,未获取时为nil
+	kvpdAddrs   string
+	pd          *kvpd.Client
+	refreshing  int32
+	fetching    int32 //正在获取slot表
+}
+
+type nodeAddr struct {
+	id   int
+	addr string
+}
+
+//解析KVNodes配置:节点id:host:port,逗号分隔
+func parseKVNodes(s string) ([]nodeAddr, error) {
+	ret := []nodeAddr{}
+	ids := map[int]bool{}
+
+	for _, v := range strings.Split(s, ",") {
+
+		t := strings.Split(strings.TrimSpace(v), ":")
+
+		if len(t) != 3 {
+			return nil, fmt.Errorf("invaild node %s", v)
+		}
+
+		id, err := strconv.Atoi(t[0])
+
+		if nil != err || id <= 0 {
+			return nil, fmt.Errorf("invaild node id %s", v)
+		}
+
+		if ids[id] {
+			return nil, fmt.Errorf("duplicate node id %d", id)
+		}
+
+		ids[id] = true
+
+		ret = append(ret, nodeAddr{id: id, addr: t[1] + ":" + t[2]})
+	}
+
+	return ret, nil
 }
 
 func newReqRounter(proxy *kvproxy) *reqRouter {
 
 	r := &reqRouter{
+		proxy:   proxy,
 		kvnodes: []*kvnode{},
+		nodes:   map[int]*kvnode{},
+		leaders: map[int]int{},
 	}
 
-	kvnodes := strings.Split(GetConfig().KVNodes, ",")
+	if err := r.reload(GetConfig()); nil != err {
+		panic(err)
+	}
+
+	return r
+}
 
-	if len(kvnodes) == 0 {
-		panic("len(kvnodes) == 0")
+/*
+ * 按当前配置更新节点列表,region数量及kvpd地址
+ * 返回被移除的节点，由调用方关闭
+ */
+func (this *reqRouter) reloadNodes(config *Config) ([]*kvnode, error) {
+	addrs, err := parseKVNodes(config.KVNodes)
+	if nil != err {
+		return nil, err
 	}
 
-	for _, v := range kvnodes {
+	this.Lock()
+	defer this.Unlock()
 
-		t := strings.Split(v, ":")
+	removed := []*kvnode{}
+	kvnodes := []*kvnode{}
+	ids := map[int]bool{}
 
-		if len(t) != 3 {
-			panic("invaild nodes")
+	for _, v := range addrs {
+		ids[v.id] = true
+		node, ok := this.nodes[v.id]
+		if !ok || node.addr != v.addr {
+			if ok {
+				removed = append(removed, node)
+			}
+			logger.Infoln("add kvnode", v.id, v.addr)
+			node = newKvnode(this.proxy, v.id, v.addr)
+			this.nodes[v.id] = node
 		}
+		kvnodes = append(kvnodes, node)
+	}
 
-		id, err := strconv.Atoi(t[0])
+	for _, v := range this.kvnodes {
+		if !ids[v.serverID] {
+			removed = append(removed, v)
+			delete(this.nodes, v.serverID)
+		}
+	}
 
-		if nil != err {
-			panic("invaild id")
+	for _, v := range removed {
+		logger.Infoln("remove kvnode", v.serverID, v.addr)
+		for region, leader := range this.leaders {
+			if leader == v.serverID {
+				delete(this.leaders, region)
+			}
 		}
+	}
 
-		addr := t[1] + ":" + t[2]
+	this.kvnodes = kvnodes
 
-		r.kvnodes = append(r.kvnodes, &kvnode{
-			serverID:     id,
-			addr:         addr,
-			compressConn: openConn(proxy, id, addr, true),
-			conn:         openConn(proxy, id, addr, false),
-		})
+	if config.Kvpd != this.kvpdAddrs {
+		this.kvpdAddrs = config.Kvpd
+		if nil != this.pd {
+			this.pd.Close()
+		}
+		if "" != config.Kvpd {
+			this.pd = kvpd.NewClient(strings.Split(config.Kvpd, ","))
+		} else {
+			this.pd = nil
+		}
 	}
 
-	return r
+	if nil == this.pd {
+		this.regionCount = config.RegionCount
+	}
+
+	return removed, nil
 }
 
-func stringHash(s string) int {
-	var hash uint16
-	for _, c := range s {
+func (this *reqRouter) reload(config *Config) error {
+	removed, err := this.reloadNodes(config)
+	if nil != err {
+		return err
+	}
 
-		ch := uint16(c)
+	for _, v := range removed {
+		go this.proxy.drain(v)
+	}
+
+	this.refresh()
+	this.refreshSlotTable("")
+
+	return nil
+}
+
+//定期从kvpd刷新路由
+func (this *reqRouter) run() {
+	for {
+		this.refresh()
+		this.refreshSlotTable("")
+		interval := time.Duration(GetConfig().RouteRefreshInterval) * time.Second
+		if interval <= 0 {
+			interval = defaultRouteRefreshInterval
+		}
+		time.Sleep(interval)
+	}
+}
+
+//异步从kvpd刷新路由，同一时间只有一个刷新
+func (this *reqRouter) refresh() {
+	this.RLock()
+	pd := this.pd
+	this.RUnlock()
 
-		hash = hash + ((hash) << 5) + ch + (ch << 7)
+	if nil == pd || !atomic.CompareAndSwapInt32(&this.refreshing, 0, 1) {
+		return
 	}
 
-	return int(hash)
+	go func() {
+		defer atomic.StoreInt32(&this.refreshing, 0)
+		resp, err := pd.QueryRoute()
+		if nil != err {
+			logger.Infoln("query route from kvpd error", err)
+			return
+		}
+		this.updateRoute(resp)
+	}()
 }
 
-//根据unikey将req转发到合适的kvnode
-func (this *reqRouter) forward2kvnode(unikey string, sendDeadline time.Time, req *kendynet.ByteBuffer, compress bool) error {
-	code := stringHash(unikey)
-	node := this.kvnodes[code%len(this.kvnodes)]
-	if compress {
-		return node.compressConn.SendReq(sendDeadline, req)
+func (this *reqRouter) updateRoute(resp *protocol.QueryRouteResp) {
+	this.Lock()
+	defer this.Unlock()
+
+	if count := int(resp.GetRegionCount()); count > 0 {
+		this.regionCount = count
+	}
+
+	for _, v := range resp.GetNodes() {
+		id := int(v.GetNodeId())
+		if _, ok := this.nodes[id]; !ok && "" != v.GetService() {
+			logger.Infoln("add kvnode", id, v.GetService())
+			this.nodes[id] = newKvnode(this.proxy, id, v.GetService())
+		}
+	}
+
+	leaders := map[int]int{}
+	for _, v := range resp.GetRoutes() {
+		if _, ok := this.nodes[int(v.GetLeader())]; ok {
+			leaders[int(v.GetRegion())] = int(v.GetLeader())
+		}
+	}
+	this.leaders = leaders
+}
+
+//unikey所属region,slot表及region数量未知时返回0,调用方持有锁
+func (this *reqRouter) getRegion(unikey string) int {
+	if nil != this.slotTable {
+		return this.slotTable.GetRegion(unikey)
+	} else if this.regionCount > 0 {
+		return partition.GetRegion(unikey, this.regionCount)
 	} else {
-		return node.conn.SendReq(sendDeadline, req)
+		return 0
+	}
+}
+
+//ERR_NOT_LEADER提示了新的leader
+func (this *reqRouter) setLeader(region int, nodeID int, service string) {
+	this.Lock()
+	node, ok := this.nodes[nodeID]
+	if !ok && "" != service {
+		logger.Infoln("add kvnode", nodeID, service)
+		node = newKvnode(this.proxy, nodeID, service)
+		this.nodes[nodeID] = node
+	}
+
+	if region > 0 {
+		if nil != node {
+			this.leaders[region] = nodeID
+		} else {
+			delete(this.leaders, region)
+		}
 	}
+	this.Unlock()
+
+	this.refresh()
+}
+
+//ERR_NOT_LEADER没有提示leader
+func (this *reqRouter) clearLeader(region int) {
+	this.Lock()
+	delete(this.leaders, region)
+	this.Unlock()
+
+	this.refresh()
+}
+
+//连接kvnode失败
+func (this *reqRouter) onConnectFailed(nodeID int) {
+	this.Lock()
+	for k, v := range this.leaders {
+		if v == nodeID {
+			delete(this.leaders, k)
+		}
+	}
+	this.Unlock()
+
+	this.refresh()
+}
+
+//将req转发到指定节点
+func (this *reqRouter) forward2leader(nodeID int, sendDeadline time.Time, req *kendynet.ByteBuffer, compress bool) (*Conn, error) {
+	this.RLock()
+	node, ok := this.nodes[nodeID]
+	this.stampSlotVersion(req)
+	this.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown kvnode %d", nodeID)
+	}
+
+	conn := node.getConn(compress)
+	return conn, conn.SendReq(sendDeadline, req)
+}
+
+//根据unikey将req转发到region的leader,返回实际使用的连接及region
+func (this *reqRouter) forward2kvnode(unikey string, sendDeadline time.Time, req *kendynet.ByteBuffer, compress bool) (*Conn, int, error) {
+	this.RLock()
+	region := this.getRegion(unikey)
+	node, ok := this.nodes[this.leaders[region]]
+	if !ok {
+		node = this.kvnodes[partition.Hash(unikey)%len(this.kvnodes)]
+	}
+	this.stampSlotVersion(req)
+	this.RUnlock()
+
+	conn := node.getConn(compress)
+	return conn, region, conn.SendReq(sendDeadline, req)
 }
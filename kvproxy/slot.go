@@ -0,0 +1,138 @@
+package kvproxy
+
+import (
+	"fmt"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/net/pb"
+	"github.com/sniperHW/flyfish/partition"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/kendynet"
+	"math/rand"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ * slot表
+ * 从kvnode获取slot表计算unikey所属region,转发的请求在包尾携带slot表版本(net.FlagSlotVersion)。
+ * kvnode的slot表比请求新时返回ERR_SLOT_VERSION(请求没有执行),kvproxy从该kvnode更新slot表后重试。
+ * 获取到slot表之前按region数量分区。
+ */
+
+const fetchSlotTableTimeout = 3 * time.Second
+
+func fetchSlotTable(addr string) (*partition.Table, error) {
+	session, compress, err := net.NewConnector("tcp", addr, false).Dial(time.Second)
+	if nil != err {
+		return nil, err
+	}
+
+	defer session.Close("", 0)
+
+	ch := make(chan *net.Message, 1)
+
+	session.SetReceiver(net.NewReceiver(pb.GetNamespace("response"), compress))
+	session.SetEncoder(net.NewEncoder(pb.GetNamespace("request"), compress))
+	session.Start(func(event *kendynet.Event) {
+		if event.EventType == kendynet.EventTypeError {
+			event.Session.Close(event.Data.(error).Error(), 0)
+		} else if msg := event.Data.(*net.Message); msg.GetCmd() == uint16(protocol.CmdType_GetSlotTable) {
+			select {
+			case ch <- msg:
+			default:
+			}
+		}
+	})
+
+	if err = session.Send(net.NewMessage(net.CommonHead{
+		Timeout: uint32(fetchSlotTableTimeout / time.Millisecond),
+	}, &protocol.GetSlotTableReq{})); nil != err {
+		return nil, err
+	}
+
+	select {
+	case msg := <-ch:
+		if errCode := msg.GetHead().ErrCode; errcode.ERR_OK != errCode {
+			return nil, fmt.Errorf("%s", errcode.GetErrorStr(errCode))
+		}
+		resp := msg.GetData().(*protocol.GetSlotTableResp)
+		if len(resp.GetRegions()) == 0 {
+			return nil, fmt.Errorf("empty slot table")
+		}
+		regions := make([]int, 0, len(resp.GetRegions()))
+		for _, v := range resp.GetRegions() {
+			regions = append(regions, int(v))
+		}
+		return partition.NewWithRegions(resp.GetVersion(), regions), nil
+	case <-time.After(fetchSlotTableTimeout):
+		return nil, fmt.Errorf("timeout")
+	}
+}
+
+//异步获取slot表,优先从addr获取，失败时尝试配置的节点
+func (this *reqRouter) refreshSlotTable(addr string) {
+	if !atomic.CompareAndSwapInt32(&this.fetching, 0, 1) {
+		return
+	}
+
+	this.RLock()
+	addrs := []string{}
+	if "" != addr {
+		addrs = append(addrs, addr)
+	}
+	if n := len(this.kvnodes); n > 0 {
+		i := rand.Intn(n)
+		for j := 0; j < n; j++ {
+			addrs = append(addrs, this.kvnodes[(i+j)%n].addr)
+		}
+	}
+	this.RUnlock()
+
+	go func() {
+		defer atomic.StoreInt32(&this.fetching, 0)
+		for _, v := range addrs {
+			table, err := fetchSlotTable(v)
+			if nil == err {
+				this.setSlotTable(table)
+				return
+			}
+			logger.Infoln("fetch slot table error", v, err)
+		}
+	}()
+}
+
+//只接受更新的slot表
+func (this *reqRouter) setSlotTable(table *partition.Table) {
+	this.Lock()
+	defer this.Unlock()
+	if nil == this.slotTable || table.Version() > this.slotTable.Version() || table.SlotCount() != this.slotTable.SlotCount() {
+		logger.Infoln("update slot table version", table.Version(), "slot count", table.SlotCount())
+		this.slotTable = table
+	}
+}
+
+//在请求包尾写入slot表版本，重试的请求已经携带版本时覆盖,调用方持有锁
+func (this *reqRouter) stampSlotVersion(req *kendynet.ByteBuffer) {
+	var version int64
+	if nil != this.slotTable {
+		version = this.slotTable.Version()
+	}
+
+	flag, err := req.GetByte(4)
+	if nil != err {
+		return
+	}
+
+	if flag&net.FlagSlotVersion != 0 {
+		req.PutInt64(req.Len()-net.SizeSlotVersion, version)
+	} else if 0 != version {
+		size, err := req.GetUint32(0)
+		if nil != err {
+			return
+		}
+		req.AppendInt64(version)
+		req.PutUint32(0, size+net.SizeSlotVersion)
+		req.PutByte(4, flag|net.FlagSlotVersion)
+	}
+}
@@ -0,0 +1,173 @@
+package kvproxy
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/sniperHW/flyfish/errcode"
+	"github.com/sniperHW/flyfish/net"
+	"github.com/sniperHW/flyfish/net/pb"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"github.com/sniperHW/kendynet"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ * watch转发
+ * watch请求的响应返回后关注依然有效，kvnode使用watch请求的seqno推送watch_notify,
+ * 所以需要保存seqno到客户端连接的映射直到unwatch或客户端连接断开。
+ */
+
+type watchReq struct {
+	seqno    int64
+	oriSeqno int64
+	unikey   string
+	session  kendynet.StreamSession
+	conn     *Conn
+}
+
+func (this *kvproxy) addWatch(w *watchReq) {
+	this.watchMtx.Lock()
+	this.watches[w.seqno] = w
+	this.watchMtx.Unlock()
+	cli := w.session.GetUserData().(*clientSession)
+	cli.Lock()
+	cli.watches[w.oriSeqno] = w
+	cli.Unlock()
+}
+
+func (this *kvproxy) removeWatch(w *watchReq) {
+	this.watchMtx.Lock()
+	if this.watches[w.seqno] == w {
+		delete(this.watches, w.seqno)
+	}
+	this.watchMtx.Unlock()
+	cli := w.session.GetUserData().(*clientSession)
+	cli.Lock()
+	if cli.watches[w.oriSeqno] == w {
+		delete(cli.watches, w.oriSeqno)
+	}
+	cli.Unlock()
+}
+
+func (this *kvproxy) onWatchNotify(seqno int64, notify *kendynet.ByteBuffer) {
+	this.watchMtx.Lock()
+	w, ok := this.watches[seqno]
+	this.watchMtx.Unlock()
+	if ok {
+		if errCode, err := notify.GetInt32(13); nil != err || errCode != errcode.ERR_OK {
+			//kvnode上的关注已经失效
+			this.removeWatch(w)
+		}
+		notify.PutInt64(5, w.oriSeqno)
+		if err := w.session.SendMessage(notify); nil != err {
+			logger.Infoln("send watch notify to client error", err.Error())
+		}
+	}
+}
+
+func decodeUnwatch(req *kendynet.ByteBuffer, offset uint64) (*protocol.UnwatchReq, error) {
+	b, err := getPayload(req, offset)
+	if nil != err {
+		return nil, err
+	}
+
+	msg := &protocol.UnwatchReq{}
+	if err = proto.Unmarshal(b, msg); nil != err {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func encodeUnwatch(seqno int64, unikey string, timeout uint32, watchSeqno int64) *kendynet.ByteBuffer {
+	encoder := net.NewEncoder(pb.GetNamespace("request"), false)
+	o, err := encoder.EnCode(net.NewMessage(net.CommonHead{
+		Seqno:   seqno,
+		UniKey:  unikey,
+		Timeout: timeout,
+	}, &protocol.UnwatchReq{WatchSeqno: watchSeqno}))
+	if nil != err {
+		return nil
+	}
+	return kendynet.NewByteBuffer(o.Bytes())
+}
+
+/*
+ * 将unwatch中客户端watch请求的seqno替换成转发时使用的seqno,并移除映射。
+ * 返回nil表示无需替换
+ */
+func (this *kvproxy) onUnwatch(session kendynet.StreamSession, seqno int64, unikey string, timeout uint32, req *kendynet.ByteBuffer, offset uint64) *kendynet.ByteBuffer {
+	msg, err := decodeUnwatch(req, offset)
+	if nil != err {
+		logger.Infoln("decode unwatch error", err)
+		return nil
+	}
+
+	cli := session.GetUserData().(*clientSession)
+	cli.Lock()
+	w, ok := cli.watches[msg.GetWatchSeqno()]
+	cli.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	this.removeWatch(w)
+
+	return encodeUnwatch(seqno, unikey, timeout, w.seqno)
+}
+
+//客户端连接断开，通知kvnode移除连接上的所有关注
+func (this *kvproxy) onClientClose(session kendynet.StreamSession) {
+	cli, ok := session.GetUserData().(*clientSession)
+	if !ok {
+		return
+	}
+
+	cli.Lock()
+	watches := make([]*watchReq, 0, len(cli.watches))
+	for _, v := range cli.watches {
+		watches = append(watches, v)
+	}
+	cli.Unlock()
+
+	for _, w := range watches {
+		this.removeWatch(w)
+		if req := encodeUnwatch(atomic.AddInt64(&this.seqno, 1), w.unikey, 0, w.seqno); nil != req {
+			w.conn.SendReq(time.Now().Add(time.Second), req)
+		}
+	}
+}
+
+func isWatchNotify(resp *kendynet.ByteBuffer) bool {
+	lenUnikey, err := resp.GetInt16(21)
+	if nil != err {
+		return false
+	}
+	cmd, err := resp.GetUint16(23 + uint64(lenUnikey))
+	if nil != err {
+		return false
+	}
+	return cmd == uint16(protocol.CmdType_WatchNotify)
+}
+
+//到kvnode的连接断开，kvnode上的关注已经失效，通知客户端
+func (this *kvproxy) onKvnodeClose(conn *Conn) {
+	watches := []*watchReq{}
+	this.watchMtx.Lock()
+	for _, v := range this.watches {
+		if v.conn == conn {
+			watches = append(watches, v)
+		}
+	}
+	this.watchMtx.Unlock()
+
+	for _, w := range watches {
+		this.removeWatch(w)
+		w.session.Send(net.NewMessage(net.CommonHead{
+			Seqno:   w.oriSeqno,
+			UniKey:  w.unikey,
+			ErrCode: errcode.ERR_CONNECTION,
+		}, &protocol.WatchNotify{}))
+	}
+}
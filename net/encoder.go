@@ -10,11 +10,21 @@ import (
 )
 
 const (
-	SizeLen               = 4
-	SizeFlag              = 1
-	SizeCmd               = 2
-	minSize        uint64 = SizeLen
-	initBufferSize uint64 = 1024 * 256
+	SizeLen                = 4
+	SizeFlag               = 1
+	SizeCmd                = 2
+	SizeSlotVersion        = 8
+	minSize         uint64 = SizeLen
+	initBufferSize  uint64 = 1024 * 256
+)
+
+/*
+ * flag按位使用
+ * FlagSlotVersion:包尾(pb数据之后)携带int64的slot表版本,kvnode对版本落后的请求返回ERR_SLOT_VERSION
+ */
+const (
+	FlagCompress    = byte(1)
+	FlagSlotVersion = byte(2)
 )
 
 func isPow2(size uint64) bool {
@@ -75,7 +85,7 @@ func (this *outMessage) Bytes() []byte {
 
 	if this.compressor != nil && len(pbbytes) >= 1024 {
 		pbbytes, _ = this.compressor.Compress(pbbytes)
-		flag = byte(1)
+		flag = FlagCompress
 	}
 
 	sizeOfUniKey := len(this.head.UniKey)
@@ -83,6 +93,12 @@ func (this *outMessage) Bytes() []byte {
 	sizeOfHead := 8 + 4 + 4 + 2 + sizeOfUniKey //int64 + int32 + uint32 + int16
 
 	payloadLen = SizeFlag + SizeCmd + len(pbbytes) + sizeOfHead
+
+	if 0 != this.head.SlotVersion {
+		flag |= FlagSlotVersion
+		payloadLen += SizeSlotVersion
+	}
+
 	totalLen = SizeLen + payloadLen
 	if uint64(totalLen) > conf.MaxPacketSize {
 		kendynet.GetLogger().Errorln("packet too large totalLen", totalLen)
@@ -106,6 +122,9 @@ func (this *outMessage) Bytes() []byte {
 	buff.AppendUint16(uint16(cmd))
 	//写数据
 	buff.AppendBytes(pbbytes)
+	if 0 != this.head.SlotVersion {
+		buff.AppendInt64(this.head.SlotVersion)
+	}
 	return buff.Bytes()
 }
 
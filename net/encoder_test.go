@@ -0,0 +1,46 @@
+// +build !aio
+
+package net
+
+import (
+	"github.com/sniperHW/flyfish/net/pb"
+	protocol "github.com/sniperHW/flyfish/proto"
+	"strings"
+	"testing"
+)
+
+func TestSlotVersion(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		for _, version := range []int64{0, 1, 1 << 40} {
+			e := NewEncoder(pb.GetNamespace("request"), compress)
+			m, _ := e.EnCode(NewMessage(CommonHead{
+				Seqno:       1,
+				UniKey:      "users1:sniperHW",
+				Timeout:     100,
+				SlotVersion: version,
+			}, &protocol.GetReq{Fields: []string{strings.Repeat("a", 2048)}}))
+
+			b := m.Bytes()
+			if compress != (b[4]&FlagCompress != 0) {
+				t.Fatal("compress flag", b[4])
+			}
+
+			r := NewReceiver(pb.GetNamespace("request"), compress)
+			r.w = uint64(copy(r.buffer, b))
+
+			msg, err := r.unPack()
+			if nil != err || nil == msg {
+				t.Fatal("unpack error", err)
+			}
+
+			head := msg.(*Message).GetHead()
+			if head.SlotVersion != version || head.Seqno != 1 || head.Timeout != 100 {
+				t.Fatal("slot version", version, head)
+			}
+
+			if fields := msg.(*Message).GetData().(*protocol.GetReq).GetFields(); len(fields) != 1 || len(fields[0]) != 2048 {
+				t.Fatal("invaild data")
+			}
+		}
+	}
+}
@@ -1,16 +1,52 @@
 package net
 
 import (
+	"fmt"
 	"github.com/golang/protobuf/proto"
 	"github.com/sniperHW/flyfish/net/pb"
 	protocol "github.com/sniperHW/flyfish/proto"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type CommonHead struct {
-	Seqno   int64
-	UniKey  string
-	ErrCode int32
-	Timeout uint32
+	Seqno       int64
+	UniKey      string //ERR_NOT_LEADER响应中为leader提示
+	ErrCode     int32
+	Timeout     uint32 //ERR_BUSY响应中为建议的重试间隔
+	SlotVersion int64  //请求方slot表的版本,0表示不检查
+}
+
+//响应不使用Timeout,ERR_BUSY响应用于携带建议的重试间隔(毫秒),0表示没有建议
+func (this *CommonHead) SetRetryAfter(d time.Duration) {
+	this.Timeout = uint32((d + time.Millisecond - 1) / time.Millisecond)
+}
+
+func (this *CommonHead) GetRetryAfter() time.Duration {
+	return time.Duration(this.Timeout) * time.Millisecond
+}
+
+/*
+ * ERR_NOT_LEADER响应通过head.UniKey携带leader提示，格式为"节点id@服务地址"
+ * 服务地址未知时为空
+ */
+func MakeLeaderHint(nodeID int, service string) string {
+	return fmt.Sprintf("%d@%s", nodeID, service)
+}
+
+func ParseLeaderHint(hint string) (nodeID int, service string, ok bool) {
+	i := strings.Index(hint, "@")
+	if i <= 0 {
+		return
+	}
+
+	var err error
+	if nodeID, err = strconv.Atoi(hint[:i]); nil != err || nodeID <= 0 {
+		return 0, "", false
+	}
+
+	return nodeID, hint[i+1:], true
 }
 
 func (this *CommonHead) SplitUniKey() (table string, key string) {
@@ -56,6 +92,11 @@ func (this *Message) GetHead() CommonHead {
 	return this.head
 }
 
+//设置请求携带的slot表版本,0表示不携带
+func (this *Message) SetSlotVersion(version int64) {
+	this.head.SlotVersion = version
+}
+
 func init() {
 
 	requestSpace := pb.GetNamespace("request")
@@ -72,6 +113,23 @@ func init() {
 	requestSpace.Register(&protocol.KickReq{}, uint32(protocol.CmdType_Kick))
 	requestSpace.Register(&protocol.ReloadTableConfReq{}, uint32(protocol.CmdType_ReloadTableConf))
 	requestSpace.Register(&protocol.Cancel{}, uint32(protocol.CmdType_Cancel))
+	requestSpace.Register(&protocol.MgetReq{}, uint32(protocol.CmdType_MGet))
+	requestSpace.Register(&protocol.MsetReq{}, uint32(protocol.CmdType_MSet))
+	requestSpace.Register(&protocol.ScanReq{}, uint32(protocol.CmdType_Scan))
+	requestSpace.Register(&protocol.WatchReq{}, uint32(protocol.CmdType_Watch))
+	requestSpace.Register(&protocol.UnwatchReq{}, uint32(protocol.CmdType_UnWatch))
+	requestSpace.Register(&protocol.TxnReq{}, uint32(protocol.CmdType_Txn))
+	requestSpace.Register(&protocol.EvalReq{}, uint32(protocol.CmdType_Eval))
+	requestSpace.Register(&protocol.GetByIndexReq{}, uint32(protocol.CmdType_GetByIndex))
+	requestSpace.Register(&protocol.UnsetReq{}, uint32(protocol.CmdType_Unset))
+	requestSpace.Register(&protocol.PreloadReq{}, uint32(protocol.CmdType_Preload))
+	requestSpace.Register(&protocol.MemberChangeReq{}, uint32(protocol.CmdType_MemberChange))
+	requestSpace.Register(&protocol.TransferLeaderReq{}, uint32(protocol.CmdType_TransferLeader))
+	requestSpace.Register(&protocol.ReportRegionReq{}, uint32(protocol.CmdType_ReportRegion))
+	requestSpace.Register(&protocol.QueryRouteReq{}, uint32(protocol.CmdType_QueryRoute))
+	requestSpace.Register(&protocol.MoveSlotReq{}, uint32(protocol.CmdType_MoveSlot))
+	requestSpace.Register(&protocol.GetSlotTableReq{}, uint32(protocol.CmdType_GetSlotTable))
+	requestSpace.Register(&protocol.ReloadConfigReq{}, uint32(protocol.CmdType_ReloadConfig))
 
 	responseSpace := pb.GetNamespace("response")
 
@@ -87,5 +145,23 @@ func init() {
 	responseSpace.Register(&protocol.CompareAndSetNxResp{}, uint32(protocol.CmdType_CompareAndSetNx))
 	responseSpace.Register(&protocol.KickResp{}, uint32(protocol.CmdType_Kick))
 	responseSpace.Register(&protocol.ReloadTableConfResp{}, uint32(protocol.CmdType_ReloadTableConf))
+	responseSpace.Register(&protocol.MgetResp{}, uint32(protocol.CmdType_MGet))
+	responseSpace.Register(&protocol.MsetResp{}, uint32(protocol.CmdType_MSet))
+	responseSpace.Register(&protocol.ScanResp{}, uint32(protocol.CmdType_Scan))
+	responseSpace.Register(&protocol.WatchResp{}, uint32(protocol.CmdType_Watch))
+	responseSpace.Register(&protocol.UnwatchResp{}, uint32(protocol.CmdType_UnWatch))
+	responseSpace.Register(&protocol.WatchNotify{}, uint32(protocol.CmdType_WatchNotify))
+	responseSpace.Register(&protocol.TxnResp{}, uint32(protocol.CmdType_Txn))
+	responseSpace.Register(&protocol.EvalResp{}, uint32(protocol.CmdType_Eval))
+	responseSpace.Register(&protocol.GetByIndexResp{}, uint32(protocol.CmdType_GetByIndex))
+	responseSpace.Register(&protocol.UnsetResp{}, uint32(protocol.CmdType_Unset))
+	responseSpace.Register(&protocol.PreloadResp{}, uint32(protocol.CmdType_Preload))
+	responseSpace.Register(&protocol.MemberChangeResp{}, uint32(protocol.CmdType_MemberChange))
+	responseSpace.Register(&protocol.TransferLeaderResp{}, uint32(protocol.CmdType_TransferLeader))
+	responseSpace.Register(&protocol.ReportRegionResp{}, uint32(protocol.CmdType_ReportRegion))
+	responseSpace.Register(&protocol.QueryRouteResp{}, uint32(protocol.CmdType_QueryRoute))
+	responseSpace.Register(&protocol.MoveSlotResp{}, uint32(protocol.CmdType_MoveSlot))
+	responseSpace.Register(&protocol.GetSlotTableResp{}, uint32(protocol.CmdType_GetSlotTable))
+	responseSpace.Register(&protocol.ReloadConfigResp{}, uint32(protocol.CmdType_ReloadConfig))
 
 }
@@ -0,0 +1,35 @@
+package net
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLeaderHint(t *testing.T) {
+	id, service, ok := ParseLeaderHint(MakeLeaderHint(2, "127.0.0.1:10018"))
+	if !ok || id != 2 || service != "127.0.0.1:10018" {
+		t.Fatal("parse leader hint failed", id, service, ok)
+	}
+
+	//服务地址未知
+	id, service, ok = ParseLeaderHint(MakeLeaderHint(3, ""))
+	if !ok || id != 3 || service != "" {
+		t.Fatal("parse leader hint failed", id, service, ok)
+	}
+
+	for _, v := range []string{"", "users1:sniperHW", "@127.0.0.1:10018", "a@127.0.0.1:10018", "0@"} {
+		if _, _, ok := ParseLeaderHint(v); ok {
+			t.Fatal("invaild leader hint", v)
+		}
+	}
+}
+
+func TestHeadHint(t *testing.T) {
+	head := CommonHead{}
+
+	//不足1毫秒向上取整
+	head.SetRetryAfter(1500 * time.Microsecond)
+	if head.GetRetryAfter() != 2*time.Millisecond {
+		t.Fatal("retry after", head.GetRetryAfter())
+	}
+}
@@ -223,11 +223,20 @@ func (this *Receiver) unpack(buffer []byte, r uint64, w uint64) (ret interface{}
 			sizeOfHead := 8 + 4 + 4 + 2 + uint32(sizeOfUniKey)
 			//普通消息
 			size := payload - SizeCmd - SizeFlag - sizeOfHead
+			if flag&FlagSlotVersion != 0 {
+				size -= SizeSlotVersion
+			}
 			if buff, err = reader.GetBytes(uint64(size)); err != nil {
 				return
 			}
 
-			if flag == byte(1) {
+			if flag&FlagSlotVersion != 0 {
+				if head.SlotVersion, err = reader.GetInt64(); err != nil {
+					return
+				}
+			}
+
+			if flag&FlagCompress != 0 {
 				if nil == this.unCompressor {
 					err = fmt.Errorf("invaild compress packet")
 					return
@@ -350,11 +359,20 @@ func (this *Receiver) unPack() (ret interface{}, err error) {
 			sizeOfHead := 8 + 4 + 4 + 2 + uint32(sizeOfUniKey)
 			//普通消息
 			size := payload - SizeCmd - SizeFlag - sizeOfHead
+			if flag&FlagSlotVersion != 0 {
+				size -= SizeSlotVersion
+			}
 			if buff, err = reader.GetBytes(uint64(size)); err != nil {
 				return
 			}
 
-			if flag == byte(1) {
+			if flag&FlagSlotVersion != 0 {
+				if head.SlotVersion, err = reader.GetInt64(); err != nil {
+					return
+				}
+			}
+
+			if flag&FlagCompress != 0 {
 				if nil == this.unCompressor {
 					err = fmt.Errorf("invaild compress packet")
 					return
@@ -0,0 +1,121 @@
+package partition
+
+import (
+	futil "github.com/sniperHW/flyfish/util"
+)
+
+/*
+ * key分区,kvnode,kvproxy及client共用
+ * key通过Hash(key) % slot数量映射到slot,slot表记录每个slot由哪个region负责。
+ * 每个slot有一个epoch,每迁移一次加1。slot表的版本为所有slot的epoch之和，
+ * 各节点apply相同的迁移后得到相同的版本，版本越大表越新。
+ * Table创建后不再修改，Update返回新的Table,可以在goroutine间共享。
+ */
+
+const MaxSlotCount = 65536 //Hash为16位
+
+func Hash(key string) int {
+	return futil.StringHash(key)
+}
+
+//slot表未知时按region数量分区,与slot数量为regionCount整数倍的初始slot表一致
+func GetRegion(key string, regionCount int) int {
+	return Hash(key)%regionCount + 1
+}
+
+type Table struct {
+	version int64
+	regions []int   //slot -> region
+	epochs  []int64 //slot -> 迁移次数
+}
+
+type SlotOwner struct {
+	Slot   int
+	Region int
+	Epoch  int64
+}
+
+//初始分配:slot由第slot % regionCount + 1个region负责
+func New(slotCount int, regionCount int) *Table {
+	t := &Table{
+		regions: make([]int, slotCount),
+		epochs:  make([]int64, slotCount),
+	}
+	for i := range t.regions {
+		t.regions[i] = i%regionCount + 1
+	}
+	return t
+}
+
+//从kvnode获取的slot表,只包含归属
+func NewWithRegions(version int64, regions []int) *Table {
+	t := &Table{
+		version: version,
+		regions: make([]int, len(regions)),
+		epochs:  make([]int64, len(regions)),
+	}
+	copy(t.regions, regions)
+	return t
+}
+
+func (this *Table) Version() int64 {
+	return this.version
+}
+
+func (this *Table) SlotCount() int {
+	return len(this.regions)
+}
+
+func (this *Table) GetSlot(key string) int {
+	return Hash(key) % len(this.regions)
+}
+
+func (this *Table) GetRegion(key string) int {
+	return this.regions[this.GetSlot(key)]
+}
+
+func (this *Table) GetSlotRegion(slot int) int {
+	return this.regions[slot]
+}
+
+func (this *Table) GetEpoch(slot int) int64 {
+	return this.epochs[slot]
+}
+
+func (this *Table) Regions() []int {
+	regions := make([]int, len(this.regions))
+	copy(regions, this.regions)
+	return regions
+}
+
+//按epoch更新slot的归属,epoch不大于当前值的变更被忽略，没有变更时返回原表
+func (this *Table) Update(owners ...SlotOwner) *Table {
+	var t *Table
+	for _, v := range owners {
+		if v.Slot < 0 || v.Slot >= len(this.regions) || v.Epoch <= this.epochs[v.Slot] {
+			continue
+		}
+
+		if nil == t {
+			t = &Table{
+				version: this.version,
+				regions: make([]int, len(this.regions)),
+				epochs:  make([]int64, len(this.epochs)),
+			}
+			copy(t.regions, this.regions)
+			copy(t.epochs, this.epochs)
+		} else if v.Epoch <= t.epochs[v.Slot] {
+			continue
+		}
+
+		t.version += v.Epoch - t.epochs[v.Slot]
+		t.regions[v.Slot] = v.Region
+		t.epochs[v.Slot] = v.Epoch
+	}
+
+	if nil == t {
+		return this
+	}
+
+	return t
+}
@@ -0,0 +1,48 @@
+package partition
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTable(t *testing.T) {
+	table := New(8, 4)
+
+	assert.Equal(t, int64(0), table.Version())
+	assert.Equal(t, 8, table.SlotCount())
+
+	for i := 0; i < 8; i++ {
+		assert.Equal(t, i%4+1, table.GetSlotRegion(i))
+	}
+
+	//初始表与按region数量分区一致
+	for _, v := range []string{"users1:sniperHW", "users1:huangwei", "test:1", ""} {
+		assert.Equal(t, GetRegion(v, 4), table.GetRegion(v))
+	}
+
+	t1 := table.Update(SlotOwner{Slot: 1, Region: 3, Epoch: 1})
+	assert.Equal(t, int64(1), t1.Version())
+	assert.Equal(t, 3, t1.GetSlotRegion(1))
+	assert.Equal(t, int64(1), t1.GetEpoch(1))
+
+	//原表不变
+	assert.Equal(t, int64(0), table.Version())
+	assert.Equal(t, 2, table.GetSlotRegion(1))
+
+	//旧的变更被忽略
+	assert.Equal(t, t1, t1.Update(SlotOwner{Slot: 1, Region: 2, Epoch: 1}))
+	assert.Equal(t, t1, t1.Update(SlotOwner{Slot: 8, Region: 2, Epoch: 1}))
+
+	//不同顺序apply得到相同的版本
+	t2 := t1.Update(SlotOwner{Slot: 1, Region: 2, Epoch: 3}, SlotOwner{Slot: 5, Region: 1, Epoch: 1}, SlotOwner{Slot: 1, Region: 4, Epoch: 2})
+	t3 := table.Update(SlotOwner{Slot: 5, Region: 1, Epoch: 1}).Update(SlotOwner{Slot: 1, Region: 2, Epoch: 3})
+	assert.Equal(t, int64(4), t2.Version())
+	assert.Equal(t, t2.Version(), t3.Version())
+	assert.Equal(t, t2.Regions(), t3.Regions())
+
+	t4 := NewWithRegions(t2.Version(), t2.Regions())
+	assert.Equal(t, t2.Version(), t4.Version())
+	for _, v := range []string{"users1:sniperHW", "users1:huangwei", "test:1", ""} {
+		assert.Equal(t, t2.GetRegion(v), t4.GetRegion(v))
+	}
+}
@@ -44,6 +44,24 @@ const (
 	CmdType_Kick            CmdType = 10
 	CmdType_ReloadTableConf CmdType = 11
 	CmdType_Cancel          CmdType = 12
+	CmdType_MGet            CmdType = 13
+	CmdType_MSet            CmdType = 14
+	CmdType_Scan            CmdType = 15
+	CmdType_Watch           CmdType = 16
+	CmdType_UnWatch         CmdType = 17
+	CmdType_WatchNotify     CmdType = 18
+	CmdType_Txn             CmdType = 19
+	CmdType_Eval            CmdType = 20
+	CmdType_GetByIndex      CmdType = 21
+	CmdType_Unset           CmdType = 22
+	CmdType_Preload         CmdType = 23
+	CmdType_MemberChange    CmdType = 24
+	CmdType_TransferLeader  CmdType = 25
+	CmdType_ReportRegion    CmdType = 26
+	CmdType_QueryRoute      CmdType = 27
+	CmdType_MoveSlot        CmdType = 28
+	CmdType_ReloadConfig    CmdType = 29
+	CmdType_GetSlotTable    CmdType = 30
 )
 
 var CmdType_name = map[int32]string{
@@ -59,6 +77,24 @@ var CmdType_name = map[int32]string{
 	10: "Kick",
 	11: "ReloadTableConf",
 	12: "Cancel",
+	13: "MGet",
+	14: "MSet",
+	15: "Scan",
+	16: "Watch",
+	17: "UnWatch",
+	18: "WatchNotify",
+	19: "Txn",
+	20: "Eval",
+	21: "GetByIndex",
+	22: "Unset",
+	23: "Preload",
+	24: "MemberChange",
+	25: "TransferLeader",
+	26: "ReportRegion",
+	27: "QueryRoute",
+	28: "MoveSlot",
+	29: "ReloadConfig",
+	30: "GetSlotTable",
 }
 
 var CmdType_value = map[string]int32{
@@ -74,6 +110,24 @@ var CmdType_value = map[string]int32{
 	"Kick":            10,
 	"ReloadTableConf": 11,
 	"Cancel":          12,
+	"MGet":            13,
+	"MSet":            14,
+	"Scan":            15,
+	"Watch":           16,
+	"UnWatch":         17,
+	"WatchNotify":     18,
+	"Txn":             19,
+	"Eval":            20,
+	"GetByIndex":      21,
+	"Unset":           22,
+	"Preload":         23,
+	"MemberChange":    24,
+	"TransferLeader":  25,
+	"ReportRegion":    26,
+	"QueryRoute":      27,
+	"MoveSlot":        28,
+	"ReloadConfig":    29,
+	"GetSlotTable":    30,
 }
 
 func (x CmdType) Enum() *CmdType {
@@ -246,7 +300,8 @@ func (m *LoginResp) GetCompress() bool {
 }
 
 type ReloadTableConfReq struct {
-	Seqno int64 `protobuf:"varint,1,req,name=seqno" json:"seqno"`
+	Seqno   int64   `protobuf:"varint,1,req,name=seqno" json:"seqno"`
+	Regions []int32 `protobuf:"varint,2,rep,packed,name=regions" json:"regions,omitempty"`
 }
 
 func (m *ReloadTableConfReq) Reset()      { *m = ReloadTableConfReq{} }
@@ -288,10 +343,18 @@ func (m *ReloadTableConfReq) GetSeqno() int64 {
 	return 0
 }
 
+func (m *ReloadTableConfReq) GetRegions() []int32 {
+	if m != nil {
+		return m.Regions
+	}
+	return nil
+}
+
 type ReloadTableConfResp struct {
 	Seqno   int64  `protobuf:"varint,1,req,name=seqno" json:"seqno"`
 	ErrCode int32  `protobuf:"varint,2,req,name=errCode" json:"errCode"`
 	Err     string `protobuf:"bytes,3,opt,name=err" json:"err"`
+	Version int64  `protobuf:"varint,4,opt,name=version" json:"version"`
 }
 
 func (m *ReloadTableConfResp) Reset()      { *m = ReloadTableConfResp{} }
@@ -347,6 +410,14 @@ func (m *ReloadTableConfResp) GetErr() string {
 	return ""
 }
 
+func (m *ReloadTableConfResp) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// 由kvproxy处理,path为空时重新加载启动时的配置文件
 type ReloadConfigReq struct {
 	Path string `protobuf:"bytes,1,opt,name=path" json:"path"`
 }
@@ -650,6 +721,8 @@ type GetReq struct {
 	Version *int64   `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
 	Fields  []string `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
 	All     bool     `protobuf:"varint,3,opt,name=all" json:"all"`
+	StaleOk bool     `protobuf:"varint,4,opt,name=stale_ok,json=staleOk" json:"stale_ok"`
+	MaxLag  int64    `protobuf:"varint,5,opt,name=max_lag,json=maxLag" json:"max_lag"`
 }
 
 func (m *GetReq) Reset()      { *m = GetReq{} }
@@ -705,9 +778,24 @@ func (m *GetReq) GetAll() bool {
 	return false
 }
 
+func (m *GetReq) GetStaleOk() bool {
+	if m != nil {
+		return m.StaleOk
+	}
+	return false
+}
+
+func (m *GetReq) GetMaxLag() int64 {
+	if m != nil {
+		return m.MaxLag
+	}
+	return 0
+}
+
 type GetResp struct {
-	Version int64    `protobuf:"varint,1,opt,name=version" json:"version"`
-	Fields  []*Field `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
+	Version      int64    `protobuf:"varint,1,opt,name=version" json:"version"`
+	Fields       []*Field `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
+	AppliedIndex uint64   `protobuf:"varint,3,opt,name=applied_index,json=appliedIndex" json:"applied_index"`
 }
 
 func (m *GetResp) Reset()      { *m = GetResp{} }
@@ -756,12 +844,19 @@ func (m *GetResp) GetFields() []*Field {
 	return nil
 }
 
-//
-//  设置记录的指定字段，如果version被指定则只有当存储数据的版本号与指定的version一致时才执行设置
-//  (注:未指定版本好的情况下，如果记录不存在则新增记录，新增记录时如果有未设定的字段，将会用设定的默认值初始化)
+func (m *GetResp) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
+	}
+	return 0
+}
+
+// 设置记录的指定字段，如果version被指定则只有当存储数据的版本号与指定的version一致时才执行设置
+// (注:未指定版本好的情况下，如果记录不存在则新增记录，新增记录时如果有未设定的字段，将会用设定的默认值初始化)
 type SetReq struct {
 	Version *int64   `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
 	Fields  []*Field `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
+	Ttl     int64    `protobuf:"varint,3,opt,name=ttl" json:"ttl"`
 }
 
 func (m *SetReq) Reset()      { *m = SetReq{} }
@@ -810,6 +905,13 @@ func (m *SetReq) GetFields() []*Field {
 	return nil
 }
 
+func (m *SetReq) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
 type SetResp struct {
 	Version int64 `protobuf:"varint,1,opt,name=version" json:"version"`
 }
@@ -858,6 +960,7 @@ func (m *SetResp) GetVersion() int64 {
 type SetNxReq struct {
 	Version *int64   `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
 	Fields  []*Field `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
+	Ttl     int64    `protobuf:"varint,3,opt,name=ttl" json:"ttl"`
 }
 
 func (m *SetNxReq) Reset()      { *m = SetNxReq{} }
@@ -906,6 +1009,13 @@ func (m *SetNxReq) GetFields() []*Field {
 	return nil
 }
 
+func (m *SetNxReq) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
 type SetNxResp struct {
 	Version int64    `protobuf:"varint,1,opt,name=version" json:"version"`
 	Fields  []*Field `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
@@ -963,6 +1073,7 @@ func (m *SetNxResp) GetFields() []*Field {
 type IncrByReq struct {
 	Version *int64 `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
 	Field   *Field `protobuf:"bytes,2,req,name=field" json:"field,omitempty"`
+	Ttl     int64  `protobuf:"varint,3,opt,name=ttl" json:"ttl"`
 }
 
 func (m *IncrByReq) Reset()      { *m = IncrByReq{} }
@@ -1011,6 +1122,13 @@ func (m *IncrByReq) GetField() *Field {
 	return nil
 }
 
+func (m *IncrByReq) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
 type IncrByResp struct {
 	Version int64  `protobuf:"varint,1,opt,name=version" json:"version"`
 	Field   *Field `protobuf:"bytes,2,opt,name=field" json:"field,omitempty"`
@@ -1480,13 +1598,108 @@ func (m *DelResp) GetVersion() int64 {
 	return 0
 }
 
+// 将记录的指定字段重置为默认值，记录必须存在
+type UnsetReq struct {
+	Version *int64   `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	Fields  []string `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
+}
+
+func (m *UnsetReq) Reset()      { *m = UnsetReq{} }
+func (*UnsetReq) ProtoMessage() {}
+func (*UnsetReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{26}
+}
+func (m *UnsetReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnsetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnsetReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnsetReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsetReq.Merge(m, src)
+}
+func (m *UnsetReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnsetReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsetReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsetReq proto.InternalMessageInfo
+
+func (m *UnsetReq) GetVersion() int64 {
+	if m != nil && m.Version != nil {
+		return *m.Version
+	}
+	return 0
+}
+
+func (m *UnsetReq) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type UnsetResp struct {
+	Version int64 `protobuf:"varint,1,opt,name=version" json:"version"`
+}
+
+func (m *UnsetResp) Reset()      { *m = UnsetResp{} }
+func (*UnsetResp) ProtoMessage() {}
+func (*UnsetResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{27}
+}
+func (m *UnsetResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnsetResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnsetResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnsetResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsetResp.Merge(m, src)
+}
+func (m *UnsetResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnsetResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsetResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsetResp proto.InternalMessageInfo
+
+func (m *UnsetResp) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type KickReq struct {
 }
 
 func (m *KickReq) Reset()      { *m = KickReq{} }
 func (*KickReq) ProtoMessage() {}
 func (*KickReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fcc84b9998d60d8, []int{26}
+	return fileDescriptor_2fcc84b9998d60d8, []int{28}
 }
 func (m *KickReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1521,7 +1734,7 @@ type KickResp struct {
 func (m *KickResp) Reset()      { *m = KickResp{} }
 func (*KickResp) ProtoMessage() {}
 func (*KickResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fcc84b9998d60d8, []int{27}
+	return fileDescriptor_2fcc84b9998d60d8, []int{29}
 }
 func (m *KickResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1550,21 +1763,25 @@ func (m *KickResp) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KickResp proto.InternalMessageInfo
 
-type Cancel struct {
-	Seqs []int64 `protobuf:"varint,1,rep,name=seqs" json:"seqs,omitempty"`
+// 批量命令中单个key的执行结果
+type Row struct {
+	Key     string   `protobuf:"bytes,1,opt,name=key" json:"key"`
+	Version int64    `protobuf:"varint,2,opt,name=version" json:"version"`
+	Fields  []*Field `protobuf:"bytes,3,rep,name=fields" json:"fields,omitempty"`
+	ErrCode int32    `protobuf:"varint,4,opt,name=errCode" json:"errCode"`
 }
 
-func (m *Cancel) Reset()      { *m = Cancel{} }
-func (*Cancel) ProtoMessage() {}
-func (*Cancel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fcc84b9998d60d8, []int{28}
+func (m *Row) Reset()      { *m = Row{} }
+func (*Row) ProtoMessage() {}
+func (*Row) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{30}
 }
-func (m *Cancel) XXX_Unmarshal(b []byte) error {
+func (m *Row) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Cancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Row) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Cancel.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Row.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1574,3167 +1791,14513 @@ func (m *Cancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Cancel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Cancel.Merge(m, src)
+func (m *Row) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Row.Merge(m, src)
 }
-func (m *Cancel) XXX_Size() int {
+func (m *Row) XXX_Size() int {
 	return m.Size()
 }
-func (m *Cancel) XXX_DiscardUnknown() {
-	xxx_messageInfo_Cancel.DiscardUnknown(m)
+func (m *Row) XXX_DiscardUnknown() {
+	xxx_messageInfo_Row.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Cancel proto.InternalMessageInfo
+var xxx_messageInfo_Row proto.InternalMessageInfo
 
-func (m *Cancel) GetSeqs() []int64 {
+func (m *Row) GetKey() string {
 	if m != nil {
-		return m.Seqs
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("proto.CmdType", CmdType_name, CmdType_value)
-	proto.RegisterEnum("proto.ValueType", ValueType_name, ValueType_value)
-	proto.RegisterType((*LoginReq)(nil), "proto.loginReq")
-	proto.RegisterType((*LoginResp)(nil), "proto.loginResp")
-	proto.RegisterType((*ReloadTableConfReq)(nil), "proto.reloadTableConfReq")
-	proto.RegisterType((*ReloadTableConfResp)(nil), "proto.reloadTableConfResp")
-	proto.RegisterType((*ReloadConfigReq)(nil), "proto.reloadConfigReq")
-	proto.RegisterType((*ReloadConfigResp)(nil), "proto.reloadConfigResp")
-	proto.RegisterType((*Value)(nil), "proto.value")
-	proto.RegisterType((*Field)(nil), "proto.field")
-	proto.RegisterType((*PingReq)(nil), "proto.ping_req")
-	proto.RegisterType((*PingResp)(nil), "proto.ping_resp")
-	proto.RegisterType((*GetReq)(nil), "proto.get_req")
-	proto.RegisterType((*GetResp)(nil), "proto.get_resp")
-	proto.RegisterType((*SetReq)(nil), "proto.set_req")
-	proto.RegisterType((*SetResp)(nil), "proto.set_resp")
-	proto.RegisterType((*SetNxReq)(nil), "proto.set_nx_req")
-	proto.RegisterType((*SetNxResp)(nil), "proto.set_nx_resp")
-	proto.RegisterType((*IncrByReq)(nil), "proto.incr_by_req")
-	proto.RegisterType((*IncrByResp)(nil), "proto.incr_by_resp")
-	proto.RegisterType((*DecrByReq)(nil), "proto.decr_by_req")
-	proto.RegisterType((*DecrByResp)(nil), "proto.decr_by_resp")
-	proto.RegisterType((*CompareAndSetReq)(nil), "proto.compare_and_set_req")
-	proto.RegisterType((*CompareAndSetResp)(nil), "proto.compare_and_set_resp")
-	proto.RegisterType((*CompareAndSetNxReq)(nil), "proto.compare_and_set_nx_req")
-	proto.RegisterType((*CompareAndSetNxResp)(nil), "proto.compare_and_set_nx_resp")
-	proto.RegisterType((*DelReq)(nil), "proto.del_req")
-	proto.RegisterType((*DelResp)(nil), "proto.del_resp")
-	proto.RegisterType((*KickReq)(nil), "proto.kick_req")
-	proto.RegisterType((*KickResp)(nil), "proto.kick_resp")
-	proto.RegisterType((*Cancel)(nil), "proto.cancel")
+func (m *Row) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("proto.proto", fileDescriptor_2fcc84b9998d60d8) }
-
-var fileDescriptor_2fcc84b9998d60d8 = []byte{
-	// 838 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0xc4, 0x71, 0x6d, 0xbf, 0x14, 0xd6, 0x4c, 0xab, 0x12, 0x55, 0x68, 0x88, 0x46, 0x1c,
-	0x42, 0x40, 0x5d, 0xb4, 0x27, 0x2e, 0x1c, 0x68, 0x56, 0x42, 0x68, 0xa5, 0x65, 0xd7, 0x5d, 0x21,
-	0x81, 0x84, 0x2a, 0x27, 0x9e, 0x84, 0x51, 0x9c, 0x19, 0xd7, 0xe3, 0x0d, 0xed, 0x0d, 0x89, 0x3f,
-	0xc0, 0x8f, 0xe0, 0xc0, 0x4f, 0xe9, 0xb1, 0xc7, 0x3d, 0x21, 0x9a, 0x5e, 0x38, 0xee, 0x4f, 0x40,
-	0x6f, 0x62, 0x37, 0x4d, 0x1b, 0x90, 0xa5, 0xcd, 0x25, 0x79, 0xfe, 0xde, 0x9b, 0xef, 0xfb, 0xde,
-	0xf8, 0x8d, 0x07, 0xda, 0x59, 0xae, 0x0b, 0x7d, 0x64, 0x7f, 0xa9, 0x6b, 0xff, 0x0e, 0xf7, 0x27,
-	0x7a, 0xa2, 0x6d, 0xf8, 0x18, 0xa3, 0x65, 0x92, 0x7f, 0x0e, 0x7e, 0xaa, 0x27, 0x52, 0x45, 0xe2,
-	0x8c, 0x76, 0xc1, 0x1f, 0xe9, 0x59, 0x96, 0x0b, 0x63, 0x3a, 0xa4, 0x4b, 0x7a, 0xfe, 0x71, 0xeb,
-	0xf2, 0xaf, 0x8f, 0x1b, 0xd1, 0x2d, 0xca, 0x07, 0x10, 0x94, 0xd5, 0x26, 0xa3, 0xfb, 0xd0, 0xd4,
-	0xd3, 0xb5, 0xc2, 0xa6, 0x9e, 0xae, 0x91, 0x34, 0x37, 0x92, 0x7c, 0x01, 0x34, 0x17, 0xa9, 0x8e,
-	0x93, 0x57, 0xf1, 0x30, 0x15, 0x03, 0xad, 0xc6, 0x28, 0x7e, 0x08, 0xae, 0x11, 0x67, 0x4a, 0x77,
-	0x48, 0xb7, 0xd9, 0x73, 0xca, 0x45, 0x4b, 0x88, 0x4b, 0xd8, 0x7b, 0xb0, 0xc2, 0x64, 0xff, 0xb7,
-	0x84, 0x32, 0xf0, 0x44, 0x9e, 0x0f, 0x74, 0x22, 0x3a, 0xcd, 0x6e, 0xb3, 0xe7, 0x96, 0xd9, 0x0a,
-	0xa4, 0x07, 0xe0, 0x88, 0x3c, 0xef, 0x38, 0x5d, 0xd2, 0x0b, 0xca, 0x1c, 0x02, 0xfc, 0x33, 0x78,
-	0xb4, 0x94, 0x42, 0x15, 0x39, 0x41, 0x67, 0x1d, 0x68, 0x65, 0x71, 0xf1, 0xb3, 0xed, 0xb4, 0xaa,
-	0xb5, 0x08, 0xef, 0x43, 0xb8, 0x5e, 0x6c, 0xb2, 0x8a, 0x98, 0xdc, 0x27, 0xfe, 0x8d, 0x80, 0x3b,
-	0x8f, 0xd3, 0xd7, 0x82, 0xf6, 0xa1, 0x55, 0x5c, 0x64, 0xc2, 0xba, 0x7e, 0xff, 0x49, 0xb8, 0x7c,
-	0x11, 0x47, 0xdf, 0x63, 0xee, 0xd5, 0x45, 0x26, 0x2a, 0x05, 0xac, 0xa1, 0x14, 0x88, 0xb4, 0xdb,
-	0x58, 0xb5, 0x47, 0x24, 0x62, 0x63, 0x6b, 0x9c, 0x54, 0xd8, 0x18, 0x31, 0xd3, 0x69, 0xdd, 0xd1,
-	0x24, 0x06, 0xb1, 0x61, 0xc7, 0xed, 0x92, 0xde, 0x6e, 0x85, 0x0d, 0xf9, 0x57, 0xe0, 0x8e, 0xa5,
-	0x48, 0x13, 0x6c, 0x4a, 0xc5, 0x33, 0xb1, 0xde, 0x14, 0x22, 0xf4, 0x10, 0xc8, 0xdc, 0x4a, 0xb6,
-	0x9f, 0xec, 0x96, 0xde, 0xac, 0xef, 0x88, 0xcc, 0xf9, 0x11, 0xf8, 0x99, 0x54, 0x93, 0xd3, 0x5c,
-	0x9c, 0x51, 0x0e, 0x41, 0x21, 0x67, 0xc2, 0x14, 0xf1, 0x2c, 0xb3, 0x34, 0x95, 0xc5, 0x15, 0xcc,
-	0x1f, 0x43, 0x50, 0xd6, 0x9b, 0x6c, 0x7d, 0x41, 0x73, 0xf3, 0x82, 0x1f, 0xc0, 0x9b, 0x88, 0xc2,
-	0xf2, 0x33, 0xf0, 0xe6, 0x22, 0x37, 0x52, 0xab, 0x3b, 0xec, 0x24, 0xaa, 0x40, 0x7a, 0x00, 0x3b,
-	0xb6, 0x15, 0x1c, 0x33, 0xa7, 0x17, 0x44, 0xe5, 0x13, 0xbe, 0x80, 0x38, 0x4d, 0xed, 0x06, 0x55,
-	0xb3, 0x87, 0x00, 0x7f, 0x01, 0xfe, 0x92, 0xda, 0x64, 0x9b, 0xb9, 0x1b, 0x2b, 0xee, 0x4f, 0xd6,
-	0xb8, 0x57, 0x1b, 0x61, 0xc1, 0x4a, 0x89, 0x7f, 0x07, 0x9e, 0xa9, 0x69, 0xb6, 0x1e, 0x61, 0x1f,
-	0x7c, 0x53, 0xd3, 0x22, 0x8f, 0x00, 0xb0, 0x56, 0x9d, 0x6f, 0x51, 0xff, 0x04, 0xda, 0xb7, 0x9c,
-	0x5b, 0xdb, 0xa5, 0x97, 0xd0, 0x96, 0x6a, 0x94, 0x9f, 0x0e, 0x2f, 0x6a, 0x39, 0xe5, 0xe5, 0x84,
-	0xda, 0x63, 0x7b, 0x9f, 0x73, 0x99, 0xe2, 0x11, 0xec, 0xae, 0x28, 0x6b, 0x18, 0xbd, 0xc3, 0x49,
-	0xfe, 0x8b, 0xf3, 0x25, 0xb4, 0x13, 0xb1, 0x75, 0x9b, 0x2b, 0xca, 0x2d, 0xd9, 0x7c, 0x0d, 0x7b,
-	0xf8, 0x21, 0x8d, 0x73, 0x71, 0x1a, 0xab, 0xe4, 0xb4, 0xee, 0xfc, 0x31, 0x70, 0x94, 0xf8, 0x65,
-	0xa3, 0x59, 0x4c, 0x60, 0x5e, 0xa7, 0x49, 0xc7, 0xd9, 0x94, 0xd7, 0x69, 0xc2, 0x7f, 0x84, 0xfd,
-	0x87, 0xb2, 0xf5, 0x5a, 0xb2, 0x1f, 0x8f, 0xcd, 0x2d, 0xd9, 0x14, 0x3f, 0x87, 0x83, 0xfb, 0xdc,
-	0x35, 0xa7, 0xfa, 0x5d, 0xbb, 0xfa, 0x09, 0x3e, 0xdc, 0xa8, 0xbc, 0xa5, 0xc6, 0x3e, 0x05, 0x2f,
-	0x11, 0x69, 0x9d, 0x4e, 0xf0, 0xe4, 0x2f, 0x4b, 0x6b, 0x9c, 0x7c, 0x00, 0x7f, 0x2a, 0x47, 0x53,
-	0xe4, 0xe5, 0x6d, 0x08, 0xca, 0xd8, 0x64, 0xfc, 0x23, 0xd8, 0x19, 0xc5, 0x6a, 0x24, 0x52, 0x4a,
-	0xa1, 0x65, 0xc4, 0x19, 0xde, 0xe2, 0x4e, 0xcf, 0x89, 0x6c, 0xdc, 0xff, 0x83, 0x80, 0x37, 0x98,
-	0x25, 0x78, 0xc5, 0x50, 0x1f, 0x5a, 0x2f, 0xa4, 0x9a, 0x84, 0x84, 0x7a, 0xe0, 0x9c, 0x88, 0x22,
-	0x6c, 0x62, 0xf0, 0x8d, 0x28, 0x42, 0x07, 0x83, 0xa7, 0x22, 0x0d, 0x5b, 0x14, 0x60, 0xe7, 0x5b,
-	0x35, 0xca, 0x8f, 0x2f, 0x42, 0x17, 0xe3, 0xa7, 0xc2, 0xc6, 0x3b, 0x34, 0x00, 0xf7, 0x44, 0x14,
-	0xcf, 0xcf, 0x43, 0x8f, 0x7e, 0x00, 0xef, 0x0d, 0x96, 0x1b, 0xf8, 0xb5, 0x4a, 0x90, 0xc7, 0xa7,
-	0x7b, 0xf0, 0x68, 0x0d, 0x7a, 0x7e, 0x1e, 0x06, 0xa8, 0xf7, 0x4c, 0x8e, 0xa6, 0x21, 0x60, 0x3a,
-	0x5a, 0xbf, 0xca, 0xc3, 0x36, 0xb2, 0x0f, 0xac, 0xf1, 0x70, 0xb7, 0xff, 0x0c, 0x82, 0xdb, 0xab,
-	0x90, 0xb6, 0xc1, 0x93, 0x6a, 0x1e, 0xcb, 0x34, 0x09, 0x1b, 0x68, 0x4c, 0xc9, 0x34, 0x24, 0x58,
-	0x6e, 0x8a, 0x1c, 0xfd, 0x5b, 0xdb, 0x52, 0xa1, 0xed, 0x00, 0xdc, 0x71, 0xaa, 0xe3, 0x22, 0x6c,
-	0xa1, 0xda, 0x30, 0xd5, 0xc3, 0xd0, 0x3d, 0xfe, 0xf2, 0xf2, 0x9a, 0x91, 0xab, 0x6b, 0x46, 0xde,
-	0x5c, 0xb3, 0xc6, 0xdb, 0x6b, 0x46, 0x7e, 0x5d, 0x30, 0xf2, 0xe7, 0x82, 0x91, 0xcb, 0x05, 0x23,
-	0x57, 0x0b, 0x46, 0xfe, 0x5e, 0x30, 0xf2, 0xcf, 0x82, 0x35, 0xde, 0x2e, 0x18, 0xf9, 0xfd, 0x86,
-	0x35, 0xae, 0x6e, 0x58, 0xe3, 0xcd, 0x0d, 0x6b, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x8d,
-	0x47, 0xf7, 0x42, 0x09, 0x00, 0x00,
+func (m *Row) GetFields() []*Field {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
 }
 
-func (x CmdType) String() string {
-	s, ok := CmdType_name[int32(x)]
-	if ok {
-		return s
+func (m *Row) GetErrCode() int32 {
+	if m != nil {
+		return m.ErrCode
 	}
-	return strconv.Itoa(int(x))
+	return 0
 }
-func (x ValueType) String() string {
-	s, ok := ValueType_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+
+// 获取同一table多个key的指定字段(all为true时获取所有字段)
+// kvnode按key所属的kvstore分组，每个region只发起一次批量读
+type MgetReq struct {
+	Table  string   `protobuf:"bytes,1,opt,name=table" json:"table"`
+	Keys   []string `protobuf:"bytes,2,rep,name=keys" json:"keys,omitempty"`
+	Fields []string `protobuf:"bytes,3,rep,name=fields" json:"fields,omitempty"`
+	All    bool     `protobuf:"varint,4,opt,name=all" json:"all"`
 }
-func (this *LoginReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*LoginReq)
-	if !ok {
-		that2, ok := that.(LoginReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MgetReq) Reset()      { *m = MgetReq{} }
+func (*MgetReq) ProtoMessage() {}
+func (*MgetReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{31}
+}
+func (m *MgetReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MgetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MgetReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Compress != that1.Compress {
-		return false
-	}
-	return true
 }
-func (this *LoginResp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *MgetReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MgetReq.Merge(m, src)
+}
+func (m *MgetReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *MgetReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MgetReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MgetReq proto.InternalMessageInfo
+
+func (m *MgetReq) GetTable() string {
+	if m != nil {
+		return m.Table
 	}
+	return ""
+}
 
-	that1, ok := that.(*LoginResp)
-	if !ok {
-		that2, ok := that.(LoginResp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *MgetReq) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *MgetReq) GetFields() []string {
+	if m != nil {
+		return m.Fields
 	}
-	if this.Ok != that1.Ok {
-		return false
+	return nil
+}
+
+func (m *MgetReq) GetAll() bool {
+	if m != nil {
+		return m.All
 	}
-	if this.Compress != that1.Compress {
-		return false
-	}
-	return true
+	return false
 }
-func (this *ReloadTableConfReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ReloadTableConfReq)
-	if !ok {
-		that2, ok := that.(ReloadTableConfReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MgetResp struct {
+	Rows []*Row `protobuf:"bytes,1,rep,name=rows" json:"rows,omitempty"`
+}
+
+func (m *MgetResp) Reset()      { *m = MgetResp{} }
+func (*MgetResp) ProtoMessage() {}
+func (*MgetResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{32}
+}
+func (m *MgetResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MgetResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MgetResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Seqno != that1.Seqno {
-		return false
-	}
-	return true
 }
-func (this *ReloadTableConfResp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *MgetResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MgetResp.Merge(m, src)
+}
+func (m *MgetResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MgetResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MgetResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MgetResp proto.InternalMessageInfo
+
+func (m *MgetResp) GetRows() []*Row {
+	if m != nil {
+		return m.Rows
 	}
+	return nil
+}
 
-	that1, ok := that.(*ReloadTableConfResp)
-	if !ok {
-		that2, ok := that.(ReloadTableConfResp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MsetItem struct {
+	Key     string   `protobuf:"bytes,1,opt,name=key" json:"key"`
+	Version *int64   `protobuf:"varint,2,opt,name=version" json:"version,omitempty"`
+	Fields  []*Field `protobuf:"bytes,3,rep,name=fields" json:"fields,omitempty"`
+}
+
+func (m *MsetItem) Reset()      { *m = MsetItem{} }
+func (*MsetItem) ProtoMessage() {}
+func (*MsetItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{33}
+}
+func (m *MsetItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsetItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsetItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Seqno != that1.Seqno {
-		return false
-	}
-	if this.ErrCode != that1.ErrCode {
-		return false
+}
+func (m *MsetItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsetItem.Merge(m, src)
+}
+func (m *MsetItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsetItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsetItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsetItem proto.InternalMessageInfo
+
+func (m *MsetItem) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if this.Err != that1.Err {
-		return false
+	return ""
+}
+
+func (m *MsetItem) GetVersion() int64 {
+	if m != nil && m.Version != nil {
+		return *m.Version
 	}
-	return true
+	return 0
 }
-func (this *ReloadConfigReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsetItem) GetFields() []*Field {
+	if m != nil {
+		return m.Fields
 	}
+	return nil
+}
 
-	that1, ok := that.(*ReloadConfigReq)
-	if !ok {
-		that2, ok := that.(ReloadConfigReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// 设置同一table多个key的字段,每个key的语义与set一致
+// kvnode按key所属的kvstore分组，每个region只发起一次批量proposal
+type MsetReq struct {
+	Table string      `protobuf:"bytes,1,opt,name=table" json:"table"`
+	Items []*MsetItem `protobuf:"bytes,2,rep,name=items" json:"items,omitempty"`
+}
+
+func (m *MsetReq) Reset()      { *m = MsetReq{} }
+func (*MsetReq) ProtoMessage() {}
+func (*MsetReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{34}
+}
+func (m *MsetReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsetReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Path != that1.Path {
-		return false
+}
+func (m *MsetReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsetReq.Merge(m, src)
+}
+func (m *MsetReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsetReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsetReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsetReq proto.InternalMessageInfo
+
+func (m *MsetReq) GetTable() string {
+	if m != nil {
+		return m.Table
 	}
-	return true
+	return ""
 }
-func (this *ReloadConfigResp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsetReq) GetItems() []*MsetItem {
+	if m != nil {
+		return m.Items
 	}
+	return nil
+}
 
-	that1, ok := that.(*ReloadConfigResp)
-	if !ok {
-		that2, ok := that.(ReloadConfigResp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MsetResp struct {
+	Rows []*Row `protobuf:"bytes,1,rep,name=rows" json:"rows,omitempty"`
+}
+
+func (m *MsetResp) Reset()      { *m = MsetResp{} }
+func (*MsetResp) ProtoMessage() {}
+func (*MsetResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{35}
+}
+func (m *MsetResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsetResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsetResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Err != that1.Err {
-		return false
-	}
-	return true
 }
-func (this *Value) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *MsetResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsetResp.Merge(m, src)
+}
+func (m *MsetResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsetResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsetResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsetResp proto.InternalMessageInfo
+
+func (m *MsetResp) GetRows() []*Row {
+	if m != nil {
+		return m.Rows
 	}
+	return nil
+}
 
-	that1, ok := that.(*Value)
-	if !ok {
-		that2, ok := that.(Value)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type TxnOp struct {
+	Key     string   `protobuf:"bytes,1,opt,name=key" json:"key"`
+	Version *int64   `protobuf:"varint,2,opt,name=version" json:"version,omitempty"`
+	Cmp     []*Field `protobuf:"bytes,3,rep,name=cmp" json:"cmp,omitempty"`
+	Fields  []*Field `protobuf:"bytes,4,rep,name=fields" json:"fields,omitempty"`
+	Del     bool     `protobuf:"varint,5,opt,name=del" json:"del"`
+	Table   string   `protobuf:"bytes,6,opt,name=table" json:"table"`
+}
+
+func (m *TxnOp) Reset()      { *m = TxnOp{} }
+func (*TxnOp) ProtoMessage() {}
+func (*TxnOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{36}
+}
+func (m *TxnOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Type != that1.Type {
-		return false
+}
+func (m *TxnOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnOp.Merge(m, src)
+}
+func (m *TxnOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxnOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnOp proto.InternalMessageInfo
+
+func (m *TxnOp) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if this.I != that1.I {
-		return false
+	return ""
+}
+
+func (m *TxnOp) GetVersion() int64 {
+	if m != nil && m.Version != nil {
+		return *m.Version
 	}
-	if this.F != that1.F {
-		return false
+	return 0
+}
+
+func (m *TxnOp) GetCmp() []*Field {
+	if m != nil {
+		return m.Cmp
 	}
-	if this.S != that1.S {
-		return false
+	return nil
+}
+
+func (m *TxnOp) GetFields() []*Field {
+	if m != nil {
+		return m.Fields
 	}
-	if !bytes.Equal(this.B, that1.B) {
-		return false
+	return nil
+}
+
+func (m *TxnOp) GetDel() bool {
+	if m != nil {
+		return m.Del
 	}
-	return true
+	return false
 }
-func (this *Field) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TxnOp) GetTable() string {
+	if m != nil {
+		return m.Table
 	}
+	return ""
+}
 
-	that1, ok := that.(*Field)
-	if !ok {
-		that2, ok := that.(Field)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// 事务,所有key必须属于同一个kvstore,key不能重复
+// 所有op的条件都满足时,所有写入作为一个proposal提交,回写数据库时在同一个数据库事务中执行
+// 任一条件不满足则不执行任何写入,errCode为第一个不满足条件的op的错误码
+type TxnReq struct {
+	Table string   `protobuf:"bytes,1,opt,name=table" json:"table"`
+	Ops   []*TxnOp `protobuf:"bytes,2,rep,name=ops" json:"ops,omitempty"`
+}
+
+func (m *TxnReq) Reset()      { *m = TxnReq{} }
+func (*TxnReq) ProtoMessage() {}
+func (*TxnReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{37}
+}
+func (m *TxnReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if !this.V.Equal(that1.V) {
-		return false
+}
+func (m *TxnReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnReq.Merge(m, src)
+}
+func (m *TxnReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxnReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnReq proto.InternalMessageInfo
+
+func (m *TxnReq) GetTable() string {
+	if m != nil {
+		return m.Table
 	}
-	return true
+	return ""
 }
-func (this *PingReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TxnReq) GetOps() []*TxnOp {
+	if m != nil {
+		return m.Ops
 	}
+	return nil
+}
 
-	that1, ok := that.(*PingReq)
-	if !ok {
-		that2, ok := that.(PingReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type TxnResp struct {
+	Rows []*Row `protobuf:"bytes,1,rep,name=rows" json:"rows,omitempty"`
+}
+
+func (m *TxnResp) Reset()      { *m = TxnResp{} }
+func (*TxnResp) ProtoMessage() {}
+func (*TxnResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{38}
+}
+func (m *TxnResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Timestamp != that1.Timestamp {
-		return false
-	}
-	return true
 }
-func (this *PingResp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *TxnResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnResp.Merge(m, src)
+}
+func (m *TxnResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxnResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnResp proto.InternalMessageInfo
+
+func (m *TxnResp) GetRows() []*Row {
+	if m != nil {
+		return m.Rows
 	}
+	return nil
+}
 
-	that1, ok := that.(*PingResp)
-	if !ok {
-		that2, ok := that.(PingResp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// 在head.unikey指定的记录上执行已注册的脚本,脚本返回的字段写入记录
+type EvalReq struct {
+	Name string   `protobuf:"bytes,1,opt,name=name" json:"name"`
+	Args []string `protobuf:"bytes,2,rep,name=args" json:"args,omitempty"`
+}
+
+func (m *EvalReq) Reset()      { *m = EvalReq{} }
+func (*EvalReq) ProtoMessage() {}
+func (*EvalReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{39}
+}
+func (m *EvalReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvalReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvalReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Timestamp != that1.Timestamp {
-		return false
-	}
-	return true
 }
-func (this *GetReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *EvalReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvalReq.Merge(m, src)
+}
+func (m *EvalReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvalReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvalReq.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GetReq)
-	if !ok {
-		that2, ok := that.(GetReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_EvalReq proto.InternalMessageInfo
+
+func (m *EvalReq) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *EvalReq) GetArgs() []string {
+	if m != nil {
+		return m.Args
 	}
-	if this.Version != nil && that1.Version != nil {
-		if *this.Version != *that1.Version {
-			return false
+	return nil
+}
+
+type EvalResp struct {
+	Version int64    `protobuf:"varint,1,opt,name=version" json:"version"`
+	Fields  []*Field `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
+	Result  string   `protobuf:"bytes,3,opt,name=result" json:"result"`
+}
+
+func (m *EvalResp) Reset()      { *m = EvalResp{} }
+func (*EvalResp) ProtoMessage() {}
+func (*EvalResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{40}
+}
+func (m *EvalResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvalResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvalResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Version != nil {
-		return false
-	} else if that1.Version != nil {
-		return false
-	}
-	if len(this.Fields) != len(that1.Fields) {
-		return false
+		return b[:n], nil
 	}
-	for i := range this.Fields {
-		if this.Fields[i] != that1.Fields[i] {
-			return false
-		}
+}
+func (m *EvalResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvalResp.Merge(m, src)
+}
+func (m *EvalResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvalResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvalResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvalResp proto.InternalMessageInfo
+
+func (m *EvalResp) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	if this.All != that1.All {
-		return false
+	return 0
+}
+
+func (m *EvalResp) GetFields() []*Field {
+	if m != nil {
+		return m.Fields
 	}
-	return true
+	return nil
 }
-func (this *GetResp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EvalResp) GetResult() string {
+	if m != nil {
+		return m.Result
 	}
+	return ""
+}
 
-	that1, ok := that.(*GetResp)
-	if !ok {
-		that2, ok := that.(GetResp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// 按二级索引查询,按key的顺序分批返回索引字段等于value的记录的key及版本号
+// 结果以数据库为基础,合并kvnode缓存中尚未回写的修改
+type GetByIndexReq struct {
+	Table  string `protobuf:"bytes,1,opt,name=table" json:"table"`
+	Value  *Field `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	Count  int32  `protobuf:"varint,3,opt,name=count" json:"count"`
+	Cursor string `protobuf:"bytes,4,opt,name=cursor" json:"cursor"`
+}
+
+func (m *GetByIndexReq) Reset()      { *m = GetByIndexReq{} }
+func (*GetByIndexReq) ProtoMessage() {}
+func (*GetByIndexReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{41}
+}
+func (m *GetByIndexReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetByIndexReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetByIndexReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Version != that1.Version {
-		return false
+}
+func (m *GetByIndexReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetByIndexReq.Merge(m, src)
+}
+func (m *GetByIndexReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetByIndexReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetByIndexReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetByIndexReq proto.InternalMessageInfo
+
+func (m *GetByIndexReq) GetTable() string {
+	if m != nil {
+		return m.Table
 	}
-	if len(this.Fields) != len(that1.Fields) {
-		return false
+	return ""
+}
+
+func (m *GetByIndexReq) GetValue() *Field {
+	if m != nil {
+		return m.Value
 	}
-	for i := range this.Fields {
-		if !this.Fields[i].Equal(that1.Fields[i]) {
-			return false
-		}
+	return nil
+}
+
+func (m *GetByIndexReq) GetCount() int32 {
+	if m != nil {
+		return m.Count
 	}
-	return true
+	return 0
 }
-func (this *SetReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GetByIndexReq) GetCursor() string {
+	if m != nil {
+		return m.Cursor
 	}
+	return ""
+}
 
-	that1, ok := that.(*SetReq)
-	if !ok {
-		that2, ok := that.(SetReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetByIndexResp struct {
+	Rows   []*Row `protobuf:"bytes,1,rep,name=rows" json:"rows,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor" json:"cursor"`
+	Finish bool   `protobuf:"varint,3,opt,name=finish" json:"finish"`
+}
+
+func (m *GetByIndexResp) Reset()      { *m = GetByIndexResp{} }
+func (*GetByIndexResp) ProtoMessage() {}
+func (*GetByIndexResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{42}
+}
+func (m *GetByIndexResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetByIndexResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetByIndexResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *GetByIndexResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetByIndexResp.Merge(m, src)
+}
+func (m *GetByIndexResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetByIndexResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetByIndexResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetByIndexResp proto.InternalMessageInfo
+
+func (m *GetByIndexResp) GetRows() []*Row {
+	if m != nil {
+		return m.Rows
 	}
-	if this.Version != nil && that1.Version != nil {
-		if *this.Version != *that1.Version {
-			return false
-		}
-	} else if this.Version != nil {
-		return false
-	} else if that1.Version != nil {
-		return false
+	return nil
+}
+
+func (m *GetByIndexResp) GetCursor() string {
+	if m != nil {
+		return m.Cursor
 	}
-	if len(this.Fields) != len(that1.Fields) {
-		return false
+	return ""
+}
+
+func (m *GetByIndexResp) GetFinish() bool {
+	if m != nil {
+		return m.Finish
 	}
-	for i := range this.Fields {
-		if !this.Fields[i].Equal(that1.Fields[i]) {
-			return false
+	return false
+}
+
+// 按__key__顺序遍历表格,cursor为上次返回的最后一个key(首次为空)
+// 结果以数据库为基础,合并kvnode缓存中尚未回写的修改
+type ScanReq struct {
+	Table  string   `protobuf:"bytes,1,opt,name=table" json:"table"`
+	Fields []string `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
+	All    bool     `protobuf:"varint,3,opt,name=all" json:"all"`
+	Count  int32    `protobuf:"varint,4,opt,name=count" json:"count"`
+	Cursor string   `protobuf:"bytes,5,opt,name=cursor" json:"cursor"`
+}
+
+func (m *ScanReq) Reset()      { *m = ScanReq{} }
+func (*ScanReq) ProtoMessage() {}
+func (*ScanReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{43}
+}
+func (m *ScanReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScanReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScanReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *SetResp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ScanReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanReq.Merge(m, src)
+}
+func (m *ScanReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScanReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanReq proto.InternalMessageInfo
+
+func (m *ScanReq) GetTable() string {
+	if m != nil {
+		return m.Table
 	}
+	return ""
+}
 
-	that1, ok := that.(*SetResp)
-	if !ok {
-		that2, ok := that.(SetResp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ScanReq) GetFields() []string {
+	if m != nil {
+		return m.Fields
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *ScanReq) GetAll() bool {
+	if m != nil {
+		return m.All
 	}
-	if this.Version != that1.Version {
-		return false
+	return false
+}
+
+func (m *ScanReq) GetCount() int32 {
+	if m != nil {
+		return m.Count
 	}
-	return true
+	return 0
 }
-func (this *SetNxReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ScanReq) GetCursor() string {
+	if m != nil {
+		return m.Cursor
 	}
+	return ""
+}
 
-	that1, ok := that.(*SetNxReq)
-	if !ok {
-		that2, ok := that.(SetNxReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ScanResp struct {
+	Rows   []*Row `protobuf:"bytes,1,rep,name=rows" json:"rows,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor" json:"cursor"`
+	Finish bool   `protobuf:"varint,3,opt,name=finish" json:"finish"`
+}
+
+func (m *ScanResp) Reset()      { *m = ScanResp{} }
+func (*ScanResp) ProtoMessage() {}
+func (*ScanResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{44}
+}
+func (m *ScanResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScanResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScanResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ScanResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanResp.Merge(m, src)
+}
+func (m *ScanResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScanResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanResp proto.InternalMessageInfo
+
+func (m *ScanResp) GetRows() []*Row {
+	if m != nil {
+		return m.Rows
 	}
-	if this.Version != nil && that1.Version != nil {
-		if *this.Version != *that1.Version {
-			return false
-		}
-	} else if this.Version != nil {
-		return false
-	} else if that1.Version != nil {
-		return false
+	return nil
+}
+
+func (m *ScanResp) GetCursor() string {
+	if m != nil {
+		return m.Cursor
 	}
-	if len(this.Fields) != len(that1.Fields) {
-		return false
+	return ""
+}
+
+func (m *ScanResp) GetFinish() bool {
+	if m != nil {
+		return m.Finish
 	}
-	for i := range this.Fields {
-		if !this.Fields[i].Equal(that1.Fields[i]) {
-			return false
+	return false
+}
+
+// 管理命令:将数据库中的记录预加载到本节点作为leader的kvstore
+// keys,[begin,end)范围(end为空表示不限制),all三选一。请求启动后台任务后立即返回任务id,
+// job非0时查询该任务的进度
+type PreloadReq struct {
+	Table string   `protobuf:"bytes,1,opt,name=table" json:"table"`
+	Keys  []string `protobuf:"bytes,2,rep,name=keys" json:"keys,omitempty"`
+	Begin string   `protobuf:"bytes,3,opt,name=begin" json:"begin"`
+	End   string   `protobuf:"bytes,4,opt,name=end" json:"end"`
+	All   bool     `protobuf:"varint,5,opt,name=all" json:"all"`
+	Job   int64    `protobuf:"varint,6,opt,name=job" json:"job"`
+}
+
+func (m *PreloadReq) Reset()      { *m = PreloadReq{} }
+func (*PreloadReq) ProtoMessage() {}
+func (*PreloadReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{45}
+}
+func (m *PreloadReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreloadReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreloadReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *SetNxResp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PreloadReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreloadReq.Merge(m, src)
+}
+func (m *PreloadReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreloadReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreloadReq.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*SetNxResp)
-	if !ok {
-		that2, ok := that.(SetNxResp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_PreloadReq proto.InternalMessageInfo
+
+func (m *PreloadReq) GetTable() string {
+	if m != nil {
+		return m.Table
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *PreloadReq) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
-	if this.Version != that1.Version {
-		return false
+	return nil
+}
+
+func (m *PreloadReq) GetBegin() string {
+	if m != nil {
+		return m.Begin
 	}
-	if len(this.Fields) != len(that1.Fields) {
-		return false
+	return ""
+}
+
+func (m *PreloadReq) GetEnd() string {
+	if m != nil {
+		return m.End
 	}
-	for i := range this.Fields {
-		if !this.Fields[i].Equal(that1.Fields[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *PreloadReq) GetAll() bool {
+	if m != nil {
+		return m.All
 	}
-	return true
+	return false
 }
-func (this *IncrByReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PreloadReq) GetJob() int64 {
+	if m != nil {
+		return m.Job
 	}
+	return 0
+}
 
-	that1, ok := that.(*IncrByReq)
-	if !ok {
-		that2, ok := that.(IncrByReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type PreloadResp struct {
+	Job     int64  `protobuf:"varint,1,opt,name=job" json:"job"`
+	Scanned int64  `protobuf:"varint,2,opt,name=scanned" json:"scanned"`
+	Loaded  int64  `protobuf:"varint,3,opt,name=loaded" json:"loaded"`
+	Skipped int64  `protobuf:"varint,4,opt,name=skipped" json:"skipped"`
+	Finish  bool   `protobuf:"varint,5,opt,name=finish" json:"finish"`
+	Err     string `protobuf:"bytes,6,opt,name=err" json:"err"`
+}
+
+func (m *PreloadResp) Reset()      { *m = PreloadResp{} }
+func (*PreloadResp) ProtoMessage() {}
+func (*PreloadResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{46}
+}
+func (m *PreloadResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreloadResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreloadResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Version != nil && that1.Version != nil {
-		if *this.Version != *that1.Version {
-			return false
-		}
-	} else if this.Version != nil {
-		return false
-	} else if that1.Version != nil {
-		return false
-	}
-	if !this.Field.Equal(that1.Field) {
-		return false
-	}
-	return true
 }
-func (this *IncrByResp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *PreloadResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreloadResp.Merge(m, src)
+}
+func (m *PreloadResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreloadResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreloadResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreloadResp proto.InternalMessageInfo
+
+func (m *PreloadResp) GetJob() int64 {
+	if m != nil {
+		return m.Job
 	}
+	return 0
+}
 
-	that1, ok := that.(*IncrByResp)
-	if !ok {
-		that2, ok := that.(IncrByResp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *PreloadResp) GetScanned() int64 {
+	if m != nil {
+		return m.Scanned
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *PreloadResp) GetLoaded() int64 {
+	if m != nil {
+		return m.Loaded
 	}
-	if this.Version != that1.Version {
-		return false
+	return 0
+}
+
+func (m *PreloadResp) GetSkipped() int64 {
+	if m != nil {
+		return m.Skipped
 	}
-	if !this.Field.Equal(that1.Field) {
-		return false
+	return 0
+}
+
+func (m *PreloadResp) GetFinish() bool {
+	if m != nil {
+		return m.Finish
 	}
-	return true
+	return false
 }
-func (this *DecrByReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PreloadResp) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
+	return ""
+}
 
-	that1, ok := that.(*DecrByReq)
-	if !ok {
-		that2, ok := that.(DecrByReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type Cancel struct {
+	Seqs []int64 `protobuf:"varint,1,rep,name=seqs" json:"seqs,omitempty"`
+}
+
+func (m *Cancel) Reset()      { *m = Cancel{} }
+func (*Cancel) ProtoMessage() {}
+func (*Cancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{47}
+}
+func (m *Cancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Cancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Cancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *Cancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cancel.Merge(m, src)
+}
+func (m *Cancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *Cancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cancel proto.InternalMessageInfo
+
+func (m *Cancel) GetSeqs() []int64 {
+	if m != nil {
+		return m.Seqs
 	}
-	if this.Version != nil && that1.Version != nil {
-		if *this.Version != *that1.Version {
-			return false
+	return nil
+}
+
+// 关注head.unikey指定记录的变更，成功后leader每次提交该记录的变更都会推送watch_notify,
+// watch_notify的seqno为watch请求的seqno
+type WatchReq struct {
+}
+
+func (m *WatchReq) Reset()      { *m = WatchReq{} }
+func (*WatchReq) ProtoMessage() {}
+func (*WatchReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{48}
+}
+func (m *WatchReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Version != nil {
-		return false
-	} else if that1.Version != nil {
-		return false
-	}
-	if !this.Field.Equal(that1.Field) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *DecrByResp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *WatchReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchReq.Merge(m, src)
+}
+func (m *WatchReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchReq.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*DecrByResp)
-	if !ok {
-		that2, ok := that.(DecrByResp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_WatchReq proto.InternalMessageInfo
+
+type WatchResp struct {
+}
+
+func (m *WatchResp) Reset()      { *m = WatchResp{} }
+func (*WatchResp) ProtoMessage() {}
+func (*WatchResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{49}
+}
+func (m *WatchResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Version != that1.Version {
-		return false
-	}
-	if !this.Field.Equal(that1.Field) {
-		return false
-	}
-	return true
 }
-func (this *CompareAndSetReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *WatchResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchResp.Merge(m, src)
+}
+func (m *WatchResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchResp.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*CompareAndSetReq)
-	if !ok {
-		that2, ok := that.(CompareAndSetReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_WatchResp proto.InternalMessageInfo
+
+type UnwatchReq struct {
+	WatchSeqno int64 `protobuf:"varint,1,opt,name=watch_seqno,json=watchSeqno" json:"watch_seqno"`
+}
+
+func (m *UnwatchReq) Reset()      { *m = UnwatchReq{} }
+func (*UnwatchReq) ProtoMessage() {}
+func (*UnwatchReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{50}
+}
+func (m *UnwatchReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnwatchReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnwatchReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *UnwatchReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnwatchReq.Merge(m, src)
+}
+func (m *UnwatchReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnwatchReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnwatchReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnwatchReq proto.InternalMessageInfo
+
+func (m *UnwatchReq) GetWatchSeqno() int64 {
+	if m != nil {
+		return m.WatchSeqno
 	}
-	if this.Version != nil && that1.Version != nil {
-		if *this.Version != *that1.Version {
-			return false
+	return 0
+}
+
+type UnwatchResp struct {
+}
+
+func (m *UnwatchResp) Reset()      { *m = UnwatchResp{} }
+func (*UnwatchResp) ProtoMessage() {}
+func (*UnwatchResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{51}
+}
+func (m *UnwatchResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnwatchResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnwatchResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Version != nil {
-		return false
-	} else if that1.Version != nil {
-		return false
-	}
-	if !this.New.Equal(that1.New) {
-		return false
-	}
-	if !this.Old.Equal(that1.Old) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *CompareAndSetResp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*CompareAndSetResp)
-	if !ok {
-		that2, ok := that.(CompareAndSetResp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Version != that1.Version {
-		return false
-	}
-	if !this.Value.Equal(that1.Value) {
-		return false
-	}
-	return true
+func (m *UnwatchResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnwatchResp.Merge(m, src)
+}
+func (m *UnwatchResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnwatchResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnwatchResp.DiscardUnknown(m)
 }
-func (this *CompareAndSetNxReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*CompareAndSetNxReq)
-	if !ok {
-		that2, ok := that.(CompareAndSetNxReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Version != nil && that1.Version != nil {
-		if *this.Version != *that1.Version {
-			return false
+var xxx_messageInfo_UnwatchResp proto.InternalMessageInfo
+
+type WatchNotify struct {
+	Version int64    `protobuf:"varint,1,opt,name=version" json:"version"`
+	Fields  []*Field `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
+	Del     bool     `protobuf:"varint,3,opt,name=del" json:"del"`
+}
+
+func (m *WatchNotify) Reset()      { *m = WatchNotify{} }
+func (*WatchNotify) ProtoMessage() {}
+func (*WatchNotify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{52}
+}
+func (m *WatchNotify) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchNotify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchNotify.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Version != nil {
-		return false
-	} else if that1.Version != nil {
-		return false
+		return b[:n], nil
 	}
-	if !this.New.Equal(that1.New) {
-		return false
+}
+func (m *WatchNotify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchNotify.Merge(m, src)
+}
+func (m *WatchNotify) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchNotify) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchNotify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchNotify proto.InternalMessageInfo
+
+func (m *WatchNotify) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	if !this.Old.Equal(that1.Old) {
-		return false
+	return 0
+}
+
+func (m *WatchNotify) GetFields() []*Field {
+	if m != nil {
+		return m.Fields
 	}
-	return true
+	return nil
 }
-func (this *CompareAndSetNxResp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *WatchNotify) GetDel() bool {
+	if m != nil {
+		return m.Del
 	}
+	return false
+}
 
-	that1, ok := that.(*CompareAndSetNxResp)
-	if !ok {
-		that2, ok := that.(CompareAndSetNxResp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// 添加或删除kvnode,对所有region执行
+type MemberChangeReq struct {
+	Remove bool   `protobuf:"varint,1,opt,name=remove" json:"remove"`
+	NodeId int32  `protobuf:"varint,2,opt,name=node_id,json=nodeId" json:"node_id"`
+	Url    string `protobuf:"bytes,3,opt,name=url" json:"url"`
+}
+
+func (m *MemberChangeReq) Reset()      { *m = MemberChangeReq{} }
+func (*MemberChangeReq) ProtoMessage() {}
+func (*MemberChangeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{53}
+}
+func (m *MemberChangeReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberChangeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberChangeReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Version != that1.Version {
-		return false
-	}
-	if !this.Value.Equal(that1.Value) {
-		return false
-	}
-	return true
 }
-func (this *DelReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *MemberChangeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberChangeReq.Merge(m, src)
+}
+func (m *MemberChangeReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberChangeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberChangeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberChangeReq proto.InternalMessageInfo
+
+func (m *MemberChangeReq) GetRemove() bool {
+	if m != nil {
+		return m.Remove
 	}
+	return false
+}
 
-	that1, ok := that.(*DelReq)
-	if !ok {
-		that2, ok := that.(DelReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *MemberChangeReq) GetNodeId() int32 {
+	if m != nil {
+		return m.NodeId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *MemberChangeReq) GetUrl() string {
+	if m != nil {
+		return m.Url
 	}
-	if this.Version != nil && that1.Version != nil {
-		if *this.Version != *that1.Version {
-			return false
+	return ""
+}
+
+type MemberChangeResp struct {
+	Err string `protobuf:"bytes,1,opt,name=err" json:"err"`
+}
+
+func (m *MemberChangeResp) Reset()      { *m = MemberChangeResp{} }
+func (*MemberChangeResp) ProtoMessage() {}
+func (*MemberChangeResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{54}
+}
+func (m *MemberChangeResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberChangeResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberChangeResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Version != nil {
-		return false
-	} else if that1.Version != nil {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *DelResp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *MemberChangeResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberChangeResp.Merge(m, src)
+}
+func (m *MemberChangeResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberChangeResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberChangeResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberChangeResp proto.InternalMessageInfo
+
+func (m *MemberChangeResp) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
+	return ""
+}
 
-	that1, ok := that.(*DelResp)
-	if !ok {
-		that2, ok := that.(DelResp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// 将region的leader转移到指定节点
+type TransferLeaderReq struct {
+	Region int32 `protobuf:"varint,1,opt,name=region" json:"region"`
+	NodeId int32 `protobuf:"varint,2,opt,name=node_id,json=nodeId" json:"node_id"`
+}
+
+func (m *TransferLeaderReq) Reset()      { *m = TransferLeaderReq{} }
+func (*TransferLeaderReq) ProtoMessage() {}
+func (*TransferLeaderReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{55}
+}
+func (m *TransferLeaderReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLeaderReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLeaderReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Version != that1.Version {
-		return false
+}
+func (m *TransferLeaderReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLeaderReq.Merge(m, src)
+}
+func (m *TransferLeaderReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferLeaderReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLeaderReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferLeaderReq proto.InternalMessageInfo
+
+func (m *TransferLeaderReq) GetRegion() int32 {
+	if m != nil {
+		return m.Region
 	}
-	return true
+	return 0
 }
-func (this *KickReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TransferLeaderReq) GetNodeId() int32 {
+	if m != nil {
+		return m.NodeId
 	}
+	return 0
+}
 
-	that1, ok := that.(*KickReq)
-	if !ok {
-		that2, ok := that.(KickReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type TransferLeaderResp struct {
+	Err string `protobuf:"bytes,1,opt,name=err" json:"err"`
+}
+
+func (m *TransferLeaderResp) Reset()      { *m = TransferLeaderResp{} }
+func (*TransferLeaderResp) ProtoMessage() {}
+func (*TransferLeaderResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{56}
+}
+func (m *TransferLeaderResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLeaderResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLeaderResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
 }
-func (this *KickResp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *TransferLeaderResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLeaderResp.Merge(m, src)
+}
+func (m *TransferLeaderResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferLeaderResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLeaderResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferLeaderResp proto.InternalMessageInfo
+
+func (m *TransferLeaderResp) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
+	return ""
+}
 
-	that1, ok := that.(*KickResp)
-	if !ok {
-		that2, ok := that.(KickResp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// 将slot迁移到指定region,需要发往源region与目标region的leader所在节点
+type MoveSlotReq struct {
+	Slot   int32 `protobuf:"varint,1,opt,name=slot" json:"slot"`
+	Region int32 `protobuf:"varint,2,opt,name=region" json:"region"`
+}
+
+func (m *MoveSlotReq) Reset()      { *m = MoveSlotReq{} }
+func (*MoveSlotReq) ProtoMessage() {}
+func (*MoveSlotReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{57}
+}
+func (m *MoveSlotReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveSlotReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveSlotReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *MoveSlotReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveSlotReq.Merge(m, src)
+}
+func (m *MoveSlotReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveSlotReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveSlotReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveSlotReq proto.InternalMessageInfo
+
+func (m *MoveSlotReq) GetSlot() int32 {
+	if m != nil {
+		return m.Slot
 	}
-	return true
+	return 0
 }
-func (this *Cancel) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MoveSlotReq) GetRegion() int32 {
+	if m != nil {
+		return m.Region
 	}
+	return 0
+}
 
-	that1, ok := that.(*Cancel)
-	if !ok {
-		that2, ok := that.(Cancel)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MoveSlotResp struct {
+	Err string `protobuf:"bytes,1,opt,name=err" json:"err"`
+}
+
+func (m *MoveSlotResp) Reset()      { *m = MoveSlotResp{} }
+func (*MoveSlotResp) ProtoMessage() {}
+func (*MoveSlotResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{58}
+}
+func (m *MoveSlotResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveSlotResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveSlotResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Seqs) != len(that1.Seqs) {
-		return false
+}
+func (m *MoveSlotResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveSlotResp.Merge(m, src)
+}
+func (m *MoveSlotResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveSlotResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveSlotResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveSlotResp proto.InternalMessageInfo
+
+func (m *MoveSlotResp) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
-	for i := range this.Seqs {
-		if this.Seqs[i] != that1.Seqs[i] {
-			return false
+	return ""
+}
+
+type GetSlotTableReq struct {
+}
+
+func (m *GetSlotTableReq) Reset()      { *m = GetSlotTableReq{} }
+func (*GetSlotTableReq) ProtoMessage() {}
+func (*GetSlotTableReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{59}
+}
+func (m *GetSlotTableReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSlotTableReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSlotTableReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *LoginReq) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&proto.LoginReq{")
-	s = append(s, "Compress: "+fmt.Sprintf("%#v", this.Compress)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *GetSlotTableReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSlotTableReq.Merge(m, src)
 }
-func (this *LoginResp) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.LoginResp{")
-	s = append(s, "Ok: "+fmt.Sprintf("%#v", this.Ok)+",\n")
-	s = append(s, "Compress: "+fmt.Sprintf("%#v", this.Compress)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *GetSlotTableReq) XXX_Size() int {
+	return m.Size()
 }
-func (this *ReloadTableConfReq) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&proto.ReloadTableConfReq{")
-	s = append(s, "Seqno: "+fmt.Sprintf("%#v", this.Seqno)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *GetSlotTableReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSlotTableReq.DiscardUnknown(m)
 }
-func (this *ReloadTableConfResp) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 7)
-	s = append(s, "&proto.ReloadTableConfResp{")
-	s = append(s, "Seqno: "+fmt.Sprintf("%#v", this.Seqno)+",\n")
-	s = append(s, "ErrCode: "+fmt.Sprintf("%#v", this.ErrCode)+",\n")
-	s = append(s, "Err: "+fmt.Sprintf("%#v", this.Err)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+var xxx_messageInfo_GetSlotTableReq proto.InternalMessageInfo
+
+// version为所有slot迁移次数之和,regions[slot]为负责该slot的region
+type GetSlotTableResp struct {
+	Version int64   `protobuf:"varint,1,opt,name=version" json:"version"`
+	Regions []int32 `protobuf:"varint,2,rep,packed,name=regions" json:"regions,omitempty"`
 }
-func (this *ReloadConfigReq) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&proto.ReloadConfigReq{")
-	s = append(s, "Path: "+fmt.Sprintf("%#v", this.Path)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *GetSlotTableResp) Reset()      { *m = GetSlotTableResp{} }
+func (*GetSlotTableResp) ProtoMessage() {}
+func (*GetSlotTableResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{60}
 }
-func (this *ReloadConfigResp) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&proto.ReloadConfigResp{")
-	s = append(s, "Err: "+fmt.Sprintf("%#v", this.Err)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *GetSlotTableResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *Value) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *GetSlotTableResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSlotTableResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&proto.Value{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "I: "+fmt.Sprintf("%#v", this.I)+",\n")
-	s = append(s, "F: "+fmt.Sprintf("%#v", this.F)+",\n")
-	s = append(s, "S: "+fmt.Sprintf("%#v", this.S)+",\n")
-	s = append(s, "B: "+fmt.Sprintf("%#v", this.B)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *Field) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *GetSlotTableResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSlotTableResp.Merge(m, src)
+}
+func (m *GetSlotTableResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSlotTableResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSlotTableResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSlotTableResp proto.InternalMessageInfo
+
+func (m *GetSlotTableResp) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.Field{")
-	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
-	if this.V != nil {
-		s = append(s, "V: "+fmt.Sprintf("%#v", this.V)+",\n")
+	return 0
+}
+
+func (m *GetSlotTableResp) GetRegions() []int32 {
+	if m != nil {
+		return m.Regions
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *PingReq) GoString() string {
-	if this == nil {
-		return "nil"
+
+// kvnode向kvpd上报的单个region状态
+type RegionInfo struct {
+	Region int32  `protobuf:"varint,1,opt,name=region" json:"region"`
+	Leader int32  `protobuf:"varint,2,opt,name=leader" json:"leader"`
+	Term   uint64 `protobuf:"varint,3,opt,name=term" json:"term"`
+}
+
+func (m *RegionInfo) Reset()      { *m = RegionInfo{} }
+func (*RegionInfo) ProtoMessage() {}
+func (*RegionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{61}
+}
+func (m *RegionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&proto.PingReq{")
-	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *PingResp) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *RegionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionInfo.Merge(m, src)
+}
+func (m *RegionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionInfo proto.InternalMessageInfo
+
+func (m *RegionInfo) GetRegion() int32 {
+	if m != nil {
+		return m.Region
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&proto.PingResp{")
-	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *GetReq) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *RegionInfo) GetLeader() int32 {
+	if m != nil {
+		return m.Leader
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&proto.GetReq{")
-	if this.Version != nil {
-		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
-	}
-	if this.Fields != nil {
-		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
-	}
-	s = append(s, "All: "+fmt.Sprintf("%#v", this.All)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *GetResp) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.GetResp{")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	if this.Fields != nil {
-		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+
+func (m *RegionInfo) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *SetReq) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.SetReq{")
-	if this.Version != nil {
-		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
-	}
-	if this.Fields != nil {
-		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+type ReportRegionReq struct {
+	NodeId      int32         `protobuf:"varint,1,opt,name=node_id,json=nodeId" json:"node_id"`
+	Service     string        `protobuf:"bytes,2,opt,name=service" json:"service"`
+	RaftUrl     string        `protobuf:"bytes,3,opt,name=raft_url,json=raftUrl" json:"raft_url"`
+	RegionCount int32         `protobuf:"varint,4,opt,name=region_count,json=regionCount" json:"region_count"`
+	Join        bool          `protobuf:"varint,5,opt,name=join" json:"join"`
+	Regions     []*RegionInfo `protobuf:"bytes,6,rep,name=regions" json:"regions,omitempty"`
 }
-func (this *SetResp) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&proto.SetResp{")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *ReportRegionReq) Reset()      { *m = ReportRegionReq{} }
+func (*ReportRegionReq) ProtoMessage() {}
+func (*ReportRegionReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{62}
 }
-func (this *SetNxReq) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.SetNxReq{")
-	if this.Version != nil {
-		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
-	}
-	if this.Fields != nil {
-		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *ReportRegionReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *SetNxResp) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.SetNxResp{")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	if this.Fields != nil {
-		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+func (m *ReportRegionReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportRegionReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *IncrByReq) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *ReportRegionReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportRegionReq.Merge(m, src)
+}
+func (m *ReportRegionReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportRegionReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportRegionReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportRegionReq proto.InternalMessageInfo
+
+func (m *ReportRegionReq) GetNodeId() int32 {
+	if m != nil {
+		return m.NodeId
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.IncrByReq{")
-	if this.Version != nil {
-		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	return 0
+}
+
+func (m *ReportRegionReq) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if this.Field != nil {
-		s = append(s, "Field: "+fmt.Sprintf("%#v", this.Field)+",\n")
+	return ""
+}
+
+func (m *ReportRegionReq) GetRaftUrl() string {
+	if m != nil {
+		return m.RaftUrl
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *IncrByResp) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReportRegionReq) GetRegionCount() int32 {
+	if m != nil {
+		return m.RegionCount
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.IncrByResp{")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	if this.Field != nil {
-		s = append(s, "Field: "+fmt.Sprintf("%#v", this.Field)+",\n")
+	return 0
+}
+
+func (m *ReportRegionReq) GetJoin() bool {
+	if m != nil {
+		return m.Join
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return false
 }
-func (this *DecrByReq) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReportRegionReq) GetRegions() []*RegionInfo {
+	if m != nil {
+		return m.Regions
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.DecrByReq{")
-	if this.Version != nil {
-		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	return nil
+}
+
+type ReportRegionResp struct {
+	Err string `protobuf:"bytes,1,opt,name=err" json:"err"`
+}
+
+func (m *ReportRegionResp) Reset()      { *m = ReportRegionResp{} }
+func (*ReportRegionResp) ProtoMessage() {}
+func (*ReportRegionResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{63}
+}
+func (m *ReportRegionResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportRegionResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportRegionResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Field != nil {
-		s = append(s, "Field: "+fmt.Sprintf("%#v", this.Field)+",\n")
+}
+func (m *ReportRegionResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportRegionResp.Merge(m, src)
+}
+func (m *ReportRegionResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportRegionResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportRegionResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportRegionResp proto.InternalMessageInfo
+
+func (m *ReportRegionResp) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *DecrByResp) GoString() string {
-	if this == nil {
-		return "nil"
+
+type RouteInfo struct {
+	Region  int32  `protobuf:"varint,1,opt,name=region" json:"region"`
+	Leader  int32  `protobuf:"varint,2,opt,name=leader" json:"leader"`
+	Service string `protobuf:"bytes,3,opt,name=service" json:"service"`
+}
+
+func (m *RouteInfo) Reset()      { *m = RouteInfo{} }
+func (*RouteInfo) ProtoMessage() {}
+func (*RouteInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{64}
+}
+func (m *RouteInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.DecrByResp{")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	if this.Field != nil {
-		s = append(s, "Field: "+fmt.Sprintf("%#v", this.Field)+",\n")
+}
+func (m *RouteInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteInfo.Merge(m, src)
+}
+func (m *RouteInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteInfo proto.InternalMessageInfo
+
+func (m *RouteInfo) GetRegion() int32 {
+	if m != nil {
+		return m.Region
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *CompareAndSetReq) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *RouteInfo) GetLeader() int32 {
+	if m != nil {
+		return m.Leader
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&proto.CompareAndSetReq{")
-	if this.Version != nil {
-		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	return 0
+}
+
+func (m *RouteInfo) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if this.New != nil {
-		s = append(s, "New: "+fmt.Sprintf("%#v", this.New)+",\n")
+	return ""
+}
+
+type NodeInfo struct {
+	NodeId  int32  `protobuf:"varint,1,opt,name=node_id,json=nodeId" json:"node_id"`
+	Service string `protobuf:"bytes,2,opt,name=service" json:"service"`
+}
+
+func (m *NodeInfo) Reset()      { *m = NodeInfo{} }
+func (*NodeInfo) ProtoMessage() {}
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{65}
+}
+func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Old != nil {
-		s = append(s, "Old: "+fmt.Sprintf("%#v", this.Old)+",\n")
+}
+func (m *NodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInfo.Merge(m, src)
+}
+func (m *NodeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeInfo proto.InternalMessageInfo
+
+func (m *NodeInfo) GetNodeId() int32 {
+	if m != nil {
+		return m.NodeId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *CompareAndSetResp) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *NodeInfo) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.CompareAndSetResp{")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	if this.Value != nil {
-		s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CompareAndSetNxReq) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 7)
-	s = append(s, "&proto.CompareAndSetNxReq{")
-	if this.Version != nil {
-		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
-	}
-	if this.New != nil {
-		s = append(s, "New: "+fmt.Sprintf("%#v", this.New)+",\n")
-	}
-	if this.Old != nil {
-		s = append(s, "Old: "+fmt.Sprintf("%#v", this.Old)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CompareAndSetNxResp) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&proto.CompareAndSetNxResp{")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	if this.Value != nil {
-		s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *DelReq) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&proto.DelReq{")
-	if this.Version != nil {
-		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+type QueryRouteReq struct {
 }
-func (this *DelResp) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&proto.DelResp{")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *QueryRouteReq) Reset()      { *m = QueryRouteReq{} }
+func (*QueryRouteReq) ProtoMessage() {}
+func (*QueryRouteReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{66}
 }
-func (this *KickReq) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 4)
-	s = append(s, "&proto.KickReq{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *QueryRouteReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *KickResp) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *QueryRouteReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&proto.KickResp{")
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *Cancel) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&proto.Cancel{")
-	if this.Seqs != nil {
-		s = append(s, "Seqs: "+fmt.Sprintf("%#v", this.Seqs)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *QueryRouteReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteReq.Merge(m, src)
 }
-func valueToGoStringProto(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
-	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+func (m *QueryRouteReq) XXX_Size() int {
+	return m.Size()
 }
-func (m *LoginReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRouteReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteReq.DiscardUnknown(m)
 }
 
-func (m *LoginReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRouteReq proto.InternalMessageInfo
+
+type QueryRouteResp struct {
+	RegionCount int32        `protobuf:"varint,1,opt,name=region_count,json=regionCount" json:"region_count"`
+	Routes      []*RouteInfo `protobuf:"bytes,2,rep,name=routes" json:"routes,omitempty"`
+	Nodes       []*NodeInfo  `protobuf:"bytes,3,rep,name=nodes" json:"nodes,omitempty"`
 }
 
-func (m *LoginReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i--
-	if m.Compress {
-		dAtA[i] = 1
+func (m *QueryRouteResp) Reset()      { *m = QueryRouteResp{} }
+func (*QueryRouteResp) ProtoMessage() {}
+func (*QueryRouteResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{67}
+}
+func (m *QueryRouteResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteResp.Marshal(b, m, deterministic)
 	} else {
-		dAtA[i] = 0
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
 }
-
-func (m *LoginResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRouteResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteResp.Merge(m, src)
 }
-
-func (m *LoginResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRouteResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteResp.DiscardUnknown(m)
 }
 
-func (m *LoginResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i--
-	if m.Compress {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+var xxx_messageInfo_QueryRouteResp proto.InternalMessageInfo
+
+func (m *QueryRouteResp) GetRegionCount() int32 {
+	if m != nil {
+		return m.RegionCount
 	}
-	i--
-	dAtA[i] = 0x10
-	i--
-	if m.Ok {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	return 0
+}
+
+func (m *QueryRouteResp) GetRoutes() []*RouteInfo {
+	if m != nil {
+		return m.Routes
 	}
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ReloadTableConfReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRouteResp) GetNodes() []*NodeInfo {
+	if m != nil {
+		return m.Nodes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ReloadTableConfReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("proto.CmdType", CmdType_name, CmdType_value)
+	proto.RegisterEnum("proto.ValueType", ValueType_name, ValueType_value)
+	proto.RegisterType((*LoginReq)(nil), "proto.loginReq")
+	proto.RegisterType((*LoginResp)(nil), "proto.loginResp")
+	proto.RegisterType((*ReloadTableConfReq)(nil), "proto.reloadTableConfReq")
+	proto.RegisterType((*ReloadTableConfResp)(nil), "proto.reloadTableConfResp")
+	proto.RegisterType((*ReloadConfigReq)(nil), "proto.reloadConfigReq")
+	proto.RegisterType((*ReloadConfigResp)(nil), "proto.reloadConfigResp")
+	proto.RegisterType((*Value)(nil), "proto.value")
+	proto.RegisterType((*Field)(nil), "proto.field")
+	proto.RegisterType((*PingReq)(nil), "proto.ping_req")
+	proto.RegisterType((*PingResp)(nil), "proto.ping_resp")
+	proto.RegisterType((*GetReq)(nil), "proto.get_req")
+	proto.RegisterType((*GetResp)(nil), "proto.get_resp")
+	proto.RegisterType((*SetReq)(nil), "proto.set_req")
+	proto.RegisterType((*SetResp)(nil), "proto.set_resp")
+	proto.RegisterType((*SetNxReq)(nil), "proto.set_nx_req")
+	proto.RegisterType((*SetNxResp)(nil), "proto.set_nx_resp")
+	proto.RegisterType((*IncrByReq)(nil), "proto.incr_by_req")
+	proto.RegisterType((*IncrByResp)(nil), "proto.incr_by_resp")
+	proto.RegisterType((*DecrByReq)(nil), "proto.decr_by_req")
+	proto.RegisterType((*DecrByResp)(nil), "proto.decr_by_resp")
+	proto.RegisterType((*CompareAndSetReq)(nil), "proto.compare_and_set_req")
+	proto.RegisterType((*CompareAndSetResp)(nil), "proto.compare_and_set_resp")
+	proto.RegisterType((*CompareAndSetNxReq)(nil), "proto.compare_and_set_nx_req")
+	proto.RegisterType((*CompareAndSetNxResp)(nil), "proto.compare_and_set_nx_resp")
+	proto.RegisterType((*DelReq)(nil), "proto.del_req")
+	proto.RegisterType((*DelResp)(nil), "proto.del_resp")
+	proto.RegisterType((*UnsetReq)(nil), "proto.unset_req")
+	proto.RegisterType((*UnsetResp)(nil), "proto.unset_resp")
+	proto.RegisterType((*KickReq)(nil), "proto.kick_req")
+	proto.RegisterType((*KickResp)(nil), "proto.kick_resp")
+	proto.RegisterType((*Row)(nil), "proto.row")
+	proto.RegisterType((*MgetReq)(nil), "proto.mget_req")
+	proto.RegisterType((*MgetResp)(nil), "proto.mget_resp")
+	proto.RegisterType((*MsetItem)(nil), "proto.mset_item")
+	proto.RegisterType((*MsetReq)(nil), "proto.mset_req")
+	proto.RegisterType((*MsetResp)(nil), "proto.mset_resp")
+	proto.RegisterType((*TxnOp)(nil), "proto.txn_op")
+	proto.RegisterType((*TxnReq)(nil), "proto.txn_req")
+	proto.RegisterType((*TxnResp)(nil), "proto.txn_resp")
+	proto.RegisterType((*EvalReq)(nil), "proto.eval_req")
+	proto.RegisterType((*EvalResp)(nil), "proto.eval_resp")
+	proto.RegisterType((*GetByIndexReq)(nil), "proto.get_by_index_req")
+	proto.RegisterType((*GetByIndexResp)(nil), "proto.get_by_index_resp")
+	proto.RegisterType((*ScanReq)(nil), "proto.scan_req")
+	proto.RegisterType((*ScanResp)(nil), "proto.scan_resp")
+	proto.RegisterType((*PreloadReq)(nil), "proto.preload_req")
+	proto.RegisterType((*PreloadResp)(nil), "proto.preload_resp")
+	proto.RegisterType((*Cancel)(nil), "proto.cancel")
+	proto.RegisterType((*WatchReq)(nil), "proto.watch_req")
+	proto.RegisterType((*WatchResp)(nil), "proto.watch_resp")
+	proto.RegisterType((*UnwatchReq)(nil), "proto.unwatch_req")
+	proto.RegisterType((*UnwatchResp)(nil), "proto.unwatch_resp")
+	proto.RegisterType((*WatchNotify)(nil), "proto.watch_notify")
+	proto.RegisterType((*MemberChangeReq)(nil), "proto.member_change_req")
+	proto.RegisterType((*MemberChangeResp)(nil), "proto.member_change_resp")
+	proto.RegisterType((*TransferLeaderReq)(nil), "proto.transfer_leader_req")
+	proto.RegisterType((*TransferLeaderResp)(nil), "proto.transfer_leader_resp")
+	proto.RegisterType((*MoveSlotReq)(nil), "proto.move_slot_req")
+	proto.RegisterType((*MoveSlotResp)(nil), "proto.move_slot_resp")
+	proto.RegisterType((*GetSlotTableReq)(nil), "proto.get_slot_table_req")
+	proto.RegisterType((*GetSlotTableResp)(nil), "proto.get_slot_table_resp")
+	proto.RegisterType((*RegionInfo)(nil), "proto.region_info")
+	proto.RegisterType((*ReportRegionReq)(nil), "proto.report_region_req")
+	proto.RegisterType((*ReportRegionResp)(nil), "proto.report_region_resp")
+	proto.RegisterType((*RouteInfo)(nil), "proto.route_info")
+	proto.RegisterType((*NodeInfo)(nil), "proto.node_info")
+	proto.RegisterType((*QueryRouteReq)(nil), "proto.query_route_req")
+	proto.RegisterType((*QueryRouteResp)(nil), "proto.query_route_resp")
 }
 
-func (m *ReloadTableConfReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i = encodeVarintProto(dAtA, i, uint64(m.Seqno))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("proto.proto", fileDescriptor_2fcc84b9998d60d8) }
+
+var fileDescriptor_2fcc84b9998d60d8 = []byte{
+	// 1921 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x70, 0xb9, 0xfc, 0xf3, 0x48, 0x49, 0xa3, 0x91, 0xaa, 0xb0, 0xaa, 0x43, 0x0b, 0x83,
+	0xfe, 0x91, 0x15, 0xc1, 0x01, 0x82, 0x1e, 0x72, 0xe9, 0xa1, 0x52, 0x5a, 0xc3, 0x4d, 0xac, 0x26,
+	0x2b, 0xbb, 0x05, 0x0a, 0x14, 0xc4, 0x92, 0x3b, 0xa4, 0xd6, 0x5c, 0xce, 0xac, 0x76, 0x97, 0x14,
+	0x89, 0x5e, 0x0a, 0xf4, 0x54, 0xa0, 0x28, 0x72, 0xed, 0xad, 0xbd, 0xf5, 0x33, 0xf4, 0x13, 0xf8,
+	0xe8, 0x63, 0x4e, 0x45, 0x2d, 0xf7, 0xd0, 0x63, 0x3e, 0x42, 0xf1, 0x66, 0x77, 0xc9, 0x5d, 0x92,
+	0xa6, 0xe8, 0x58, 0xc9, 0x45, 0x1a, 0xfe, 0xde, 0xcc, 0x7b, 0xbf, 0xf7, 0x9b, 0xd9, 0x37, 0x7f,
+	0xa0, 0xe6, 0x07, 0x2a, 0x52, 0x0f, 0xf5, 0x5f, 0x66, 0xea, 0x7f, 0x07, 0x7b, 0x3d, 0xd5, 0x53,
+	0xba, 0xf9, 0x21, 0xb6, 0x62, 0x23, 0x3f, 0x81, 0x8a, 0xa7, 0x7a, 0xae, 0xb4, 0xc4, 0x15, 0x3b,
+	0x84, 0x4a, 0x47, 0x0d, 0xfc, 0x40, 0x84, 0x61, 0x83, 0x1c, 0x92, 0xa3, 0xca, 0x69, 0xf1, 0xc5,
+	0xbf, 0xef, 0x6f, 0x58, 0x53, 0x94, 0x9f, 0x41, 0x35, 0xe9, 0x1d, 0xfa, 0x6c, 0x0f, 0x0a, 0xaa,
+	0x9f, 0xeb, 0x58, 0x50, 0xfd, 0x9c, 0x93, 0xc2, 0x52, 0x27, 0xe7, 0xc0, 0x02, 0xe1, 0x29, 0xdb,
+	0x79, 0x6a, 0xb7, 0x3d, 0x71, 0xa6, 0x64, 0x17, 0x83, 0x1f, 0x80, 0x19, 0x8a, 0x2b, 0xa9, 0x1a,
+	0xe4, 0xb0, 0x70, 0x64, 0x24, 0x83, 0x62, 0x88, 0xdd, 0x83, 0x72, 0x20, 0x7a, 0xae, 0x92, 0xe8,
+	0xd2, 0x38, 0x32, 0x4f, 0x0b, 0x94, 0x58, 0x29, 0xc4, 0xff, 0x4c, 0x60, 0x77, 0xc1, 0x61, 0xe8,
+	0xaf, 0xf4, 0xd8, 0x84, 0xb2, 0x08, 0x82, 0x33, 0xe5, 0x88, 0x46, 0xe1, 0xb0, 0x70, 0x64, 0x26,
+	0xd6, 0x14, 0x64, 0xfb, 0x60, 0x88, 0x20, 0x68, 0x18, 0x87, 0xe4, 0xa8, 0x9a, 0xd8, 0x10, 0xc0,
+	0x71, 0x23, 0x11, 0x84, 0xae, 0x92, 0x8d, 0xe2, 0x21, 0x99, 0x7a, 0x4d, 0x41, 0xfe, 0x01, 0x6c,
+	0xc7, 0x54, 0x90, 0x85, 0xdb, 0xc3, 0xc4, 0x1a, 0x50, 0xf4, 0xed, 0xe8, 0xb2, 0x41, 0x32, 0xbe,
+	0x34, 0xc2, 0x8f, 0x81, 0xe6, 0x3b, 0x87, 0x7e, 0x1a, 0x98, 0xcc, 0x05, 0xe6, 0x7f, 0x22, 0x60,
+	0x8e, 0x6c, 0x6f, 0x28, 0xd8, 0x31, 0x14, 0xa3, 0x89, 0x2f, 0x74, 0x56, 0x5b, 0x1f, 0xd1, 0x78,
+	0x1e, 0x1f, 0xfe, 0x06, 0x6d, 0x4f, 0x27, 0xbe, 0x48, 0x23, 0x60, 0x1f, 0xc6, 0x80, 0xb8, 0x8d,
+	0x42, 0x86, 0x28, 0x71, 0x11, 0xeb, 0xea, 0xc4, 0x48, 0x8a, 0x75, 0x11, 0x0b, 0x1b, 0xc5, 0x4c,
+	0x4c, 0x12, 0x22, 0xd6, 0x6e, 0x98, 0x87, 0xe4, 0xa8, 0x9e, 0x62, 0x6d, 0xfe, 0x33, 0x30, 0xbb,
+	0xae, 0xf0, 0x1c, 0x4c, 0x4a, 0xda, 0x03, 0x91, 0x4f, 0x0a, 0x11, 0x76, 0x00, 0x64, 0xa4, 0x43,
+	0xd6, 0x3e, 0xaa, 0x27, 0xdc, 0x34, 0x6f, 0x8b, 0x8c, 0xf8, 0x43, 0xa8, 0xf8, 0xae, 0xec, 0xb5,
+	0x02, 0x71, 0xc5, 0x38, 0x54, 0x23, 0x77, 0x20, 0xc2, 0xc8, 0x1e, 0xf8, 0x0d, 0x92, 0xa1, 0x38,
+	0x83, 0xf9, 0x87, 0x50, 0x4d, 0xfa, 0x87, 0x7e, 0x7e, 0x40, 0x61, 0xf9, 0x80, 0xbf, 0x11, 0x28,
+	0xf7, 0x44, 0xa4, 0x03, 0x64, 0xa6, 0x6a, 0xe6, 0x9e, 0x4c, 0xa7, 0x8a, 0xed, 0x43, 0x49, 0xe7,
+	0x12, 0xaf, 0xa9, 0xaa, 0x95, 0xfc, 0xc2, 0x19, 0xb0, 0x3d, 0xaf, 0x61, 0x64, 0xd6, 0x2e, 0x02,
+	0xec, 0x3e, 0x54, 0xc2, 0xc8, 0xf6, 0x44, 0x4b, 0xf5, 0x1b, 0xc5, 0x8c, 0xb1, 0xac, 0xd1, 0x5f,
+	0xf7, 0xd9, 0xfb, 0x50, 0x1e, 0xd8, 0xe3, 0x96, 0x67, 0xf7, 0x1a, 0xe6, 0x34, 0xe0, 0x86, 0x55,
+	0x1a, 0xd8, 0xe3, 0xcf, 0xec, 0x1e, 0xff, 0x03, 0x54, 0x62, 0x6a, 0xa1, 0xbf, 0x9c, 0xdb, 0x6c,
+	0x19, 0xb1, 0x1f, 0xe6, 0xb8, 0xcd, 0x94, 0xd4, 0xe0, 0x94, 0xe9, 0x03, 0xd8, 0xb4, 0x7d, 0xdf,
+	0x73, 0x85, 0xd3, 0x72, 0xa5, 0x23, 0xc6, 0x9a, 0x73, 0x31, 0xf1, 0x55, 0x4f, 0x4c, 0x8f, 0xd1,
+	0xc2, 0x7b, 0x50, 0x0e, 0xd7, 0xd4, 0x65, 0xbd, 0xd8, 0xfb, 0x60, 0x44, 0x51, 0xac, 0x52, 0xca,
+	0x1e, 0x01, 0x7e, 0x0c, 0x95, 0x70, 0xcd, 0x2c, 0xf9, 0x73, 0x00, 0xec, 0x2b, 0xc7, 0xdf, 0x01,
+	0xaf, 0x0b, 0xa8, 0x4d, 0x63, 0xdd, 0xd5, 0x04, 0x70, 0x17, 0x6a, 0xae, 0xec, 0x04, 0xad, 0xf6,
+	0x64, 0xad, 0x0c, 0x78, 0xf2, 0xf5, 0xe8, 0x92, 0x33, 0xef, 0x33, 0x36, 0xbd, 0x91, 0xbf, 0x05,
+	0xf5, 0x59, 0xa8, 0x35, 0x12, 0xc8, 0xc4, 0x22, 0x6f, 0x88, 0xc5, 0xbf, 0x80, 0x9a, 0x23, 0xee,
+	0x94, 0x3e, 0xd2, 0x74, 0xc4, 0x1d, 0xd3, 0x1c, 0xc2, 0x2e, 0xee, 0x1d, 0x76, 0x20, 0x5a, 0xb6,
+	0x74, 0x5a, 0xeb, 0xae, 0xe3, 0x26, 0x18, 0x52, 0x5c, 0x2f, 0x25, 0x8b, 0x06, 0xb4, 0x2b, 0xcf,
+	0x69, 0x18, 0xcb, 0xec, 0xca, 0x73, 0xf8, 0xef, 0x60, 0x6f, 0x31, 0xec, 0x7a, 0x29, 0xe9, 0x82,
+	0xb7, 0x3c, 0x25, 0x6d, 0xe2, 0x63, 0xd8, 0x9f, 0xf7, 0x2d, 0xc7, 0xdf, 0x49, 0x56, 0xbf, 0x87,
+	0xf7, 0x96, 0x46, 0xbe, 0xa3, 0xc4, 0x1e, 0x40, 0xd9, 0x11, 0xde, 0x3a, 0x99, 0x60, 0xa5, 0x88,
+	0xbb, 0xae, 0x51, 0x29, 0xce, 0xa0, 0x3a, 0x94, 0xe1, 0xbb, 0x15, 0x76, 0x7e, 0x02, 0x90, 0x3a,
+	0x59, 0x23, 0x24, 0x40, 0xa5, 0xef, 0x76, 0xfa, 0x18, 0x91, 0xd7, 0xa0, 0x9a, 0xb4, 0x43, 0x1f,
+	0x77, 0x62, 0x23, 0x50, 0xd7, 0xf8, 0xa5, 0xf6, 0xc5, 0x24, 0xbf, 0x53, 0xf7, 0xc5, 0x24, 0xeb,
+	0xb8, 0xb0, 0xba, 0xb4, 0x18, 0x2b, 0xea, 0x58, 0xe6, 0x80, 0x82, 0x9b, 0xcd, 0xfc, 0x01, 0x85,
+	0x3f, 0x87, 0xca, 0x20, 0xdd, 0xe9, 0x0e, 0xc0, 0x8c, 0xf0, 0xe4, 0x93, 0xe3, 0x12, 0x43, 0x8c,
+	0x41, 0xb1, 0x2f, 0x26, 0xa9, 0x14, 0xba, 0xcd, 0xf6, 0x73, 0x0c, 0x16, 0x76, 0xbe, 0xe2, 0xdc,
+	0xce, 0xc7, 0x3f, 0x80, 0xea, 0x20, 0xb3, 0x75, 0x15, 0x03, 0x75, 0x8d, 0x07, 0x44, 0x24, 0x0f,
+	0x09, 0xf9, 0x40, 0x5d, 0x5b, 0x1a, 0xe7, 0x2e, 0x54, 0x07, 0x28, 0xb2, 0x1b, 0x89, 0xc1, 0xdb,
+	0x69, 0x44, 0xde, 0x52, 0x23, 0x7e, 0x0e, 0x95, 0x41, 0xb8, 0x86, 0x06, 0x3f, 0x06, 0x13, 0xd9,
+	0xa4, 0xb5, 0x3c, 0x3d, 0x32, 0x4d, 0x69, 0x5a, 0xb1, 0x59, 0xe7, 0x19, 0xae, 0x9b, 0xe7, 0xbf,
+	0x08, 0x94, 0xa2, 0xb1, 0x6c, 0x29, 0xff, 0x1b, 0x67, 0xd9, 0x04, 0xa3, 0x33, 0xf0, 0x97, 0xa6,
+	0x88, 0x86, 0x8c, 0x0a, 0xc5, 0xd5, 0x3b, 0x9e, 0x23, 0xbc, 0x86, 0x99, 0x9d, 0x35, 0x47, 0x78,
+	0x33, 0x45, 0x4a, 0x0b, 0x8a, 0xf0, 0x5f, 0x42, 0x19, 0xb9, 0xdf, 0x26, 0xdc, 0x7d, 0x30, 0x94,
+	0x9f, 0xca, 0xb6, 0x99, 0x44, 0x8f, 0x93, 0xb6, 0xd0, 0x82, 0xdf, 0x70, 0xec, 0x67, 0x0d, 0xc1,
+	0x3e, 0x86, 0x8a, 0x18, 0xd9, 0x71, 0x6d, 0x78, 0xf3, 0xf1, 0x91, 0x41, 0xd1, 0x0e, 0x7a, 0xd3,
+	0xf5, 0x8a, 0x6d, 0xae, 0xa0, 0x9a, 0x8c, 0xbc, 0xb3, 0xa3, 0xd3, 0x3d, 0x28, 0x05, 0x22, 0x1c,
+	0x7a, 0x51, 0xee, 0x88, 0x9f, 0x60, 0xfc, 0x2f, 0x04, 0x28, 0x2e, 0xf8, 0xf6, 0x24, 0x3e, 0x58,
+	0xdd, 0x2a, 0xd4, 0x1a, 0xa5, 0x11, 0xc7, 0x77, 0xd4, 0x50, 0xc6, 0x11, 0xd3, 0xef, 0x39, 0x86,
+	0x90, 0x4e, 0x67, 0x18, 0x84, 0x2a, 0xc8, 0x1d, 0xc2, 0x13, 0x8c, 0x2b, 0xd8, 0x99, 0x63, 0x73,
+	0xbb, 0xdc, 0x19, 0x97, 0x85, 0x45, 0x97, 0x68, 0xed, 0xba, 0xd2, 0x0d, 0x2f, 0x73, 0xe7, 0xdc,
+	0x04, 0xe3, 0x5f, 0x12, 0xa8, 0x84, 0x1d, 0xfb, 0xf6, 0x05, 0xf2, 0xb6, 0x67, 0xe8, 0xa9, 0x06,
+	0xc5, 0x55, 0x1a, 0x98, 0x4b, 0x34, 0xe8, 0x41, 0x35, 0x61, 0xf4, 0x2d, 0xe7, 0xfe, 0x0f, 0x82,
+	0xb7, 0x67, 0x7d, 0x2d, 0xfb, 0x46, 0xc5, 0xf5, 0x00, 0xcc, 0xb6, 0xe8, 0xb9, 0x32, 0xb7, 0xb0,
+	0x62, 0x48, 0x5f, 0xee, 0xa4, 0x93, 0x9b, 0x63, 0x04, 0x52, 0xb9, 0xcc, 0x79, 0xb9, 0xf6, 0xc1,
+	0x78, 0xae, 0xda, 0x8d, 0x52, 0x66, 0x9d, 0x23, 0x80, 0xb5, 0xa7, 0x3e, 0xe3, 0x18, 0xfa, 0x69,
+	0x47, 0x32, 0xd7, 0x11, 0x3f, 0x16, 0x54, 0x4d, 0x0a, 0x27, 0xbf, 0x17, 0x25, 0x20, 0x4a, 0x81,
+	0x4e, 0x84, 0x93, 0x3b, 0x70, 0x26, 0x98, 0x1e, 0xdd, 0x77, 0x7d, 0x5f, 0x38, 0xf9, 0xcb, 0x6e,
+	0x02, 0x66, 0x84, 0x34, 0x17, 0x85, 0x4c, 0x6f, 0xb2, 0xa5, 0xf9, 0x9b, 0x2c, 0x4e, 0x8e, 0x2d,
+	0x3b, 0xc2, 0x43, 0xf9, 0x42, 0x71, 0x15, 0x4f, 0xa3, 0x61, 0xe9, 0x36, 0x6e, 0xb5, 0xd7, 0x76,
+	0xd4, 0xb9, 0xd4, 0xfb, 0x6e, 0x1d, 0x20, 0xfd, 0x11, 0xfa, 0xfc, 0xa7, 0x50, 0x1b, 0xca, 0xa9,
+	0x91, 0xfd, 0x08, 0x6a, 0xf1, 0x8f, 0xf4, 0x92, 0x3f, 0x63, 0x18, 0x8f, 0xba, 0x40, 0x9c, 0x6f,
+	0x41, 0x7d, 0x36, 0x2a, 0xf4, 0xb9, 0x07, 0xf5, 0xf8, 0x97, 0x54, 0x91, 0xdb, 0x9d, 0xdc, 0x51,
+	0x3d, 0x49, 0x8a, 0xb0, 0x31, 0x57, 0x84, 0xf9, 0x25, 0xec, 0x0c, 0xc4, 0xa0, 0x2d, 0x82, 0x56,
+	0xe7, 0xd2, 0x96, 0x3d, 0xa1, 0x99, 0xeb, 0xe2, 0x33, 0x50, 0x23, 0x91, 0x7b, 0x3c, 0x49, 0x30,
+	0xbc, 0x46, 0x4a, 0xe5, 0x88, 0x96, 0x1b, 0xcf, 0x59, 0xfa, 0x95, 0x94, 0x10, 0x7c, 0xac, 0xd7,
+	0xca, 0x30, 0xf0, 0xf2, 0x2f, 0x13, 0xc3, 0xc0, 0xe3, 0x27, 0xc0, 0xe6, 0x23, 0xad, 0x78, 0x4e,
+	0xb0, 0x60, 0x37, 0x0a, 0x6c, 0x19, 0x76, 0x45, 0xd0, 0xf2, 0x84, 0xed, 0x88, 0x60, 0xc6, 0xac,
+	0x97, 0x6a, 0x61, 0xce, 0x98, 0x21, 0x76, 0x0b, 0x33, 0xfe, 0x10, 0xf6, 0x16, 0x7d, 0xae, 0xe0,
+	0xf0, 0x08, 0x36, 0x31, 0xe1, 0x56, 0xe8, 0xa9, 0x28, 0xdd, 0x15, 0xb0, 0x9d, 0x8b, 0xad, 0x91,
+	0x0c, 0xaf, 0xc2, 0x22, 0x2f, 0x7e, 0x04, 0x5b, 0x59, 0x47, 0x2b, 0x42, 0xee, 0x01, 0xc3, 0x4a,
+	0xaa, 0x3b, 0xea, 0x4f, 0x58, 0x2f, 0xb3, 0x0b, 0xd8, 0x5d, 0x40, 0xd7, 0xd8, 0x69, 0x56, 0xbf,
+	0x4a, 0x75, 0xa0, 0x16, 0x37, 0x5b, 0xae, 0xec, 0xaa, 0x5b, 0x94, 0xc5, 0xef, 0x50, 0x2b, 0x96,
+	0xcf, 0x2f, 0xc6, 0x50, 0x97, 0x48, 0x04, 0x83, 0xdc, 0xf5, 0x5e, 0x23, 0xfc, 0xbf, 0x04, 0x76,
+	0x02, 0xe1, 0xab, 0x20, 0x6a, 0xc5, 0x9e, 0xb4, 0x8e, 0x99, 0x79, 0x22, 0x4b, 0x56, 0x10, 0x7e,
+	0xd6, 0x22, 0x18, 0xb9, 0x1d, 0x91, 0x2b, 0x8f, 0x29, 0x88, 0x0f, 0x1d, 0x81, 0xdd, 0x8d, 0x5a,
+	0xf3, 0xcb, 0xac, 0x8c, 0xe8, 0xb3, 0xc0, 0x63, 0x3f, 0x81, 0x7a, 0x12, 0x6d, 0xb1, 0x98, 0x27,
+	0x49, 0x9f, 0xa1, 0x01, 0x89, 0x3f, 0x57, 0xae, 0xcc, 0x95, 0x07, 0x8d, 0xb0, 0x93, 0x99, 0x76,
+	0x25, 0xfd, 0x59, 0xb1, 0xb4, 0x88, 0xcf, 0x34, 0x9b, 0x69, 0x79, 0x02, 0x6c, 0x3e, 0xcb, 0x15,
+	0x93, 0x7c, 0x09, 0x10, 0xa8, 0x61, 0x24, 0xde, 0x5d, 0xf8, 0x8c, 0x52, 0xc6, 0x12, 0xa5, 0xf8,
+	0xaf, 0xa0, 0x1a, 0x0b, 0x8d, 0x81, 0xde, 0x4d, 0x75, 0xbe, 0x03, 0xdb, 0x57, 0x43, 0x11, 0x4c,
+	0x5a, 0x31, 0x77, 0x5c, 0x97, 0x7f, 0x25, 0x40, 0xf3, 0x58, 0xe8, 0x2f, 0x88, 0x4f, 0xde, 0x24,
+	0xfe, 0x03, 0x28, 0xe9, 0x61, 0x69, 0xe1, 0xda, 0x99, 0x6e, 0x93, 0xa9, 0x36, 0x56, 0xd2, 0x01,
+	0x0f, 0xc8, 0xc8, 0x32, 0x3d, 0x6d, 0xa7, 0x07, 0xe4, 0x69, 0x6e, 0x56, 0x6c, 0x3e, 0xfe, 0xbb,
+	0x01, 0xe5, 0xb3, 0x81, 0x83, 0xcf, 0x8c, 0xac, 0x02, 0xc5, 0xcf, 0x5d, 0xd9, 0xa3, 0x84, 0x95,
+	0xc1, 0xb8, 0x10, 0x11, 0x2d, 0x60, 0xe3, 0x91, 0x88, 0xa8, 0x81, 0x8d, 0x4f, 0x84, 0x47, 0x8b,
+	0x0c, 0xa0, 0xf4, 0x58, 0x76, 0x82, 0xd3, 0x09, 0x35, 0xb1, 0xfd, 0x89, 0xd0, 0xed, 0x12, 0xab,
+	0x82, 0x79, 0x21, 0xa2, 0xf3, 0x31, 0x2d, 0xb3, 0x1d, 0xd8, 0x3c, 0x8b, 0x2f, 0xa4, 0x3f, 0x97,
+	0x0e, 0xfa, 0xa9, 0xb0, 0x5d, 0xd8, 0xce, 0x41, 0xe7, 0x63, 0x5a, 0xc5, 0x78, 0x9f, 0xba, 0x9d,
+	0x3e, 0x05, 0x34, 0x5b, 0xf9, 0xe7, 0x5e, 0x5a, 0x43, 0xef, 0x67, 0x7a, 0x57, 0xa1, 0x75, 0xec,
+	0xfa, 0x04, 0x89, 0x6c, 0xea, 0x16, 0xfa, 0xdc, 0xc2, 0xd6, 0x45, 0xc7, 0x96, 0x74, 0x1b, 0x63,
+	0xff, 0x16, 0x37, 0x00, 0x4a, 0x59, 0x0d, 0xca, 0xcf, 0x64, 0xfc, 0x63, 0x87, 0x6d, 0x43, 0x4d,
+	0x37, 0xcf, 0xf5, 0xbe, 0x40, 0x19, 0x66, 0xf1, 0x74, 0x2c, 0xe9, 0x2e, 0x8e, 0xfd, 0xc5, 0xc8,
+	0xf6, 0xe8, 0x1e, 0xdb, 0x02, 0x78, 0x24, 0xa2, 0xd3, 0x89, 0x7e, 0x54, 0xa3, 0xdf, 0x43, 0x5f,
+	0xcf, 0xf0, 0x4a, 0x49, 0xf7, 0xd1, 0xd7, 0xe7, 0xf1, 0x96, 0x4c, 0xdf, 0x63, 0x14, 0xea, 0x4f,
+	0x74, 0x31, 0x3e, 0xd3, 0xb5, 0x98, 0x36, 0x18, 0x83, 0xad, 0xa7, 0x49, 0x71, 0xfc, 0x4c, 0x2f,
+	0x2e, 0xfa, 0x7d, 0xec, 0x65, 0xe9, 0x65, 0x6d, 0xe9, 0x69, 0xa3, 0x07, 0xe8, 0xff, 0x0b, 0x9c,
+	0x70, 0x0b, 0xe7, 0x85, 0xfe, 0x80, 0xd5, 0xa1, 0xf2, 0x44, 0x8d, 0xc4, 0x85, 0xa7, 0x22, 0x7a,
+	0x2f, 0xee, 0x3f, 0x7b, 0x2f, 0xa6, 0xef, 0x23, 0xf2, 0x48, 0x44, 0x68, 0xd6, 0x5a, 0xd0, 0xe6,
+	0xf1, 0xa7, 0x50, 0x9d, 0x3e, 0x05, 0x23, 0x27, 0x57, 0x8e, 0x6c, 0xd7, 0x73, 0xe8, 0x06, 0xa6,
+	0x23, 0x5d, 0x8f, 0x12, 0x94, 0x2a, 0x8c, 0x02, 0x9c, 0x3b, 0x3d, 0x65, 0xae, 0xc4, 0x29, 0xab,
+	0x82, 0xd9, 0xf5, 0x94, 0x1d, 0xd1, 0x22, 0xa6, 0xdb, 0xf6, 0x54, 0x9b, 0x9a, 0xa7, 0x1f, 0xbf,
+	0x78, 0xd5, 0x24, 0x2f, 0x5f, 0x35, 0xc9, 0x57, 0xaf, 0x9a, 0x1b, 0x5f, 0xbf, 0x6a, 0x92, 0x3f,
+	0xde, 0x34, 0xc9, 0x3f, 0x6f, 0x9a, 0xe4, 0xc5, 0x4d, 0x93, 0xbc, 0xbc, 0x69, 0x92, 0xff, 0xdc,
+	0x34, 0xc9, 0xff, 0x6e, 0x9a, 0x1b, 0x5f, 0xdf, 0x34, 0xc9, 0x97, 0xaf, 0x9b, 0x1b, 0x2f, 0x5f,
+	0x37, 0x37, 0xbe, 0x7a, 0xdd, 0xdc, 0xf8, 0xff, 0x00, 0x4d, 0x7c, 0xe0, 0x45, 0x81, 0x18, 0x00,
+	0x00,
 }
 
-func (m *ReloadTableConfResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x CmdType) String() string {
+	s, ok := CmdType_name[int32(x)]
+	if ok {
+		return s
 	}
-	return dAtA[:n], nil
+	return strconv.Itoa(int(x))
 }
-
-func (m *ReloadTableConfResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x ValueType) String() string {
+	s, ok := ValueType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
 }
-
-func (m *ReloadTableConfResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Err)
-	copy(dAtA[i:], m.Err)
-	i = encodeVarintProto(dAtA, i, uint64(len(m.Err)))
-	i--
-	dAtA[i] = 0x1a
-	i = encodeVarintProto(dAtA, i, uint64(m.ErrCode))
-	i--
-	dAtA[i] = 0x10
-	i = encodeVarintProto(dAtA, i, uint64(m.Seqno))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *ReloadConfigReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *LoginReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *ReloadConfigReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*LoginReq)
+	if !ok {
+		that2, ok := that.(LoginReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Compress != that1.Compress {
+		return false
+	}
+	return true
 }
+func (this *LoginResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReloadConfigReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Path)
-	copy(dAtA[i:], m.Path)
-	i = encodeVarintProto(dAtA, i, uint64(len(m.Path)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	that1, ok := that.(*LoginResp)
+	if !ok {
+		that2, ok := that.(LoginResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Ok != that1.Ok {
+		return false
+	}
+	if this.Compress != that1.Compress {
+		return false
+	}
+	return true
 }
+func (this *ReloadTableConfReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReloadConfigResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ReloadTableConfReq)
+	if !ok {
+		that2, ok := that.(ReloadTableConfReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Seqno != that1.Seqno {
+		return false
+	}
+	if len(this.Regions) != len(that1.Regions) {
+		return false
+	}
+	for i := range this.Regions {
+		if this.Regions[i] != that1.Regions[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *ReloadTableConfResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReloadConfigResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ReloadTableConfResp)
+	if !ok {
+		that2, ok := that.(ReloadTableConfResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Seqno != that1.Seqno {
+		return false
+	}
+	if this.ErrCode != that1.ErrCode {
+		return false
+	}
+	if this.Err != that1.Err {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
 }
+func (this *ReloadConfigReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReloadConfigResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Err)
-	copy(dAtA[i:], m.Err)
-	i = encodeVarintProto(dAtA, i, uint64(len(m.Err)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	that1, ok := that.(*ReloadConfigReq)
+	if !ok {
+		that2, ok := that.(ReloadConfigReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	return true
 }
+func (this *ReloadConfigResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Value) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ReloadConfigResp)
+	if !ok {
+		that2, ok := that.(ReloadConfigResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Err != that1.Err {
+		return false
+	}
+	return true
 }
+func (this *Value) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Value) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*Value)
+	if !ok {
+		that2, ok := that.(Value)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.I != that1.I {
+		return false
+	}
+	if this.F != that1.F {
+		return false
+	}
+	if this.S != that1.S {
+		return false
+	}
+	if !bytes.Equal(this.B, that1.B) {
+		return false
+	}
+	return true
 }
+func (this *Field) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.B != nil {
-		i -= len(m.B)
-		copy(dAtA[i:], m.B)
-		i = encodeVarintProto(dAtA, i, uint64(len(m.B)))
-		i--
-		dAtA[i] = 0x2a
+	that1, ok := that.(*Field)
+	if !ok {
+		that2, ok := that.(Field)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i -= len(m.S)
-	copy(dAtA[i:], m.S)
-	i = encodeVarintProto(dAtA, i, uint64(len(m.S)))
-	i--
-	dAtA[i] = 0x22
-	i -= 8
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.F))))
-	i--
-	dAtA[i] = 0x19
-	i = encodeVarintProto(dAtA, i, uint64(m.I))
-	i--
-	dAtA[i] = 0x10
-	i = encodeVarintProto(dAtA, i, uint64(m.Type))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *Field) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Field) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Name != that1.Name {
+		return false
+	}
+	if !this.V.Equal(that1.V) {
+		return false
+	}
+	return true
 }
+func (this *PingReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Field) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.V != nil {
-		{
-			size, err := m.V.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProto(dAtA, i, uint64(size))
+	that1, ok := that.(*PingReq)
+	if !ok {
+		that2, ok := that.(PingReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintProto(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *PingReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PingReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PingReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i = encodeVarintProto(dAtA, i, uint64(m.Timestamp))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *PingResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Timestamp != that1.Timestamp {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PingResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PingResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i = encodeVarintProto(dAtA, i, uint64(m.Timestamp))
-	i--
-	dAtA[i] = 0x10
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *GetReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PingResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *GetReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*PingResp)
+	if !ok {
+		that2, ok := that.(PingResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
 }
+func (this *GetReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i--
-	if m.All {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	that1, ok := that.(*GetReq)
+	if !ok {
+		that2, ok := that.(GetReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i--
-	dAtA[i] = 0x18
-	if len(m.Fields) > 0 {
-		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Fields[iNdEx])
-			copy(dAtA[i:], m.Fields[iNdEx])
-			i = encodeVarintProto(dAtA, i, uint64(len(m.Fields[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != nil && that1.Version != nil {
+		if *this.Version != *that1.Version {
+			return false
+		}
+	} else if this.Version != nil {
+		return false
+	} else if that1.Version != nil {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if this.Fields[i] != that1.Fields[i] {
+			return false
 		}
 	}
-	if m.Version != nil {
-		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
-		i--
-		dAtA[i] = 0x8
+	if this.All != that1.All {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.StaleOk != that1.StaleOk {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.MaxLag != that1.MaxLag {
+		return false
+	}
+	return true
 }
+func (this *GetResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fields) > 0 {
-		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProto(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	that1, ok := that.(*GetResp)
+	if !ok {
+		that2, ok := that.(GetResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	i = encodeVarintProto(dAtA, i, uint64(m.Version))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *SetReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SetReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fields) > 0 {
-		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProto(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if this.Version != that1.Version {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
 		}
 	}
-	if m.Version != nil {
-		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
-		i--
-		dAtA[i] = 0x8
+	if this.AppliedIndex != that1.AppliedIndex {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *SetResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SetReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SetResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SetResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i = encodeVarintProto(dAtA, i, uint64(m.Version))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
 
-func (m *SetNxReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*SetReq)
+	if !ok {
+		that2, ok := that.(SetReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SetNxReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SetNxReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fields) > 0 {
-		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProto(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != nil && that1.Version != nil {
+		if *this.Version != *that1.Version {
+			return false
 		}
+	} else if this.Version != nil {
+		return false
+	} else if that1.Version != nil {
+		return false
 	}
-	if m.Version != nil {
-		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
-		i--
-		dAtA[i] = 0x8
+	if len(this.Fields) != len(that1.Fields) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SetNxResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SetNxResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Ttl != that1.Ttl {
+		return false
+	}
+	return true
 }
+func (this *SetResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SetNxResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fields) > 0 {
-		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProto(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	that1, ok := that.(*SetResp)
+	if !ok {
+		that2, ok := that.(SetResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	i = encodeVarintProto(dAtA, i, uint64(m.Version))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *IncrByReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *IncrByReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
 }
+func (this *SetNxReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *IncrByReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Field == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("field")
-	} else {
-		{
-			size, err := m.Field.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProto(dAtA, i, uint64(size))
+	that1, ok := that.(*SetNxReq)
+	if !ok {
+		that2, ok := that.(SetNxReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Version != nil {
-		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *IncrByResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Version != nil && that1.Version != nil {
+		if *this.Version != *that1.Version {
+			return false
+		}
+	} else if this.Version != nil {
+		return false
+	} else if that1.Version != nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *IncrByResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *IncrByResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Field != nil {
-		{
-			size, err := m.Field.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProto(dAtA, i, uint64(size))
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	i = encodeVarintProto(dAtA, i, uint64(m.Version))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *DecrByReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Ttl != that1.Ttl {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DecrByReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *SetNxResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DecrByReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Field == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("field")
-	} else {
-		{
-			size, err := m.Field.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProto(dAtA, i, uint64(size))
+	that1, ok := that.(*SetNxResp)
+	if !ok {
+		that2, ok := that.(SetNxResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Version != nil {
-		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DecrByResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Version != that1.Version {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DecrByResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DecrByResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Field != nil {
-		{
-			size, err := m.Field.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProto(dAtA, i, uint64(size))
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	i = encodeVarintProto(dAtA, i, uint64(m.Version))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *CompareAndSetReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *IncrByReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CompareAndSetReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *CompareAndSetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Old == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("old")
-	} else {
-		{
-			size, err := m.Old.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProto(dAtA, i, uint64(size))
+	that1, ok := that.(*IncrByReq)
+	if !ok {
+		that2, ok := that.(IncrByReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.New == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("new")
-	} else {
-		{
-			size, err := m.New.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProto(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != nil && that1.Version != nil {
+		if *this.Version != *that1.Version {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
+	} else if this.Version != nil {
+		return false
+	} else if that1.Version != nil {
+		return false
 	}
-	if m.Version != nil {
-		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
-		i--
-		dAtA[i] = 0x8
+	if !this.Field.Equal(that1.Field) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Ttl != that1.Ttl {
+		return false
+	}
+	return true
 }
+func (this *IncrByResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CompareAndSetResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*IncrByResp)
+	if !ok {
+		that2, ok := that.(IncrByResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CompareAndSetResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if !this.Field.Equal(that1.Field) {
+		return false
+	}
+	return true
 }
+func (this *DecrByReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CompareAndSetResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProto(dAtA, i, uint64(size))
+	that1, ok := that.(*DecrByReq)
+	if !ok {
+		that2, ok := that.(DecrByReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	i = encodeVarintProto(dAtA, i, uint64(m.Version))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *CompareAndSetNxReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Version != nil && that1.Version != nil {
+		if *this.Version != *that1.Version {
+			return false
+		}
+	} else if this.Version != nil {
+		return false
+	} else if that1.Version != nil {
+		return false
+	}
+	if !this.Field.Equal(that1.Field) {
+		return false
+	}
+	return true
 }
+func (this *DecrByResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CompareAndSetNxReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*DecrByResp)
+	if !ok {
+		that2, ok := that.(DecrByResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if !this.Field.Equal(that1.Field) {
+		return false
+	}
+	return true
 }
+func (this *CompareAndSetReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CompareAndSetNxReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Old == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("old")
-	} else {
-		{
-			size, err := m.Old.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProto(dAtA, i, uint64(size))
+	that1, ok := that.(*CompareAndSetReq)
+	if !ok {
+		that2, ok := that.(CompareAndSetReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.New == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("new")
-	} else {
-		{
-			size, err := m.New.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProto(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != nil && that1.Version != nil {
+		if *this.Version != *that1.Version {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
+	} else if this.Version != nil {
+		return false
+	} else if that1.Version != nil {
+		return false
 	}
-	if m.Version != nil {
-		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
-		i--
-		dAtA[i] = 0x8
+	if !this.New.Equal(that1.New) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CompareAndSetNxResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Old.Equal(that1.Old) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CompareAndSetNxResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *CompareAndSetResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CompareAndSetNxResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProto(dAtA, i, uint64(size))
+	that1, ok := that.(*CompareAndSetResp)
+	if !ok {
+		that2, ok := that.(CompareAndSetResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	i = encodeVarintProto(dAtA, i, uint64(m.Version))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *DelReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DelReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != nil {
-		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
-		i--
-		dAtA[i] = 0x8
+	if this.Version != that1.Version {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if !this.Value.Equal(that1.Value) {
+		return false
+	}
+	return true
 }
-
-func (m *DelResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CompareAndSetNxReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DelResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *DelResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i = encodeVarintProto(dAtA, i, uint64(m.Version))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *KickReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*CompareAndSetNxReq)
+	if !ok {
+		that2, ok := that.(CompareAndSetNxReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *KickReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *KickReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *KickResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *KickResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *KickResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *Cancel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Version != nil && that1.Version != nil {
+		if *this.Version != *that1.Version {
+			return false
+		}
+	} else if this.Version != nil {
+		return false
+	} else if that1.Version != nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Cancel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.New.Equal(that1.New) {
+		return false
+	}
+	if !this.Old.Equal(that1.Old) {
+		return false
+	}
+	return true
 }
+func (this *CompareAndSetNxResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Cancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Seqs) > 0 {
-		for iNdEx := len(m.Seqs) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarintProto(dAtA, i, uint64(m.Seqs[iNdEx]))
-			i--
-			dAtA[i] = 0x8
+	that1, ok := that.(*CompareAndSetNxResp)
+	if !ok {
+		that2, ok := that.(CompareAndSetNxResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintProto(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProto(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *LoginReq) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Version != that1.Version {
+		return false
 	}
-	var l int
-	_ = l
-	n += 2
-	return n
-}
-
-func (m *LoginResp) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.Value.Equal(that1.Value) {
+		return false
 	}
-	var l int
-	_ = l
-	n += 2
-	n += 2
-	return n
+	return true
 }
-
-func (m *ReloadTableConfReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *DelReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	n += 1 + sovProto(uint64(m.Seqno))
-	return n
-}
 
-func (m *ReloadTableConfResp) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*DelReq)
+	if !ok {
+		that2, ok := that.(DelReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	n += 1 + sovProto(uint64(m.Seqno))
-	n += 1 + sovProto(uint64(m.ErrCode))
-	l = len(m.Err)
-	n += 1 + l + sovProto(uint64(l))
-	return n
-}
-
-func (m *ReloadConfigReq) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	n += 1 + l + sovProto(uint64(l))
-	return n
+	if this.Version != nil && that1.Version != nil {
+		if *this.Version != *that1.Version {
+			return false
+		}
+	} else if this.Version != nil {
+		return false
+	} else if that1.Version != nil {
+		return false
+	}
+	return true
 }
+func (this *DelResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReloadConfigResp) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*DelResp)
+	if !ok {
+		that2, ok := that.(DelResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Err)
-	n += 1 + l + sovProto(uint64(l))
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
 }
+func (this *UnsetReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Value) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*UnsetReq)
+	if !ok {
+		that2, ok := that.(UnsetReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	n += 1 + sovProto(uint64(m.Type))
-	n += 1 + sovProto(uint64(m.I))
-	n += 9
-	l = len(m.S)
-	n += 1 + l + sovProto(uint64(l))
-	if m.B != nil {
-		l = len(m.B)
-		n += 1 + l + sovProto(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if this.Version != nil && that1.Version != nil {
+		if *this.Version != *that1.Version {
+			return false
+		}
+	} else if this.Version != nil {
+		return false
+	} else if that1.Version != nil {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if this.Fields[i] != that1.Fields[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *UnsetResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Field) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*UnsetResp)
+	if !ok {
+		that2, ok := that.(UnsetResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovProto(uint64(l))
-	if m.V != nil {
-		l = m.V.Size()
-		n += 1 + l + sovProto(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
 }
+func (this *KickReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PingReq) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*KickReq)
+	if !ok {
+		that2, ok := that.(KickReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	n += 1 + sovProto(uint64(m.Timestamp))
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *KickResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PingResp) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*KickResp)
+	if !ok {
+		that2, ok := that.(KickResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	n += 1 + sovProto(uint64(m.Timestamp))
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *Row) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetReq) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Row)
+	if !ok {
+		that2, ok := that.(Row)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Version != nil {
-		n += 1 + sovProto(uint64(*m.Version))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Fields) > 0 {
-		for _, s := range m.Fields {
-			l = len(s)
-			n += 1 + l + sovProto(uint64(l))
-		}
+	if this.Key != that1.Key {
+		return false
 	}
-	n += 2
-	return n
-}
-
-func (m *GetResp) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Version != that1.Version {
+		return false
 	}
-	var l int
-	_ = l
-	n += 1 + sovProto(uint64(m.Version))
-	if len(m.Fields) > 0 {
-		for _, e := range m.Fields {
-			l = e.Size()
-			n += 1 + l + sovProto(uint64(l))
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
 		}
 	}
-	return n
+	if this.ErrCode != that1.ErrCode {
+		return false
+	}
+	return true
 }
+func (this *MgetReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SetReq) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MgetReq)
+	if !ok {
+		that2, ok := that.(MgetReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Version != nil {
-		n += 1 + sovProto(uint64(*m.Version))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Fields) > 0 {
-		for _, e := range m.Fields {
-			l = e.Size()
-			n += 1 + l + sovProto(uint64(l))
+	if this.Table != that1.Table {
+		return false
+	}
+	if len(this.Keys) != len(that1.Keys) {
+		return false
+	}
+	for i := range this.Keys {
+		if this.Keys[i] != that1.Keys[i] {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *SetResp) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Fields) != len(that1.Fields) {
+		return false
 	}
-	var l int
-	_ = l
-	n += 1 + sovProto(uint64(m.Version))
-	return n
+	for i := range this.Fields {
+		if this.Fields[i] != that1.Fields[i] {
+			return false
+		}
+	}
+	if this.All != that1.All {
+		return false
+	}
+	return true
 }
+func (this *MgetResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SetNxReq) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MgetResp)
+	if !ok {
+		that2, ok := that.(MgetResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Version != nil {
-		n += 1 + sovProto(uint64(*m.Version))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Fields) > 0 {
-		for _, e := range m.Fields {
-			l = e.Size()
-			n += 1 + l + sovProto(uint64(l))
+	if len(this.Rows) != len(that1.Rows) {
+		return false
+	}
+	for i := range this.Rows {
+		if !this.Rows[i].Equal(that1.Rows[i]) {
+			return false
 		}
 	}
-	return n
+	return true
 }
+func (this *MsetItem) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SetNxResp) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsetItem)
+	if !ok {
+		that2, ok := that.(MsetItem)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	n += 1 + sovProto(uint64(m.Version))
-	if len(m.Fields) > 0 {
-		for _, e := range m.Fields {
-			l = e.Size()
-			n += 1 + l + sovProto(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Version != nil && that1.Version != nil {
+		if *this.Version != *that1.Version {
+			return false
 		}
+	} else if this.Version != nil {
+		return false
+	} else if that1.Version != nil {
+		return false
 	}
-	return n
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *MsetReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *IncrByReq) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsetReq)
+	if !ok {
+		that2, ok := that.(MsetReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Version != nil {
-		n += 1 + sovProto(uint64(*m.Version))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Field != nil {
-		l = m.Field.Size()
-		n += 1 + l + sovProto(uint64(l))
+	if this.Table != that1.Table {
+		return false
 	}
-	return n
-}
-
-func (m *IncrByResp) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Items) != len(that1.Items) {
+		return false
 	}
-	var l int
-	_ = l
-	n += 1 + sovProto(uint64(m.Version))
-	if m.Field != nil {
-		l = m.Field.Size()
-		n += 1 + l + sovProto(uint64(l))
+	for i := range this.Items {
+		if !this.Items[i].Equal(that1.Items[i]) {
+			return false
+		}
 	}
-	return n
+	return true
 }
+func (this *MsetResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DecrByReq) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsetResp)
+	if !ok {
+		that2, ok := that.(MsetResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Version != nil {
-		n += 1 + sovProto(uint64(*m.Version))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Field != nil {
-		l = m.Field.Size()
-		n += 1 + l + sovProto(uint64(l))
+	if len(this.Rows) != len(that1.Rows) {
+		return false
 	}
-	return n
+	for i := range this.Rows {
+		if !this.Rows[i].Equal(that1.Rows[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *TxnOp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DecrByResp) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*TxnOp)
+	if !ok {
+		that2, ok := that.(TxnOp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	n += 1 + sovProto(uint64(m.Version))
-	if m.Field != nil {
-		l = m.Field.Size()
-		n += 1 + l + sovProto(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *CompareAndSetReq) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Key != that1.Key {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Version != nil {
-		n += 1 + sovProto(uint64(*m.Version))
+	if this.Version != nil && that1.Version != nil {
+		if *this.Version != *that1.Version {
+			return false
+		}
+	} else if this.Version != nil {
+		return false
+	} else if that1.Version != nil {
+		return false
 	}
-	if m.New != nil {
-		l = m.New.Size()
-		n += 1 + l + sovProto(uint64(l))
+	if len(this.Cmp) != len(that1.Cmp) {
+		return false
 	}
-	if m.Old != nil {
-		l = m.Old.Size()
-		n += 1 + l + sovProto(uint64(l))
+	for i := range this.Cmp {
+		if !this.Cmp[i].Equal(that1.Cmp[i]) {
+			return false
+		}
 	}
-	return n
-}
-
-func (m *CompareAndSetResp) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Fields) != len(that1.Fields) {
+		return false
 	}
-	var l int
-	_ = l
-	n += 1 + sovProto(uint64(m.Version))
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovProto(uint64(l))
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
+		}
 	}
-	return n
+	if this.Del != that1.Del {
+		return false
+	}
+	if this.Table != that1.Table {
+		return false
+	}
+	return true
 }
+func (this *TxnReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CompareAndSetNxReq) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*TxnReq)
+	if !ok {
+		that2, ok := that.(TxnReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Version != nil {
-		n += 1 + sovProto(uint64(*m.Version))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.New != nil {
-		l = m.New.Size()
-		n += 1 + l + sovProto(uint64(l))
+	if this.Table != that1.Table {
+		return false
 	}
-	if m.Old != nil {
-		l = m.Old.Size()
-		n += 1 + l + sovProto(uint64(l))
+	if len(this.Ops) != len(that1.Ops) {
+		return false
 	}
-	return n
+	for i := range this.Ops {
+		if !this.Ops[i].Equal(that1.Ops[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *TxnResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CompareAndSetNxResp) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*TxnResp)
+	if !ok {
+		that2, ok := that.(TxnResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	n += 1 + sovProto(uint64(m.Version))
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovProto(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *DelReq) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Rows) != len(that1.Rows) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Version != nil {
-		n += 1 + sovProto(uint64(*m.Version))
+	for i := range this.Rows {
+		if !this.Rows[i].Equal(that1.Rows[i]) {
+			return false
+		}
 	}
-	return n
+	return true
 }
+func (this *EvalReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DelResp) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*EvalReq)
+	if !ok {
+		that2, ok := that.(EvalReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	n += 1 + sovProto(uint64(m.Version))
-	return n
-}
-
-func (m *KickReq) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *KickResp) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Name != that1.Name {
+		return false
 	}
-	var l int
-	_ = l
-	return n
+	if len(this.Args) != len(that1.Args) {
+		return false
+	}
+	for i := range this.Args {
+		if this.Args[i] != that1.Args[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *EvalResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Cancel) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*EvalResp)
+	if !ok {
+		that2, ok := that.(EvalResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Seqs) > 0 {
-		for _, e := range m.Seqs {
-			n += 1 + sovProto(uint64(e))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
 		}
 	}
-	return n
+	if this.Result != that1.Result {
+		return false
+	}
+	return true
 }
+func (this *GetByIndexReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovProto(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProto(x uint64) (n int) {
-	return sovProto(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *LoginReq) String() string {
-	if this == nil {
-		return "nil"
+	that1, ok := that.(*GetByIndexReq)
+	if !ok {
+		that2, ok := that.(GetByIndexReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&LoginReq{`,
-		`Compress:` + fmt.Sprintf("%v", this.Compress) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *LoginResp) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&LoginResp{`,
-		`Ok:` + fmt.Sprintf("%v", this.Ok) + `,`,
-		`Compress:` + fmt.Sprintf("%v", this.Compress) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReloadTableConfReq) String() string {
-	if this == nil {
-		return "nil"
+	if this.Table != that1.Table {
+		return false
 	}
-	s := strings.Join([]string{`&ReloadTableConfReq{`,
-		`Seqno:` + fmt.Sprintf("%v", this.Seqno) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReloadTableConfResp) String() string {
-	if this == nil {
-		return "nil"
+	if !this.Value.Equal(that1.Value) {
+		return false
 	}
-	s := strings.Join([]string{`&ReloadTableConfResp{`,
-		`Seqno:` + fmt.Sprintf("%v", this.Seqno) + `,`,
-		`ErrCode:` + fmt.Sprintf("%v", this.ErrCode) + `,`,
-		`Err:` + fmt.Sprintf("%v", this.Err) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReloadConfigReq) String() string {
-	if this == nil {
-		return "nil"
+	if this.Count != that1.Count {
+		return false
 	}
-	s := strings.Join([]string{`&ReloadConfigReq{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReloadConfigResp) String() string {
-	if this == nil {
-		return "nil"
+	if this.Cursor != that1.Cursor {
+		return false
 	}
-	s := strings.Join([]string{`&ReloadConfigResp{`,
-		`Err:` + fmt.Sprintf("%v", this.Err) + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *Value) String() string {
-	if this == nil {
-		return "nil"
+func (this *GetByIndexResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&Value{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`I:` + fmt.Sprintf("%v", this.I) + `,`,
-		`F:` + fmt.Sprintf("%v", this.F) + `,`,
-		`S:` + fmt.Sprintf("%v", this.S) + `,`,
-		`B:` + fmt.Sprintf("%v", this.B) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Field) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*GetByIndexResp)
+	if !ok {
+		that2, ok := that.(GetByIndexResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&Field{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`V:` + strings.Replace(fmt.Sprintf("%v", this.V), "Value", "Value", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PingReq) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&PingReq{`,
-		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PingResp) String() string {
-	if this == nil {
-		return "nil"
+	if len(this.Rows) != len(that1.Rows) {
+		return false
 	}
-	s := strings.Join([]string{`&PingResp{`,
-		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetReq) String() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Rows {
+		if !this.Rows[i].Equal(that1.Rows[i]) {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&GetReq{`,
-		`Version:` + valueToStringProto(this.Version) + `,`,
-		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
-		`All:` + fmt.Sprintf("%v", this.All) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetResp) String() string {
-	if this == nil {
-		return "nil"
+	if this.Cursor != that1.Cursor {
+		return false
 	}
-	repeatedStringForFields := "[]*Field{"
-	for _, f := range this.Fields {
-		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	if this.Finish != that1.Finish {
+		return false
 	}
-	repeatedStringForFields += "}"
-	s := strings.Join([]string{`&GetResp{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Fields:` + repeatedStringForFields + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *SetReq) String() string {
-	if this == nil {
-		return "nil"
+func (this *ScanReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	repeatedStringForFields := "[]*Field{"
-	for _, f := range this.Fields {
-		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+
+	that1, ok := that.(*ScanReq)
+	if !ok {
+		that2, ok := that.(ScanReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	repeatedStringForFields += "}"
-	s := strings.Join([]string{`&SetReq{`,
-		`Version:` + valueToStringProto(this.Version) + `,`,
-		`Fields:` + repeatedStringForFields + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SetResp) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&SetResp{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SetNxReq) String() string {
-	if this == nil {
-		return "nil"
+	if this.Table != that1.Table {
+		return false
 	}
-	repeatedStringForFields := "[]*Field{"
-	for _, f := range this.Fields {
-		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	if len(this.Fields) != len(that1.Fields) {
+		return false
 	}
-	repeatedStringForFields += "}"
-	s := strings.Join([]string{`&SetNxReq{`,
-		`Version:` + valueToStringProto(this.Version) + `,`,
-		`Fields:` + repeatedStringForFields + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SetNxResp) String() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Fields {
+		if this.Fields[i] != that1.Fields[i] {
+			return false
+		}
 	}
-	repeatedStringForFields := "[]*Field{"
-	for _, f := range this.Fields {
-		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	if this.All != that1.All {
+		return false
 	}
-	repeatedStringForFields += "}"
-	s := strings.Join([]string{`&SetNxResp{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Fields:` + repeatedStringForFields + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *IncrByReq) String() string {
-	if this == nil {
-		return "nil"
+	if this.Count != that1.Count {
+		return false
 	}
-	s := strings.Join([]string{`&IncrByReq{`,
-		`Version:` + valueToStringProto(this.Version) + `,`,
-		`Field:` + strings.Replace(fmt.Sprintf("%v", this.Field), "Field", "Field", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *IncrByResp) String() string {
-	if this == nil {
-		return "nil"
+	if this.Cursor != that1.Cursor {
+		return false
 	}
-	s := strings.Join([]string{`&IncrByResp{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Field:` + strings.Replace(fmt.Sprintf("%v", this.Field), "Field", "Field", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *DecrByReq) String() string {
-	if this == nil {
-		return "nil"
+func (this *ScanResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&DecrByReq{`,
-		`Version:` + valueToStringProto(this.Version) + `,`,
-		`Field:` + strings.Replace(fmt.Sprintf("%v", this.Field), "Field", "Field", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DecrByResp) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ScanResp)
+	if !ok {
+		that2, ok := that.(ScanResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&DecrByResp{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Field:` + strings.Replace(fmt.Sprintf("%v", this.Field), "Field", "Field", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CompareAndSetReq) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&CompareAndSetReq{`,
-		`Version:` + valueToStringProto(this.Version) + `,`,
-		`New:` + strings.Replace(fmt.Sprintf("%v", this.New), "Field", "Field", 1) + `,`,
-		`Old:` + strings.Replace(fmt.Sprintf("%v", this.Old), "Field", "Field", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CompareAndSetResp) String() string {
-	if this == nil {
-		return "nil"
+	if len(this.Rows) != len(that1.Rows) {
+		return false
 	}
-	s := strings.Join([]string{`&CompareAndSetResp{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Value:` + strings.Replace(fmt.Sprintf("%v", this.Value), "Field", "Field", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CompareAndSetNxReq) String() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Rows {
+		if !this.Rows[i].Equal(that1.Rows[i]) {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&CompareAndSetNxReq{`,
-		`Version:` + valueToStringProto(this.Version) + `,`,
-		`New:` + strings.Replace(fmt.Sprintf("%v", this.New), "Field", "Field", 1) + `,`,
-		`Old:` + strings.Replace(fmt.Sprintf("%v", this.Old), "Field", "Field", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CompareAndSetNxResp) String() string {
-	if this == nil {
-		return "nil"
+	if this.Cursor != that1.Cursor {
+		return false
 	}
-	s := strings.Join([]string{`&CompareAndSetNxResp{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Value:` + strings.Replace(fmt.Sprintf("%v", this.Value), "Field", "Field", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DelReq) String() string {
-	if this == nil {
-		return "nil"
+	if this.Finish != that1.Finish {
+		return false
 	}
-	s := strings.Join([]string{`&DelReq{`,
-		`Version:` + valueToStringProto(this.Version) + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *DelResp) String() string {
-	if this == nil {
-		return "nil"
+func (this *PreloadReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&DelResp{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KickReq) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*PreloadReq)
+	if !ok {
+		that2, ok := that.(PreloadReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&KickReq{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KickResp) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&KickResp{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Cancel) String() string {
-	if this == nil {
-		return "nil"
+	if this.Table != that1.Table {
+		return false
 	}
-	s := strings.Join([]string{`&Cancel{`,
-		`Seqs:` + fmt.Sprintf("%v", this.Seqs) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringProto(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if len(this.Keys) != len(that1.Keys) {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	for i := range this.Keys {
+		if this.Keys[i] != that1.Keys[i] {
+			return false
+		}
+	}
+	if this.Begin != that1.Begin {
+		return false
+	}
+	if this.End != that1.End {
+		return false
+	}
+	if this.All != that1.All {
+		return false
+	}
+	if this.Job != that1.Job {
+		return false
+	}
+	return true
 }
-func (m *LoginReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProto
+func (this *PreloadResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PreloadResp)
+	if !ok {
+		that2, ok := that.(PreloadResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Job != that1.Job {
+		return false
+	}
+	if this.Scanned != that1.Scanned {
+		return false
+	}
+	if this.Loaded != that1.Loaded {
+		return false
+	}
+	if this.Skipped != that1.Skipped {
+		return false
+	}
+	if this.Finish != that1.Finish {
+		return false
+	}
+	if this.Err != that1.Err {
+		return false
+	}
+	return true
+}
+func (this *Cancel) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Cancel)
+	if !ok {
+		that2, ok := that.(Cancel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Seqs) != len(that1.Seqs) {
+		return false
+	}
+	for i := range this.Seqs {
+		if this.Seqs[i] != that1.Seqs[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *WatchReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WatchReq)
+	if !ok {
+		that2, ok := that.(WatchReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *WatchResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WatchResp)
+	if !ok {
+		that2, ok := that.(WatchResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *UnwatchReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnwatchReq)
+	if !ok {
+		that2, ok := that.(UnwatchReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WatchSeqno != that1.WatchSeqno {
+		return false
+	}
+	return true
+}
+func (this *UnwatchResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnwatchResp)
+	if !ok {
+		that2, ok := that.(UnwatchResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *WatchNotify) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WatchNotify)
+	if !ok {
+		that2, ok := that.(WatchNotify)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
+		}
+	}
+	if this.Del != that1.Del {
+		return false
+	}
+	return true
+}
+func (this *MemberChangeReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MemberChangeReq)
+	if !ok {
+		that2, ok := that.(MemberChangeReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Remove != that1.Remove {
+		return false
+	}
+	if this.NodeId != that1.NodeId {
+		return false
+	}
+	if this.Url != that1.Url {
+		return false
+	}
+	return true
+}
+func (this *MemberChangeResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MemberChangeResp)
+	if !ok {
+		that2, ok := that.(MemberChangeResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Err != that1.Err {
+		return false
+	}
+	return true
+}
+func (this *TransferLeaderReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferLeaderReq)
+	if !ok {
+		that2, ok := that.(TransferLeaderReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if this.NodeId != that1.NodeId {
+		return false
+	}
+	return true
+}
+func (this *TransferLeaderResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferLeaderResp)
+	if !ok {
+		that2, ok := that.(TransferLeaderResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Err != that1.Err {
+		return false
+	}
+	return true
+}
+func (this *MoveSlotReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MoveSlotReq)
+	if !ok {
+		that2, ok := that.(MoveSlotReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Slot != that1.Slot {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	return true
+}
+func (this *MoveSlotResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MoveSlotResp)
+	if !ok {
+		that2, ok := that.(MoveSlotResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Err != that1.Err {
+		return false
+	}
+	return true
+}
+func (this *GetSlotTableReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetSlotTableReq)
+	if !ok {
+		that2, ok := that.(GetSlotTableReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *GetSlotTableResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetSlotTableResp)
+	if !ok {
+		that2, ok := that.(GetSlotTableResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if len(this.Regions) != len(that1.Regions) {
+		return false
+	}
+	for i := range this.Regions {
+		if this.Regions[i] != that1.Regions[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *RegionInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegionInfo)
+	if !ok {
+		that2, ok := that.(RegionInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if this.Leader != that1.Leader {
+		return false
+	}
+	if this.Term != that1.Term {
+		return false
+	}
+	return true
+}
+func (this *ReportRegionReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReportRegionReq)
+	if !ok {
+		that2, ok := that.(ReportRegionReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NodeId != that1.NodeId {
+		return false
+	}
+	if this.Service != that1.Service {
+		return false
+	}
+	if this.RaftUrl != that1.RaftUrl {
+		return false
+	}
+	if this.RegionCount != that1.RegionCount {
+		return false
+	}
+	if this.Join != that1.Join {
+		return false
+	}
+	if len(this.Regions) != len(that1.Regions) {
+		return false
+	}
+	for i := range this.Regions {
+		if !this.Regions[i].Equal(that1.Regions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ReportRegionResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReportRegionResp)
+	if !ok {
+		that2, ok := that.(ReportRegionResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Err != that1.Err {
+		return false
+	}
+	return true
+}
+func (this *RouteInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteInfo)
+	if !ok {
+		that2, ok := that.(RouteInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if this.Leader != that1.Leader {
+		return false
+	}
+	if this.Service != that1.Service {
+		return false
+	}
+	return true
+}
+func (this *NodeInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NodeInfo)
+	if !ok {
+		that2, ok := that.(NodeInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NodeId != that1.NodeId {
+		return false
+	}
+	if this.Service != that1.Service {
+		return false
+	}
+	return true
+}
+func (this *QueryRouteReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryRouteReq)
+	if !ok {
+		that2, ok := that.(QueryRouteReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *QueryRouteResp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryRouteResp)
+	if !ok {
+		that2, ok := that.(QueryRouteResp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RegionCount != that1.RegionCount {
+		return false
+	}
+	if len(this.Routes) != len(that1.Routes) {
+		return false
+	}
+	for i := range this.Routes {
+		if !this.Routes[i].Equal(that1.Routes[i]) {
+			return false
+		}
+	}
+	if len(this.Nodes) != len(that1.Nodes) {
+		return false
+	}
+	for i := range this.Nodes {
+		if !this.Nodes[i].Equal(that1.Nodes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *LoginReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.LoginReq{")
+	s = append(s, "Compress: "+fmt.Sprintf("%#v", this.Compress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LoginResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.LoginResp{")
+	s = append(s, "Ok: "+fmt.Sprintf("%#v", this.Ok)+",\n")
+	s = append(s, "Compress: "+fmt.Sprintf("%#v", this.Compress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReloadTableConfReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.ReloadTableConfReq{")
+	s = append(s, "Seqno: "+fmt.Sprintf("%#v", this.Seqno)+",\n")
+	if this.Regions != nil {
+		s = append(s, "Regions: "+fmt.Sprintf("%#v", this.Regions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReloadTableConfResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&proto.ReloadTableConfResp{")
+	s = append(s, "Seqno: "+fmt.Sprintf("%#v", this.Seqno)+",\n")
+	s = append(s, "ErrCode: "+fmt.Sprintf("%#v", this.ErrCode)+",\n")
+	s = append(s, "Err: "+fmt.Sprintf("%#v", this.Err)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReloadConfigReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.ReloadConfigReq{")
+	s = append(s, "Path: "+fmt.Sprintf("%#v", this.Path)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReloadConfigResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.ReloadConfigResp{")
+	s = append(s, "Err: "+fmt.Sprintf("%#v", this.Err)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Value) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&proto.Value{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "I: "+fmt.Sprintf("%#v", this.I)+",\n")
+	s = append(s, "F: "+fmt.Sprintf("%#v", this.F)+",\n")
+	s = append(s, "S: "+fmt.Sprintf("%#v", this.S)+",\n")
+	s = append(s, "B: "+fmt.Sprintf("%#v", this.B)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Field) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.Field{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	if this.V != nil {
+		s = append(s, "V: "+fmt.Sprintf("%#v", this.V)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PingReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.PingReq{")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PingResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.PingResp{")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&proto.GetReq{")
+	if this.Version != nil {
+		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	}
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "All: "+fmt.Sprintf("%#v", this.All)+",\n")
+	s = append(s, "StaleOk: "+fmt.Sprintf("%#v", this.StaleOk)+",\n")
+	s = append(s, "MaxLag: "+fmt.Sprintf("%#v", this.MaxLag)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.GetResp{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "AppliedIndex: "+fmt.Sprintf("%#v", this.AppliedIndex)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.SetReq{")
+	if this.Version != nil {
+		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	}
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.SetResp{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetNxReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.SetNxReq{")
+	if this.Version != nil {
+		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	}
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetNxResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.SetNxResp{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IncrByReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.IncrByReq{")
+	if this.Version != nil {
+		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	}
+	if this.Field != nil {
+		s = append(s, "Field: "+fmt.Sprintf("%#v", this.Field)+",\n")
+	}
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IncrByResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.IncrByResp{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.Field != nil {
+		s = append(s, "Field: "+fmt.Sprintf("%#v", this.Field)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DecrByReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.DecrByReq{")
+	if this.Version != nil {
+		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	}
+	if this.Field != nil {
+		s = append(s, "Field: "+fmt.Sprintf("%#v", this.Field)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DecrByResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.DecrByResp{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.Field != nil {
+		s = append(s, "Field: "+fmt.Sprintf("%#v", this.Field)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CompareAndSetReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.CompareAndSetReq{")
+	if this.Version != nil {
+		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	}
+	if this.New != nil {
+		s = append(s, "New: "+fmt.Sprintf("%#v", this.New)+",\n")
+	}
+	if this.Old != nil {
+		s = append(s, "Old: "+fmt.Sprintf("%#v", this.Old)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CompareAndSetResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.CompareAndSetResp{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.Value != nil {
+		s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CompareAndSetNxReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.CompareAndSetNxReq{")
+	if this.Version != nil {
+		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	}
+	if this.New != nil {
+		s = append(s, "New: "+fmt.Sprintf("%#v", this.New)+",\n")
+	}
+	if this.Old != nil {
+		s = append(s, "Old: "+fmt.Sprintf("%#v", this.Old)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CompareAndSetNxResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.CompareAndSetNxResp{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.Value != nil {
+		s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DelReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.DelReq{")
+	if this.Version != nil {
+		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DelResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.DelResp{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnsetReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.UnsetReq{")
+	if this.Version != nil {
+		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	}
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnsetResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.UnsetResp{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *KickReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&proto.KickReq{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *KickResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&proto.KickResp{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Row) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&proto.Row{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "ErrCode: "+fmt.Sprintf("%#v", this.ErrCode)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MgetReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&proto.MgetReq{")
+	s = append(s, "Table: "+fmt.Sprintf("%#v", this.Table)+",\n")
+	if this.Keys != nil {
+		s = append(s, "Keys: "+fmt.Sprintf("%#v", this.Keys)+",\n")
+	}
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "All: "+fmt.Sprintf("%#v", this.All)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MgetResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.MgetResp{")
+	if this.Rows != nil {
+		s = append(s, "Rows: "+fmt.Sprintf("%#v", this.Rows)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MsetItem) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.MsetItem{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.Version != nil {
+		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	}
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MsetReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.MsetReq{")
+	s = append(s, "Table: "+fmt.Sprintf("%#v", this.Table)+",\n")
+	if this.Items != nil {
+		s = append(s, "Items: "+fmt.Sprintf("%#v", this.Items)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MsetResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.MsetResp{")
+	if this.Rows != nil {
+		s = append(s, "Rows: "+fmt.Sprintf("%#v", this.Rows)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxnOp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&proto.TxnOp{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.Version != nil {
+		s = append(s, "Version: "+valueToGoStringProto(this.Version, "int64")+",\n")
+	}
+	if this.Cmp != nil {
+		s = append(s, "Cmp: "+fmt.Sprintf("%#v", this.Cmp)+",\n")
+	}
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "Del: "+fmt.Sprintf("%#v", this.Del)+",\n")
+	s = append(s, "Table: "+fmt.Sprintf("%#v", this.Table)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxnReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.TxnReq{")
+	s = append(s, "Table: "+fmt.Sprintf("%#v", this.Table)+",\n")
+	if this.Ops != nil {
+		s = append(s, "Ops: "+fmt.Sprintf("%#v", this.Ops)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxnResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.TxnResp{")
+	if this.Rows != nil {
+		s = append(s, "Rows: "+fmt.Sprintf("%#v", this.Rows)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvalReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.EvalReq{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	if this.Args != nil {
+		s = append(s, "Args: "+fmt.Sprintf("%#v", this.Args)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EvalResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.EvalResp{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetByIndexReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&proto.GetByIndexReq{")
+	s = append(s, "Table: "+fmt.Sprintf("%#v", this.Table)+",\n")
+	if this.Value != nil {
+		s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	}
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Cursor: "+fmt.Sprintf("%#v", this.Cursor)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetByIndexResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.GetByIndexResp{")
+	if this.Rows != nil {
+		s = append(s, "Rows: "+fmt.Sprintf("%#v", this.Rows)+",\n")
+	}
+	s = append(s, "Cursor: "+fmt.Sprintf("%#v", this.Cursor)+",\n")
+	s = append(s, "Finish: "+fmt.Sprintf("%#v", this.Finish)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScanReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&proto.ScanReq{")
+	s = append(s, "Table: "+fmt.Sprintf("%#v", this.Table)+",\n")
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "All: "+fmt.Sprintf("%#v", this.All)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Cursor: "+fmt.Sprintf("%#v", this.Cursor)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScanResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.ScanResp{")
+	if this.Rows != nil {
+		s = append(s, "Rows: "+fmt.Sprintf("%#v", this.Rows)+",\n")
+	}
+	s = append(s, "Cursor: "+fmt.Sprintf("%#v", this.Cursor)+",\n")
+	s = append(s, "Finish: "+fmt.Sprintf("%#v", this.Finish)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PreloadReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&proto.PreloadReq{")
+	s = append(s, "Table: "+fmt.Sprintf("%#v", this.Table)+",\n")
+	if this.Keys != nil {
+		s = append(s, "Keys: "+fmt.Sprintf("%#v", this.Keys)+",\n")
+	}
+	s = append(s, "Begin: "+fmt.Sprintf("%#v", this.Begin)+",\n")
+	s = append(s, "End: "+fmt.Sprintf("%#v", this.End)+",\n")
+	s = append(s, "All: "+fmt.Sprintf("%#v", this.All)+",\n")
+	s = append(s, "Job: "+fmt.Sprintf("%#v", this.Job)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PreloadResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&proto.PreloadResp{")
+	s = append(s, "Job: "+fmt.Sprintf("%#v", this.Job)+",\n")
+	s = append(s, "Scanned: "+fmt.Sprintf("%#v", this.Scanned)+",\n")
+	s = append(s, "Loaded: "+fmt.Sprintf("%#v", this.Loaded)+",\n")
+	s = append(s, "Skipped: "+fmt.Sprintf("%#v", this.Skipped)+",\n")
+	s = append(s, "Finish: "+fmt.Sprintf("%#v", this.Finish)+",\n")
+	s = append(s, "Err: "+fmt.Sprintf("%#v", this.Err)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Cancel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.Cancel{")
+	if this.Seqs != nil {
+		s = append(s, "Seqs: "+fmt.Sprintf("%#v", this.Seqs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WatchReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&proto.WatchReq{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WatchResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&proto.WatchResp{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnwatchReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.UnwatchReq{")
+	s = append(s, "WatchSeqno: "+fmt.Sprintf("%#v", this.WatchSeqno)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnwatchResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&proto.UnwatchResp{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WatchNotify) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.WatchNotify{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "Del: "+fmt.Sprintf("%#v", this.Del)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MemberChangeReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.MemberChangeReq{")
+	s = append(s, "Remove: "+fmt.Sprintf("%#v", this.Remove)+",\n")
+	s = append(s, "NodeId: "+fmt.Sprintf("%#v", this.NodeId)+",\n")
+	s = append(s, "Url: "+fmt.Sprintf("%#v", this.Url)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MemberChangeResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.MemberChangeResp{")
+	s = append(s, "Err: "+fmt.Sprintf("%#v", this.Err)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransferLeaderReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.TransferLeaderReq{")
+	s = append(s, "Region: "+fmt.Sprintf("%#v", this.Region)+",\n")
+	s = append(s, "NodeId: "+fmt.Sprintf("%#v", this.NodeId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransferLeaderResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.TransferLeaderResp{")
+	s = append(s, "Err: "+fmt.Sprintf("%#v", this.Err)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MoveSlotReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.MoveSlotReq{")
+	s = append(s, "Slot: "+fmt.Sprintf("%#v", this.Slot)+",\n")
+	s = append(s, "Region: "+fmt.Sprintf("%#v", this.Region)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MoveSlotResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.MoveSlotResp{")
+	s = append(s, "Err: "+fmt.Sprintf("%#v", this.Err)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetSlotTableReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&proto.GetSlotTableReq{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetSlotTableResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.GetSlotTableResp{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	if this.Regions != nil {
+		s = append(s, "Regions: "+fmt.Sprintf("%#v", this.Regions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RegionInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.RegionInfo{")
+	s = append(s, "Region: "+fmt.Sprintf("%#v", this.Region)+",\n")
+	s = append(s, "Leader: "+fmt.Sprintf("%#v", this.Leader)+",\n")
+	s = append(s, "Term: "+fmt.Sprintf("%#v", this.Term)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReportRegionReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&proto.ReportRegionReq{")
+	s = append(s, "NodeId: "+fmt.Sprintf("%#v", this.NodeId)+",\n")
+	s = append(s, "Service: "+fmt.Sprintf("%#v", this.Service)+",\n")
+	s = append(s, "RaftUrl: "+fmt.Sprintf("%#v", this.RaftUrl)+",\n")
+	s = append(s, "RegionCount: "+fmt.Sprintf("%#v", this.RegionCount)+",\n")
+	s = append(s, "Join: "+fmt.Sprintf("%#v", this.Join)+",\n")
+	if this.Regions != nil {
+		s = append(s, "Regions: "+fmt.Sprintf("%#v", this.Regions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReportRegionResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&proto.ReportRegionResp{")
+	s = append(s, "Err: "+fmt.Sprintf("%#v", this.Err)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RouteInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.RouteInfo{")
+	s = append(s, "Region: "+fmt.Sprintf("%#v", this.Region)+",\n")
+	s = append(s, "Leader: "+fmt.Sprintf("%#v", this.Leader)+",\n")
+	s = append(s, "Service: "+fmt.Sprintf("%#v", this.Service)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NodeInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&proto.NodeInfo{")
+	s = append(s, "NodeId: "+fmt.Sprintf("%#v", this.NodeId)+",\n")
+	s = append(s, "Service: "+fmt.Sprintf("%#v", this.Service)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QueryRouteReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&proto.QueryRouteReq{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QueryRouteResp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&proto.QueryRouteResp{")
+	s = append(s, "RegionCount: "+fmt.Sprintf("%#v", this.RegionCount)+",\n")
+	if this.Routes != nil {
+		s = append(s, "Routes: "+fmt.Sprintf("%#v", this.Routes)+",\n")
+	}
+	if this.Nodes != nil {
+		s = append(s, "Nodes: "+fmt.Sprintf("%#v", this.Nodes)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringProto(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *LoginReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoginReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoginReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Compress {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *LoginResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoginResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoginResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Compress {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i--
+	if m.Ok {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *ReloadTableConfReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadTableConfReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReloadTableConfReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Regions) > 0 {
+		dAtA2 := make([]byte, len(m.Regions)*10)
+		var j1 int
+		for _, num1 := range m.Regions {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintProto(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintProto(dAtA, i, uint64(m.Seqno))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *ReloadTableConfResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadTableConfResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReloadTableConfResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Err)
+	copy(dAtA[i:], m.Err)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Err)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintProto(dAtA, i, uint64(m.ErrCode))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintProto(dAtA, i, uint64(m.Seqno))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *ReloadConfigReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadConfigReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReloadConfigReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReloadConfigResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadConfigResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReloadConfigResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Err)
+	copy(dAtA[i:], m.Err)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Err)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Value) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Value) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.B != nil {
+		i -= len(m.B)
+		copy(dAtA[i:], m.B)
+		i = encodeVarintProto(dAtA, i, uint64(len(m.B)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.S)
+	copy(dAtA[i:], m.S)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.S)))
+	i--
+	dAtA[i] = 0x22
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.F))))
+	i--
+	dAtA[i] = 0x19
+	i = encodeVarintProto(dAtA, i, uint64(m.I))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintProto(dAtA, i, uint64(m.Type))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Field) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Field) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Field) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.V != nil {
+		{
+			size, err := m.V.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PingReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PingReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PingReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.Timestamp))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *PingResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PingResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PingResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.Timestamp))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.MaxLag))
+	i--
+	dAtA[i] = 0x28
+	i--
+	if m.StaleOk {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i--
+	if m.All {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintProto(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != nil {
+		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.AppliedIndex))
+	i--
+	dAtA[i] = 0x18
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *SetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.Ttl))
+	i--
+	dAtA[i] = 0x18
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != nil {
+		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *SetNxReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetNxReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetNxReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.Ttl))
+	i--
+	dAtA[i] = 0x18
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != nil {
+		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetNxResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetNxResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetNxResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *IncrByReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncrByReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncrByReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.Ttl))
+	i--
+	dAtA[i] = 0x18
+	if m.Field == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("field")
+	} else {
+		{
+			size, err := m.Field.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != nil {
+		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncrByResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncrByResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncrByResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Field != nil {
+		{
+			size, err := m.Field.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *DecrByReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecrByReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecrByReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Field == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("field")
+	} else {
+		{
+			size, err := m.Field.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != nil {
+		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecrByResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecrByResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecrByResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Field != nil {
+		{
+			size, err := m.Field.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *CompareAndSetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompareAndSetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompareAndSetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Old == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("old")
+	} else {
+		{
+			size, err := m.Old.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.New == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("new")
+	} else {
+		{
+			size, err := m.New.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != nil {
+		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompareAndSetResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompareAndSetResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompareAndSetResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *CompareAndSetNxReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompareAndSetNxReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompareAndSetNxReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Old == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("old")
+	} else {
+		{
+			size, err := m.Old.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.New == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("new")
+	} else {
+		{
+			size, err := m.New.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != nil {
+		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompareAndSetNxResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompareAndSetNxResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompareAndSetNxResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *DelReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != nil {
+		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintProto(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != nil {
+		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsetResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsetResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsetResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *KickReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KickReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KickReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *KickResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KickResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KickResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Row) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Row) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Row) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.ErrCode))
+	i--
+	dAtA[i] = 0x20
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MgetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MgetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MgetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.All {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintProto(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintProto(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Table)
+	copy(dAtA[i:], m.Table)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Table)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MgetResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MgetResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MgetResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsetItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsetItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsetItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Version != nil {
+		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Table)
+	copy(dAtA[i:], m.Table)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Table)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsetResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsetResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsetResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Table)
+	copy(dAtA[i:], m.Table)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Table)))
+	i--
+	dAtA[i] = 0x32
+	i--
+	if m.Del {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Cmp) > 0 {
+		for iNdEx := len(m.Cmp) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cmp[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Version != nil {
+		i = encodeVarintProto(dAtA, i, uint64(*m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ops) > 0 {
+		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Table)
+	copy(dAtA[i:], m.Table)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Table)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvalReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvalReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvalReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintProto(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EvalResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvalResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvalResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Result)
+	copy(dAtA[i:], m.Result)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Result)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *GetByIndexReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetByIndexReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetByIndexReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Cursor)
+	copy(dAtA[i:], m.Cursor)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Cursor)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintProto(dAtA, i, uint64(m.Count))
+	i--
+	dAtA[i] = 0x18
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Table)
+	copy(dAtA[i:], m.Table)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Table)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetByIndexResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetByIndexResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetByIndexResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Finish {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Cursor)
+	copy(dAtA[i:], m.Cursor)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Cursor)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Rows) > 0 {
+		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScanReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScanReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScanReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Cursor)
+	copy(dAtA[i:], m.Cursor)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Cursor)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintProto(dAtA, i, uint64(m.Count))
+	i--
+	dAtA[i] = 0x20
+	i--
+	if m.All {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintProto(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Table)
+	copy(dAtA[i:], m.Table)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Table)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScanResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScanResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScanResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Finish {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Cursor)
+	copy(dAtA[i:], m.Cursor)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Cursor)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Rows) > 0 {
+		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PreloadReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreloadReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreloadReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.Job))
+	i--
+	dAtA[i] = 0x30
+	i--
+	if m.All {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.End)
+	copy(dAtA[i:], m.End)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.End)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Begin)
+	copy(dAtA[i:], m.Begin)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Begin)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintProto(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Table)
+	copy(dAtA[i:], m.Table)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Table)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PreloadResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreloadResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreloadResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Err)
+	copy(dAtA[i:], m.Err)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Err)))
+	i--
+	dAtA[i] = 0x32
+	i--
+	if m.Finish {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintProto(dAtA, i, uint64(m.Skipped))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintProto(dAtA, i, uint64(m.Loaded))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintProto(dAtA, i, uint64(m.Scanned))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintProto(dAtA, i, uint64(m.Job))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Cancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Cancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Seqs) > 0 {
+		for iNdEx := len(m.Seqs) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintProto(dAtA, i, uint64(m.Seqs[iNdEx]))
+			i--
+			dAtA[i] = 0x8
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UnwatchReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnwatchReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnwatchReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.WatchSeqno))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *UnwatchResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnwatchResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnwatchResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchNotify) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchNotify) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchNotify) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Del {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *MemberChangeReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberChangeReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberChangeReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Url)
+	copy(dAtA[i:], m.Url)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Url)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintProto(dAtA, i, uint64(m.NodeId))
+	i--
+	dAtA[i] = 0x10
+	i--
+	if m.Remove {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *MemberChangeResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberChangeResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberChangeResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Err)
+	copy(dAtA[i:], m.Err)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Err)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferLeaderReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLeaderReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLeaderReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.NodeId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintProto(dAtA, i, uint64(m.Region))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferLeaderResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLeaderResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLeaderResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Err)
+	copy(dAtA[i:], m.Err)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Err)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MoveSlotReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveSlotReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveSlotReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.Region))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintProto(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *MoveSlotResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveSlotResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveSlotResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Err)
+	copy(dAtA[i:], m.Err)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Err)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSlotTableReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSlotTableReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSlotTableReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSlotTableResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSlotTableResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSlotTableResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Regions) > 0 {
+		dAtA14 := make([]byte, len(m.Regions)*10)
+		var j13 int
+		for _, num1 := range m.Regions {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintProto(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintProto(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *RegionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintProto(dAtA, i, uint64(m.Term))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintProto(dAtA, i, uint64(m.Leader))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintProto(dAtA, i, uint64(m.Region))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportRegionReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportRegionReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportRegionReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Regions) > 0 {
+		for iNdEx := len(m.Regions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Regions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i--
+	if m.Join {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintProto(dAtA, i, uint64(m.RegionCount))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.RaftUrl)
+	copy(dAtA[i:], m.RaftUrl)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.RaftUrl)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintProto(dAtA, i, uint64(m.NodeId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportRegionResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportRegionResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportRegionResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Err)
+	copy(dAtA[i:], m.Err)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Err)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RouteInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintProto(dAtA, i, uint64(m.Leader))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintProto(dAtA, i, uint64(m.Region))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintProto(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintProto(dAtA, i, uint64(m.NodeId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i = encodeVarintProto(dAtA, i, uint64(m.RegionCount))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProto(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProto(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LoginReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+
+func (m *LoginResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	n += 2
+	return n
+}
+
+func (m *ReloadTableConfReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Seqno))
+	if len(m.Regions) > 0 {
+		l = 0
+		for _, e := range m.Regions {
+			l += sovProto(uint64(e))
+		}
+		n += 1 + sovProto(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ReloadTableConfResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Seqno))
+	n += 1 + sovProto(uint64(m.ErrCode))
+	l = len(m.Err)
+	n += 1 + l + sovProto(uint64(l))
+	n += 1 + sovProto(uint64(m.Version))
+	return n
+}
+
+func (m *ReloadConfigReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *ReloadConfigResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *Value) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Type))
+	n += 1 + sovProto(uint64(m.I))
+	n += 9
+	l = len(m.S)
+	n += 1 + l + sovProto(uint64(l))
+	if m.B != nil {
+		l = len(m.B)
+		n += 1 + l + sovProto(uint64(l))
+	}
+	return n
+}
+
+func (m *Field) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovProto(uint64(l))
+	if m.V != nil {
+		l = m.V.Size()
+		n += 1 + l + sovProto(uint64(l))
+	}
+	return n
+}
+
+func (m *PingReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Timestamp))
+	return n
+}
+
+func (m *PingResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Timestamp))
+	return n
+}
+
+func (m *GetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != nil {
+		n += 1 + sovProto(uint64(*m.Version))
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	n += 2
+	n += 2
+	n += 1 + sovProto(uint64(m.MaxLag))
+	return n
+}
+
+func (m *GetResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Version))
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	n += 1 + sovProto(uint64(m.AppliedIndex))
+	return n
+}
+
+func (m *SetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != nil {
+		n += 1 + sovProto(uint64(*m.Version))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	n += 1 + sovProto(uint64(m.Ttl))
+	return n
+}
+
+func (m *SetResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Version))
+	return n
+}
+
+func (m *SetNxReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != nil {
+		n += 1 + sovProto(uint64(*m.Version))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	n += 1 + sovProto(uint64(m.Ttl))
+	return n
+}
+
+func (m *SetNxResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Version))
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncrByReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != nil {
+		n += 1 + sovProto(uint64(*m.Version))
+	}
+	if m.Field != nil {
+		l = m.Field.Size()
+		n += 1 + l + sovProto(uint64(l))
+	}
+	n += 1 + sovProto(uint64(m.Ttl))
+	return n
+}
+
+func (m *IncrByResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Version))
+	if m.Field != nil {
+		l = m.Field.Size()
+		n += 1 + l + sovProto(uint64(l))
+	}
+	return n
+}
+
+func (m *DecrByReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != nil {
+		n += 1 + sovProto(uint64(*m.Version))
+	}
+	if m.Field != nil {
+		l = m.Field.Size()
+		n += 1 + l + sovProto(uint64(l))
+	}
+	return n
+}
+
+func (m *DecrByResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Version))
+	if m.Field != nil {
+		l = m.Field.Size()
+		n += 1 + l + sovProto(uint64(l))
+	}
+	return n
+}
+
+func (m *CompareAndSetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != nil {
+		n += 1 + sovProto(uint64(*m.Version))
+	}
+	if m.New != nil {
+		l = m.New.Size()
+		n += 1 + l + sovProto(uint64(l))
+	}
+	if m.Old != nil {
+		l = m.Old.Size()
+		n += 1 + l + sovProto(uint64(l))
+	}
+	return n
+}
+
+func (m *CompareAndSetResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Version))
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovProto(uint64(l))
+	}
+	return n
+}
+
+func (m *CompareAndSetNxReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != nil {
+		n += 1 + sovProto(uint64(*m.Version))
+	}
+	if m.New != nil {
+		l = m.New.Size()
+		n += 1 + l + sovProto(uint64(l))
+	}
+	if m.Old != nil {
+		l = m.Old.Size()
+		n += 1 + l + sovProto(uint64(l))
+	}
+	return n
+}
+
+func (m *CompareAndSetNxResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Version))
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovProto(uint64(l))
+	}
+	return n
+}
+
+func (m *DelReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != nil {
+		n += 1 + sovProto(uint64(*m.Version))
+	}
+	return n
+}
+
+func (m *DelResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Version))
+	return n
+}
+
+func (m *UnsetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != nil {
+		n += 1 + sovProto(uint64(*m.Version))
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnsetResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Version))
+	return n
+}
+
+func (m *KickReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *KickResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Row) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovProto(uint64(l))
+	n += 1 + sovProto(uint64(m.Version))
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	n += 1 + sovProto(uint64(m.ErrCode))
+	return n
+}
+
+func (m *MgetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Table)
+	n += 1 + l + sovProto(uint64(l))
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	n += 2
+	return n
+}
+
+func (m *MgetResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsetItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovProto(uint64(l))
+	if m.Version != nil {
+		n += 1 + sovProto(uint64(*m.Version))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Table)
+	n += 1 + l + sovProto(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsetResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxnOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovProto(uint64(l))
+	if m.Version != nil {
+		n += 1 + sovProto(uint64(*m.Version))
+	}
+	if len(m.Cmp) > 0 {
+		for _, e := range m.Cmp {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	n += 2
+	l = len(m.Table)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *TxnReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Table)
+	n += 1 + l + sovProto(uint64(l))
+	if len(m.Ops) > 0 {
+		for _, e := range m.Ops {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxnResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvalReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovProto(uint64(l))
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvalResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Version))
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	l = len(m.Result)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *GetByIndexReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Table)
+	n += 1 + l + sovProto(uint64(l))
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovProto(uint64(l))
+	}
+	n += 1 + sovProto(uint64(m.Count))
+	l = len(m.Cursor)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *GetByIndexResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	l = len(m.Cursor)
+	n += 1 + l + sovProto(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *ScanReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Table)
+	n += 1 + l + sovProto(uint64(l))
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	n += 2
+	n += 1 + sovProto(uint64(m.Count))
+	l = len(m.Cursor)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *ScanResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	l = len(m.Cursor)
+	n += 1 + l + sovProto(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *PreloadReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Table)
+	n += 1 + l + sovProto(uint64(l))
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	l = len(m.Begin)
+	n += 1 + l + sovProto(uint64(l))
+	l = len(m.End)
+	n += 1 + l + sovProto(uint64(l))
+	n += 2
+	n += 1 + sovProto(uint64(m.Job))
+	return n
+}
+
+func (m *PreloadResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Job))
+	n += 1 + sovProto(uint64(m.Scanned))
+	n += 1 + sovProto(uint64(m.Loaded))
+	n += 1 + sovProto(uint64(m.Skipped))
+	n += 2
+	l = len(m.Err)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *Cancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Seqs) > 0 {
+		for _, e := range m.Seqs {
+			n += 1 + sovProto(uint64(e))
+		}
+	}
+	return n
+}
+
+func (m *WatchReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WatchResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnwatchReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.WatchSeqno))
+	return n
+}
+
+func (m *UnwatchResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WatchNotify) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Version))
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	n += 2
+	return n
+}
+
+func (m *MemberChangeReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	n += 1 + sovProto(uint64(m.NodeId))
+	l = len(m.Url)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *MemberChangeResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *TransferLeaderReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Region))
+	n += 1 + sovProto(uint64(m.NodeId))
+	return n
+}
+
+func (m *TransferLeaderResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *MoveSlotReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Slot))
+	n += 1 + sovProto(uint64(m.Region))
+	return n
+}
+
+func (m *MoveSlotResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *GetSlotTableReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetSlotTableResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Version))
+	if len(m.Regions) > 0 {
+		l = 0
+		for _, e := range m.Regions {
+			l += sovProto(uint64(e))
+		}
+		n += 1 + sovProto(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *RegionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Region))
+	n += 1 + sovProto(uint64(m.Leader))
+	n += 1 + sovProto(uint64(m.Term))
+	return n
+}
+
+func (m *ReportRegionReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.NodeId))
+	l = len(m.Service)
+	n += 1 + l + sovProto(uint64(l))
+	l = len(m.RaftUrl)
+	n += 1 + l + sovProto(uint64(l))
+	n += 1 + sovProto(uint64(m.RegionCount))
+	n += 2
+	if len(m.Regions) > 0 {
+		for _, e := range m.Regions {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReportRegionResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *RouteInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.Region))
+	n += 1 + sovProto(uint64(m.Leader))
+	l = len(m.Service)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *NodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.NodeId))
+	l = len(m.Service)
+	n += 1 + l + sovProto(uint64(l))
+	return n
+}
+
+func (m *QueryRouteReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRouteResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovProto(uint64(m.RegionCount))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovProto(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProto(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProto(x uint64) (n int) {
+	return sovProto(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *LoginReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoginReq{`,
+		`Compress:` + fmt.Sprintf("%v", this.Compress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LoginResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoginResp{`,
+		`Ok:` + fmt.Sprintf("%v", this.Ok) + `,`,
+		`Compress:` + fmt.Sprintf("%v", this.Compress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReloadTableConfReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReloadTableConfReq{`,
+		`Seqno:` + fmt.Sprintf("%v", this.Seqno) + `,`,
+		`Regions:` + fmt.Sprintf("%v", this.Regions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReloadTableConfResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReloadTableConfResp{`,
+		`Seqno:` + fmt.Sprintf("%v", this.Seqno) + `,`,
+		`ErrCode:` + fmt.Sprintf("%v", this.ErrCode) + `,`,
+		`Err:` + fmt.Sprintf("%v", this.Err) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReloadConfigReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReloadConfigReq{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReloadConfigResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReloadConfigResp{`,
+		`Err:` + fmt.Sprintf("%v", this.Err) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Value) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Value{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`I:` + fmt.Sprintf("%v", this.I) + `,`,
+		`F:` + fmt.Sprintf("%v", this.F) + `,`,
+		`S:` + fmt.Sprintf("%v", this.S) + `,`,
+		`B:` + fmt.Sprintf("%v", this.B) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Field) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Field{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`V:` + strings.Replace(fmt.Sprintf("%v", this.V), "Value", "Value", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PingReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PingReq{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PingResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PingResp{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetReq{`,
+		`Version:` + valueToStringProto(this.Version) + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`All:` + fmt.Sprintf("%v", this.All) + `,`,
+		`StaleOk:` + fmt.Sprintf("%v", this.StaleOk) + `,`,
+		`MaxLag:` + fmt.Sprintf("%v", this.MaxLag) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFields := "[]*Field{"
+	for _, f := range this.Fields {
+		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	}
+	repeatedStringForFields += "}"
+	s := strings.Join([]string{`&GetResp{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Fields:` + repeatedStringForFields + `,`,
+		`AppliedIndex:` + fmt.Sprintf("%v", this.AppliedIndex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFields := "[]*Field{"
+	for _, f := range this.Fields {
+		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	}
+	repeatedStringForFields += "}"
+	s := strings.Join([]string{`&SetReq{`,
+		`Version:` + valueToStringProto(this.Version) + `,`,
+		`Fields:` + repeatedStringForFields + `,`,
+		`Ttl:` + fmt.Sprintf("%v", this.Ttl) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetResp{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetNxReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFields := "[]*Field{"
+	for _, f := range this.Fields {
+		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	}
+	repeatedStringForFields += "}"
+	s := strings.Join([]string{`&SetNxReq{`,
+		`Version:` + valueToStringProto(this.Version) + `,`,
+		`Fields:` + repeatedStringForFields + `,`,
+		`Ttl:` + fmt.Sprintf("%v", this.Ttl) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetNxResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFields := "[]*Field{"
+	for _, f := range this.Fields {
+		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	}
+	repeatedStringForFields += "}"
+	s := strings.Join([]string{`&SetNxResp{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Fields:` + repeatedStringForFields + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IncrByReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IncrByReq{`,
+		`Version:` + valueToStringProto(this.Version) + `,`,
+		`Field:` + strings.Replace(fmt.Sprintf("%v", this.Field), "Field", "Field", 1) + `,`,
+		`Ttl:` + fmt.Sprintf("%v", this.Ttl) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IncrByResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IncrByResp{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Field:` + strings.Replace(fmt.Sprintf("%v", this.Field), "Field", "Field", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DecrByReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DecrByReq{`,
+		`Version:` + valueToStringProto(this.Version) + `,`,
+		`Field:` + strings.Replace(fmt.Sprintf("%v", this.Field), "Field", "Field", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DecrByResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DecrByResp{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Field:` + strings.Replace(fmt.Sprintf("%v", this.Field), "Field", "Field", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompareAndSetReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompareAndSetReq{`,
+		`Version:` + valueToStringProto(this.Version) + `,`,
+		`New:` + strings.Replace(fmt.Sprintf("%v", this.New), "Field", "Field", 1) + `,`,
+		`Old:` + strings.Replace(fmt.Sprintf("%v", this.Old), "Field", "Field", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompareAndSetResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompareAndSetResp{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Value:` + strings.Replace(fmt.Sprintf("%v", this.Value), "Field", "Field", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompareAndSetNxReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompareAndSetNxReq{`,
+		`Version:` + valueToStringProto(this.Version) + `,`,
+		`New:` + strings.Replace(fmt.Sprintf("%v", this.New), "Field", "Field", 1) + `,`,
+		`Old:` + strings.Replace(fmt.Sprintf("%v", this.Old), "Field", "Field", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompareAndSetNxResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompareAndSetNxResp{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Value:` + strings.Replace(fmt.Sprintf("%v", this.Value), "Field", "Field", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DelReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DelReq{`,
+		`Version:` + valueToStringProto(this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DelResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DelResp{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnsetReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnsetReq{`,
+		`Version:` + valueToStringProto(this.Version) + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnsetResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnsetResp{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KickReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KickReq{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KickResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KickResp{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Row) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFields := "[]*Field{"
+	for _, f := range this.Fields {
+		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	}
+	repeatedStringForFields += "}"
+	s := strings.Join([]string{`&Row{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Fields:` + repeatedStringForFields + `,`,
+		`ErrCode:` + fmt.Sprintf("%v", this.ErrCode) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MgetReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MgetReq{`,
+		`Table:` + fmt.Sprintf("%v", this.Table) + `,`,
+		`Keys:` + fmt.Sprintf("%v", this.Keys) + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`All:` + fmt.Sprintf("%v", this.All) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MgetResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRows := "[]*Row{"
+	for _, f := range this.Rows {
+		repeatedStringForRows += strings.Replace(fmt.Sprintf("%v", f), "Row", "Row", 1) + ","
+	}
+	repeatedStringForRows += "}"
+	s := strings.Join([]string{`&MgetResp{`,
+		`Rows:` + repeatedStringForRows + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MsetItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFields := "[]*Field{"
+	for _, f := range this.Fields {
+		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	}
+	repeatedStringForFields += "}"
+	s := strings.Join([]string{`&MsetItem{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Version:` + valueToStringProto(this.Version) + `,`,
+		`Fields:` + repeatedStringForFields + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MsetReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]*MsetItem{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(fmt.Sprintf("%v", f), "MsetItem", "MsetItem", 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&MsetReq{`,
+		`Table:` + fmt.Sprintf("%v", this.Table) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MsetResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRows := "[]*Row{"
+	for _, f := range this.Rows {
+		repeatedStringForRows += strings.Replace(fmt.Sprintf("%v", f), "Row", "Row", 1) + ","
+	}
+	repeatedStringForRows += "}"
+	s := strings.Join([]string{`&MsetResp{`,
+		`Rows:` + repeatedStringForRows + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxnOp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCmp := "[]*Field{"
+	for _, f := range this.Cmp {
+		repeatedStringForCmp += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	}
+	repeatedStringForCmp += "}"
+	repeatedStringForFields := "[]*Field{"
+	for _, f := range this.Fields {
+		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	}
+	repeatedStringForFields += "}"
+	s := strings.Join([]string{`&TxnOp{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Version:` + valueToStringProto(this.Version) + `,`,
+		`Cmp:` + repeatedStringForCmp + `,`,
+		`Fields:` + repeatedStringForFields + `,`,
+		`Del:` + fmt.Sprintf("%v", this.Del) + `,`,
+		`Table:` + fmt.Sprintf("%v", this.Table) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxnReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOps := "[]*TxnOp{"
+	for _, f := range this.Ops {
+		repeatedStringForOps += strings.Replace(fmt.Sprintf("%v", f), "TxnOp", "TxnOp", 1) + ","
+	}
+	repeatedStringForOps += "}"
+	s := strings.Join([]string{`&TxnReq{`,
+		`Table:` + fmt.Sprintf("%v", this.Table) + `,`,
+		`Ops:` + repeatedStringForOps + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxnResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRows := "[]*Row{"
+	for _, f := range this.Rows {
+		repeatedStringForRows += strings.Replace(fmt.Sprintf("%v", f), "Row", "Row", 1) + ","
+	}
+	repeatedStringForRows += "}"
+	s := strings.Join([]string{`&TxnResp{`,
+		`Rows:` + repeatedStringForRows + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvalReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EvalReq{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EvalResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFields := "[]*Field{"
+	for _, f := range this.Fields {
+		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	}
+	repeatedStringForFields += "}"
+	s := strings.Join([]string{`&EvalResp{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Fields:` + repeatedStringForFields + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetByIndexReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetByIndexReq{`,
+		`Table:` + fmt.Sprintf("%v", this.Table) + `,`,
+		`Value:` + strings.Replace(fmt.Sprintf("%v", this.Value), "Field", "Field", 1) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Cursor:` + fmt.Sprintf("%v", this.Cursor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetByIndexResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRows := "[]*Row{"
+	for _, f := range this.Rows {
+		repeatedStringForRows += strings.Replace(fmt.Sprintf("%v", f), "Row", "Row", 1) + ","
+	}
+	repeatedStringForRows += "}"
+	s := strings.Join([]string{`&GetByIndexResp{`,
+		`Rows:` + repeatedStringForRows + `,`,
+		`Cursor:` + fmt.Sprintf("%v", this.Cursor) + `,`,
+		`Finish:` + fmt.Sprintf("%v", this.Finish) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScanReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScanReq{`,
+		`Table:` + fmt.Sprintf("%v", this.Table) + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`All:` + fmt.Sprintf("%v", this.All) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Cursor:` + fmt.Sprintf("%v", this.Cursor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScanResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRows := "[]*Row{"
+	for _, f := range this.Rows {
+		repeatedStringForRows += strings.Replace(fmt.Sprintf("%v", f), "Row", "Row", 1) + ","
+	}
+	repeatedStringForRows += "}"
+	s := strings.Join([]string{`&ScanResp{`,
+		`Rows:` + repeatedStringForRows + `,`,
+		`Cursor:` + fmt.Sprintf("%v", this.Cursor) + `,`,
+		`Finish:` + fmt.Sprintf("%v", this.Finish) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PreloadReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PreloadReq{`,
+		`Table:` + fmt.Sprintf("%v", this.Table) + `,`,
+		`Keys:` + fmt.Sprintf("%v", this.Keys) + `,`,
+		`Begin:` + fmt.Sprintf("%v", this.Begin) + `,`,
+		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+		`All:` + fmt.Sprintf("%v", this.All) + `,`,
+		`Job:` + fmt.Sprintf("%v", this.Job) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PreloadResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PreloadResp{`,
+		`Job:` + fmt.Sprintf("%v", this.Job) + `,`,
+		`Scanned:` + fmt.Sprintf("%v", this.Scanned) + `,`,
+		`Loaded:` + fmt.Sprintf("%v", this.Loaded) + `,`,
+		`Skipped:` + fmt.Sprintf("%v", this.Skipped) + `,`,
+		`Finish:` + fmt.Sprintf("%v", this.Finish) + `,`,
+		`Err:` + fmt.Sprintf("%v", this.Err) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Cancel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Cancel{`,
+		`Seqs:` + fmt.Sprintf("%v", this.Seqs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchReq{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchResp{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnwatchReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnwatchReq{`,
+		`WatchSeqno:` + fmt.Sprintf("%v", this.WatchSeqno) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnwatchResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnwatchResp{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchNotify) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFields := "[]*Field{"
+	for _, f := range this.Fields {
+		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	}
+	repeatedStringForFields += "}"
+	s := strings.Join([]string{`&WatchNotify{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Fields:` + repeatedStringForFields + `,`,
+		`Del:` + fmt.Sprintf("%v", this.Del) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemberChangeReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemberChangeReq{`,
+		`Remove:` + fmt.Sprintf("%v", this.Remove) + `,`,
+		`NodeId:` + fmt.Sprintf("%v", this.NodeId) + `,`,
+		`Url:` + fmt.Sprintf("%v", this.Url) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemberChangeResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemberChangeResp{`,
+		`Err:` + fmt.Sprintf("%v", this.Err) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransferLeaderReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransferLeaderReq{`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`NodeId:` + fmt.Sprintf("%v", this.NodeId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransferLeaderResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransferLeaderResp{`,
+		`Err:` + fmt.Sprintf("%v", this.Err) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MoveSlotReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MoveSlotReq{`,
+		`Slot:` + fmt.Sprintf("%v", this.Slot) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MoveSlotResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MoveSlotResp{`,
+		`Err:` + fmt.Sprintf("%v", this.Err) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSlotTableReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetSlotTableReq{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSlotTableResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetSlotTableResp{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Regions:` + fmt.Sprintf("%v", this.Regions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegionInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegionInfo{`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Leader:` + fmt.Sprintf("%v", this.Leader) + `,`,
+		`Term:` + fmt.Sprintf("%v", this.Term) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReportRegionReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRegions := "[]*RegionInfo{"
+	for _, f := range this.Regions {
+		repeatedStringForRegions += strings.Replace(fmt.Sprintf("%v", f), "RegionInfo", "RegionInfo", 1) + ","
+	}
+	repeatedStringForRegions += "}"
+	s := strings.Join([]string{`&ReportRegionReq{`,
+		`NodeId:` + fmt.Sprintf("%v", this.NodeId) + `,`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`RaftUrl:` + fmt.Sprintf("%v", this.RaftUrl) + `,`,
+		`RegionCount:` + fmt.Sprintf("%v", this.RegionCount) + `,`,
+		`Join:` + fmt.Sprintf("%v", this.Join) + `,`,
+		`Regions:` + repeatedStringForRegions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReportRegionResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReportRegionResp{`,
+		`Err:` + fmt.Sprintf("%v", this.Err) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RouteInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RouteInfo{`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Leader:` + fmt.Sprintf("%v", this.Leader) + `,`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodeInfo{`,
+		`NodeId:` + fmt.Sprintf("%v", this.NodeId) + `,`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueryRouteReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueryRouteReq{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueryRouteResp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRoutes := "[]*RouteInfo{"
+	for _, f := range this.Routes {
+		repeatedStringForRoutes += strings.Replace(fmt.Sprintf("%v", f), "RouteInfo", "RouteInfo", 1) + ","
+	}
+	repeatedStringForRoutes += "}"
+	repeatedStringForNodes := "[]*NodeInfo{"
+	for _, f := range this.Nodes {
+		repeatedStringForNodes += strings.Replace(fmt.Sprintf("%v", f), "NodeInfo", "NodeInfo", 1) + ","
+	}
+	repeatedStringForNodes += "}"
+	s := strings.Join([]string{`&QueryRouteResp{`,
+		`RegionCount:` + fmt.Sprintf("%v", this.RegionCount) + `,`,
+		`Routes:` + repeatedStringForRoutes + `,`,
+		`Nodes:` + repeatedStringForNodes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringProto(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *LoginReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: loginReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: loginReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compress = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoginResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: loginResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: loginResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compress = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReloadTableConfReq) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: reloadTableConfReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: reloadTableConfReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seqno", wireType)
+			}
+			m.Seqno = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seqno |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProto
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Regions = append(m.Regions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProto
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProto
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProto
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Regions) == 0 {
+					m.Regions = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProto
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Regions = append(m.Regions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("seqno")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReloadTableConfResp) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: reloadTableConfResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: reloadTableConfResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seqno", wireType)
+			}
+			m.Seqno = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seqno |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrCode", wireType)
+			}
+			m.ErrCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("seqno")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("errCode")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReloadConfigReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: reloadConfigReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: reloadConfigReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReloadConfigResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: reloadConfigResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: reloadConfigResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Value) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: value: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: value: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ValueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field I", wireType)
+			}
+			m.I = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.I |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.F = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.B = append(m.B[:0], dAtA[iNdEx:postIndex]...)
+			if m.B == nil {
+				m.B = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("type")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Field) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: field: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: field: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.V == nil {
+				m.V = &Value{}
+			}
+			if err := m.V.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PingReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ping_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ping_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PingResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ping_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ping_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: get_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: get_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Version = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleOk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StaleOk = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLag", wireType)
+			}
+			m.MaxLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: get_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: get_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &Field{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedIndex", wireType)
+			}
+			m.AppliedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: set_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: set_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Version = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &Field{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: set_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: set_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetNxReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: set_nx_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: set_nx_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Version = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &Field{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetNxResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: set_nx_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: set_nx_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &Field{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncrByReq) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: incr_by_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: incr_by_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Version = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Field == nil {
+				m.Field = &Field{}
+			}
+			if err := m.Field.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("field")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncrByResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: incr_by_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: incr_by_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Field == nil {
+				m.Field = &Field{}
+			}
+			if err := m.Field.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecrByReq) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: decr_by_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: decr_by_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Version = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Field == nil {
+				m.Field = &Field{}
+			}
+			if err := m.Field.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("field")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecrByResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: decr_by_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: decr_by_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Field == nil {
+				m.Field = &Field{}
+			}
+			if err := m.Field.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompareAndSetReq) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: compare_and_set_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: compare_and_set_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Version = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.New == nil {
+				m.New = &Field{}
+			}
+			if err := m.New.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Old", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Old == nil {
+				m.Old = &Field{}
+			}
+			if err := m.Old.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("new")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("old")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompareAndSetResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: compare_and_set_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: compare_and_set_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &Field{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompareAndSetNxReq) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: compare_and_set_nx_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: compare_and_set_nx_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Version = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.New == nil {
+				m.New = &Field{}
+			}
+			if err := m.New.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Old", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Old == nil {
+				m.Old = &Field{}
+			}
+			if err := m.Old.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("new")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("old")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompareAndSetNxResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: compare_and_set_nx_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: compare_and_set_nx_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &Field{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: del_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: del_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Version = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: del_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: del_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsetReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: unset_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: unset_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Version = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsetResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: unset_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: unset_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KickReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: kick_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: kick_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KickResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: kick_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: kick_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Row) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: row: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: row: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &Field{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrCode", wireType)
+			}
+			m.ErrCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MgetReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: mget_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: mget_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MgetResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: mget_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: mget_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, &Row{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsetItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: mset_item: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: mset_item: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Version = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &Field{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsetReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: mset_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: mset_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &MsetItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsetResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: mset_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: mset_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, &Row{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: txn_op: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: txn_op: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Version = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cmp = append(m.Cmp, &Field{})
+			if err := m.Cmp[len(m.Cmp)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &Field{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Del", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Del = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: txn_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: txn_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ops = append(m.Ops, &TxnOp{})
+			if err := m.Ops[len(m.Ops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: txn_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: txn_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, &Row{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvalReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: eval_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: eval_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvalResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: eval_resp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: eval_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &Field{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetByIndexReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: get_by_index_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: get_by_index_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: loginReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: loginReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &Field{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -4744,12 +16307,43 @@ func (m *LoginReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Count |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Compress = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -4774,7 +16368,7 @@ func (m *LoginReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoginResp) Unmarshal(dAtA []byte) error {
+func (m *GetByIndexResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4797,17 +16391,17 @@ func (m *LoginResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: loginResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: get_by_index_resp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: loginResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: get_by_index_resp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -4817,15 +16411,61 @@ func (m *LoginResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Ok = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, &Row{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finish", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4842,7 +16482,7 @@ func (m *LoginResp) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Compress = bool(v != 0)
+			m.Finish = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -4867,8 +16507,7 @@ func (m *LoginResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReloadTableConfReq) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *ScanReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4891,17 +16530,120 @@ func (m *ReloadTableConfReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: reloadTableConfReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: scan_req: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: reloadTableConfReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: scan_req: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seqno", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			m.Seqno = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -4911,12 +16653,24 @@ func (m *ReloadTableConfReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seqno |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			hasFields[0] |= uint64(0x00000001)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -4935,17 +16689,13 @@ func (m *ReloadTableConfReq) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("seqno")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ReloadTableConfResp) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *ScanResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4968,17 +16718,17 @@ func (m *ReloadTableConfResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: reloadTableConfResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: scan_resp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: reloadTableConfResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: scan_resp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seqno", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
 			}
-			m.Seqno = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -4988,35 +16738,29 @@ func (m *ReloadTableConfResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seqno |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrCode", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthProto
 			}
-			m.ErrCode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ErrCode |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
 			}
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, &Row{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5044,8 +16788,28 @@ func (m *ReloadTableConfResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Err = string(dAtA[iNdEx:postIndex])
+			m.Cursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finish", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finish = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -5064,19 +16828,13 @@ func (m *ReloadTableConfResp) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("seqno")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("errCode")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ReloadConfigReq) Unmarshal(dAtA []byte) error {
+func (m *PreloadReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5099,15 +16857,15 @@ func (m *ReloadConfigReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: reloadConfigReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: preload_req: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: reloadConfigReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: preload_req: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5135,64 +16893,75 @@ func (m *ReloadConfigReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProto(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProto
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthProto
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReloadConfigResp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProto
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: reloadConfigResp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: reloadConfigResp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Begin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5220,8 +16989,47 @@ func (m *ReloadConfigResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Err = string(dAtA[iNdEx:postIndex])
+			m.End = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			m.Job = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Job |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -5246,8 +17054,7 @@ func (m *ReloadConfigResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Value) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *PreloadResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5270,17 +17077,55 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: value: wiretype end group for non-group")
+			return fmt.Errorf("proto: preload_resp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: value: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: preload_resp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			m.Job = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Job |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scanned", wireType)
+			}
+			m.Scanned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scanned |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Loaded", wireType)
 			}
-			m.Type = 0
+			m.Loaded = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -5290,17 +17135,16 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ValueType(b&0x7F) << shift
+				m.Loaded |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field I", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
 			}
-			m.I = 0
+			m.Skipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -5310,27 +17154,16 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.I |= int64(b&0x7F) << shift
+				m.Skipped |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.F = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finish", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -5340,29 +17173,17 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProto
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProto
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.S = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.Finish = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -5372,25 +17193,23 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProto
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProto
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.B = append(m.B[:0], dAtA[iNdEx:postIndex]...)
-			if m.B == nil {
-				m.B = []byte{}
-			}
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5410,16 +17229,13 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("type")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *Field) Unmarshal(dAtA []byte) error {
+func (m *Cancel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5442,80 +17258,141 @@ func (m *Field) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: field: wiretype end group for non-group")
+			return fmt.Errorf("proto: cancel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: field: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: cancel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProto
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Seqs = append(m.Seqs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProto
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthProto
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProto
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProto
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProto
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Seqs) == 0 {
+					m.Seqs = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProto
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Seqs = append(m.Seqs, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seqs", wireType)
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthProto
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthProto
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.V == nil {
-				m.V = &Value{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
 			}
-			if err := m.V.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: watch_req: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: watch_req: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -5540,7 +17417,7 @@ func (m *Field) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PingReq) Unmarshal(dAtA []byte) error {
+func (m *WatchResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5563,31 +17440,12 @@ func (m *PingReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ping_req: wiretype end group for non-group")
+			return fmt.Errorf("proto: watch_resp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ping_req: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: watch_resp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -5612,7 +17470,7 @@ func (m *PingReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PingResp) Unmarshal(dAtA []byte) error {
+func (m *UnwatchReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5635,17 +17493,17 @@ func (m *PingResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ping_resp: wiretype end group for non-group")
+			return fmt.Errorf("proto: unwatch_req: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ping_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: unwatch_req: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchSeqno", wireType)
 			}
-			m.Timestamp = 0
+			m.WatchSeqno = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -5655,7 +17513,7 @@ func (m *PingResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.WatchSeqno |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5684,7 +17542,7 @@ func (m *PingResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReq) Unmarshal(dAtA []byte) error {
+func (m *UnwatchResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5707,84 +17565,12 @@ func (m *GetReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: get_req: wiretype end group for non-group")
+			return fmt.Errorf("proto: unwatch_resp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: get_req: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: unwatch_resp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Version = &v
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProto
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProto
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.All = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -5809,7 +17595,7 @@ func (m *GetReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetResp) Unmarshal(dAtA []byte) error {
+func (m *WatchNotify) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5832,10 +17618,10 @@ func (m *GetResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: get_resp: wiretype end group for non-group")
+			return fmt.Errorf("proto: watch_notify: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: get_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: watch_notify: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5891,6 +17677,26 @@ func (m *GetResp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Del", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Del = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -5915,7 +17721,7 @@ func (m *GetResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetReq) Unmarshal(dAtA []byte) error {
+func (m *MemberChangeReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5938,17 +17744,17 @@ func (m *SetReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: set_req: wiretype end group for non-group")
+			return fmt.Errorf("proto: member_change_req: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: set_req: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: member_change_req: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			var v int64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -5958,17 +17764,17 @@ func (m *SetReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Version = &v
+			m.Remove = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			var msglen int
+			m.NodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -5978,84 +17784,16 @@ func (m *SetReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NodeId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProto
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProto
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fields = append(m.Fields, &Field{})
-			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProto(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthProto
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthProto
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetResp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProto
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: set_resp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: set_resp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -6065,11 +17803,24 @@ func (m *SetResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -6094,7 +17845,7 @@ func (m *SetResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetNxReq) Unmarshal(dAtA []byte) error {
+func (m *MemberChangeResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6117,37 +17868,17 @@ func (m *SetNxReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: set_nx_req: wiretype end group for non-group")
+			return fmt.Errorf("proto: member_change_resp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: set_nx_req: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: member_change_resp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Version = &v
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -6157,25 +17888,23 @@ func (m *SetNxReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProto
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProto
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = append(m.Fields, &Field{})
-			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6201,7 +17930,7 @@ func (m *SetNxReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetNxResp) Unmarshal(dAtA []byte) error {
+func (m *TransferLeaderReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6224,17 +17953,17 @@ func (m *SetNxResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: set_nx_resp: wiretype end group for non-group")
+			return fmt.Errorf("proto: transfer_leader_req: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: set_nx_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: transfer_leader_req: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			m.Version = 0
+			m.Region = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -6244,16 +17973,16 @@ func (m *SetNxResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				m.Region |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			var msglen int
+			m.NodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -6263,26 +17992,11 @@ func (m *SetNxResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NodeId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProto
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProto
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fields = append(m.Fields, &Field{})
-			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -6307,8 +18021,7 @@ func (m *SetNxResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncrByReq) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *TransferLeaderResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6331,37 +18044,17 @@ func (m *IncrByReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: incr_by_req: wiretype end group for non-group")
+			return fmt.Errorf("proto: transfer_leader_resp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: incr_by_req: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: transfer_leader_resp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Version = &v
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -6371,29 +18064,24 @@ func (m *IncrByReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProto
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProto
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Field == nil {
-				m.Field = &Field{}
-			}
-			if err := m.Field.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -6412,16 +18100,13 @@ func (m *IncrByReq) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("field")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *IncrByResp) Unmarshal(dAtA []byte) error {
+func (m *MoveSlotReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6444,17 +18129,17 @@ func (m *IncrByResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: incr_by_resp: wiretype end group for non-group")
+			return fmt.Errorf("proto: move_slot_req: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: incr_by_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: move_slot_req: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			m.Version = 0
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -6464,16 +18149,16 @@ func (m *IncrByResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				m.Slot |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			var msglen int
+			m.Region = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -6483,28 +18168,11 @@ func (m *IncrByResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Region |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProto
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProto
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Field == nil {
-				m.Field = &Field{}
-			}
-			if err := m.Field.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -6529,8 +18197,7 @@ func (m *IncrByResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecrByReq) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *MoveSlotResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6553,37 +18220,17 @@ func (m *DecrByReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: decr_by_req: wiretype end group for non-group")
+			return fmt.Errorf("proto: move_slot_resp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: decr_by_req: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: move_slot_resp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Version = &v
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -6593,29 +18240,24 @@ func (m *DecrByReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProto
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProto
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Field == nil {
-				m.Field = &Field{}
-			}
-			if err := m.Field.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -6634,16 +18276,13 @@ func (m *DecrByReq) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("field")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *DecrByResp) Unmarshal(dAtA []byte) error {
+func (m *GetSlotTableReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6666,67 +18305,12 @@ func (m *DecrByResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: decr_by_resp: wiretype end group for non-group")
+			return fmt.Errorf("proto: get_slot_table_req: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: decr_by_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: get_slot_table_req: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProto
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProto
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Field == nil {
-				m.Field = &Field{}
-			}
-			if err := m.Field.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -6751,8 +18335,7 @@ func (m *DecrByResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CompareAndSetReq) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *GetSlotTableResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6775,17 +18358,17 @@ func (m *CompareAndSetReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: compare_and_set_req: wiretype end group for non-group")
+			return fmt.Errorf("proto: get_slot_table_resp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: compare_and_set_req: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: get_slot_table_resp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var v int64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -6795,86 +18378,87 @@ func (m *CompareAndSetReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Version = &v
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProto
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProto
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.New == nil {
-				m.New = &Field{}
-			}
-			if err := m.New.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Old", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProto
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProto
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Regions = append(m.Regions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProto
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProto
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProto
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Regions) == 0 {
+					m.Regions = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProto
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Regions = append(m.Regions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProto
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProto
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Old == nil {
-				m.Old = &Field{}
-			}
-			if err := m.Old.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -6893,19 +18477,13 @@ func (m *CompareAndSetReq) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("new")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("old")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *CompareAndSetResp) Unmarshal(dAtA []byte) error {
+func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6928,17 +18506,17 @@ func (m *CompareAndSetResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: compare_and_set_resp: wiretype end group for non-group")
+			return fmt.Errorf("proto: region_info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: compare_and_set_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: region_info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			m.Version = 0
+			m.Region = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -6948,16 +18526,16 @@ func (m *CompareAndSetResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				m.Region |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
-			var msglen int
+			m.Leader = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -6967,28 +18545,30 @@ func (m *CompareAndSetResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Leader |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProto
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProto
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Value == nil {
-				m.Value = &Field{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Term = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Term |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -7013,8 +18593,7 @@ func (m *CompareAndSetResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CompareAndSetNxReq) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *ReportRegionReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7037,17 +18616,17 @@ func (m *CompareAndSetNxReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: compare_and_set_nx_req: wiretype end group for non-group")
+			return fmt.Errorf("proto: report_region_req: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: compare_and_set_nx_req: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: report_region_req: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			var v int64
+			m.NodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -7057,17 +18636,16 @@ func (m *CompareAndSetNxReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				m.NodeId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Version = &v
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -7077,34 +18655,29 @@ func (m *CompareAndSetNxReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProto
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProto
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.New == nil {
-				m.New = &Field{}
-			}
-			if err := m.New.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Old", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -7114,93 +18687,48 @@ func (m *CompareAndSetNxReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProto
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProto
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Old == nil {
-				m.Old = &Field{}
-			}
-			if err := m.Old.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RaftUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProto(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthProto
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthProto
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("new")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("old")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CompareAndSetNxResp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProto
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RegionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: compare_and_set_nx_resp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: compare_and_set_nx_resp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
 			}
-			m.Version = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -7210,14 +18738,15 @@ func (m *CompareAndSetNxResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.Join = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7244,10 +18773,8 @@ func (m *CompareAndSetNxResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &Field{}
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Regions = append(m.Regions, &RegionInfo{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7275,7 +18802,7 @@ func (m *CompareAndSetNxResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelReq) Unmarshal(dAtA []byte) error {
+func (m *ReportRegionResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7298,17 +18825,17 @@ func (m *DelReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: del_req: wiretype end group for non-group")
+			return fmt.Errorf("proto: report_region_resp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: del_req: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: report_region_resp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -7318,12 +18845,24 @@ func (m *DelReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Version = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -7348,7 +18887,7 @@ func (m *DelReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelResp) Unmarshal(dAtA []byte) error {
+func (m *RouteInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7371,17 +18910,17 @@ func (m *DelResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: del_resp: wiretype end group for non-group")
+			return fmt.Errorf("proto: route_info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: del_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: route_info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			m.Version = 0
+			m.Region = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProto
@@ -7391,11 +18930,62 @@ func (m *DelResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				m.Region |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			m.Leader = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Leader |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -7420,7 +19010,7 @@ func (m *DelResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KickReq) Unmarshal(dAtA []byte) error {
+func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7443,12 +19033,63 @@ func (m *KickReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: kick_req: wiretype end group for non-group")
+			return fmt.Errorf("proto: node_info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: kick_req: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: node_info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			m.NodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])
@@ -7473,7 +19114,7 @@ func (m *KickReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KickResp) Unmarshal(dAtA []byte) error {
+func (m *QueryRouteReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7496,10 +19137,10 @@ func (m *KickResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: kick_resp: wiretype end group for non-group")
+			return fmt.Errorf("proto: query_route_req: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: kick_resp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: query_route_req: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7526,7 +19167,7 @@ func (m *KickResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cancel) Unmarshal(dAtA []byte) error {
+func (m *QueryRouteResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7549,88 +19190,99 @@ func (m *Cancel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: cancel: wiretype end group for non-group")
+			return fmt.Errorf("proto: query_route_resp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: cancel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: query_route_resp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProto
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionCount", wireType)
+			}
+			m.RegionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
 				}
-				m.Seqs = append(m.Seqs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProto
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthProto
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthProto
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Seqs) == 0 {
-					m.Seqs = make([]int64, 0, elementCount)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, &RouteInfo{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowProto
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Seqs = append(m.Seqs, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seqs", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &NodeInfo{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProto(dAtA[iNdEx:])